@@ -33,11 +33,24 @@ func (v *AnyValidator) Nullable() *AnyValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *AnyValidator) Nullish() *AnyValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
 // Parse accepts any value
 func (v *AnyValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *AnyValidator) parseWithState(value any, st *parseState) ParseResult {
 	// If Required is explicitly set and value is nil, reject
 	if v.isRequired && value == nil {
-		return FailureMessage("Expected value, received null")
+		return FailureTypeMismatch("value", nil)
 	}
 
 	// Accept everything else
@@ -10,11 +10,19 @@ type ArrayValidator struct {
 	minLen           *int
 	maxLen           *int
 	isNonEmpty       bool
+	refinements      []refinement
+	superRefinements []SuperRefineFunc
+	progress         func(done, total int)
+	chunkSize        int
 
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  []interface{}
+	defaultFunc func() []interface{} // Set by DefaultFunc, called on each parse
+
+	examples schemaExamples // Declared with Examples
 }
 
 // Array creates a new array validator with the given element validator
@@ -49,6 +57,34 @@ func (v *ArrayValidator) NonEmpty() *ArrayValidator {
 	return v
 }
 
+// defaultChunkSize is the number of elements between progress reports
+const defaultChunkSize = 1000
+
+// OnProgress reports progress while the elements are validated, after every
+// chunk of elements (1000 unless set with ChunkSize) and after the last one,
+// for job UIs tracking the validation of large imports. Like the elements,
+// the callback is not called again once the parse context is canceled.
+func (v *ArrayValidator) OnProgress(report func(done, total int)) *ArrayValidator {
+	v.progress = report
+	return v
+}
+
+// ChunkSize sets the number of elements validated between progress reports
+func (v *ArrayValidator) ChunkSize(size int) *ArrayValidator {
+	if size < 1 {
+		panic(fmt.Sprintf("zogo: chunk size must be positive, got %d", size))
+	}
+	v.chunkSize = size
+	return v
+}
+
+// SuperRefine adds a refinement over the whole array that can report several
+// issues, e.g. at "[2].sku". It runs after all elements pass.
+func (v *ArrayValidator) SuperRefine(refine SuperRefineFunc) *ArrayValidator {
+	v.superRefinements = append(v.superRefinements, refine)
+	return v
+}
+
 // Required marks the field as required
 func (v *ArrayValidator) Required() *ArrayValidator {
 	v.isRequired = true
@@ -69,8 +105,61 @@ func (v *ArrayValidator) Nullable() *ArrayValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *ArrayValidator) Nullish() *ArrayValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Default sets an array to validate in place of a missing one, such as an
+// empty list of tags, so consumers need not check for nil. It runs through
+// the schema like any input.
+func (v *ArrayValidator) Default(val []interface{}) *ArrayValidator {
+	v.defaultVal, v.defaultFunc = val, nil
+	return v
+}
+
+// DefaultFunc is Default with the array built by fn for each missing one,
+// so parses never share it
+func (v *ArrayValidator) DefaultFunc(fn func() []interface{}) *ArrayValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
+	return v
+}
+
+// Refine adds custom validation logic on the parsed array, such as
+// requiring it to be sorted. It runs after all elements pass.
+func (v *ArrayValidator) Refine(check func([]interface{}) bool, message string) *ArrayValidator {
+	v.refinements = append(v.refinements, refinement{
+		check:   func(value any) bool { return check(value.([]interface{})) },
+		message: message,
+	})
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *ArrayValidator) Examples(valid, invalid []any) *ArrayValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value
 func (v *ArrayValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *ArrayValidator) parseWithState(value any, st *parseState) ParseResult {
+	// Validate a copy of the default in place of a missing array
+	if value == nil && v.defaultVal != nil {
+		value = copyValue(v.defaultVal)
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+
 	// Handle nil values based on modifiers
 	if value == nil {
 		// If optional, nil is OK
@@ -84,55 +173,78 @@ func (v *ArrayValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected array, received null")
+		return FailureTypeMismatch("array", nil)
 	}
 
 	// Check if value is a slice
 	arr, ok := value.([]interface{})
 	if !ok {
-		return FailureMessage("Expected array, received " + typeof(value))
+		return FailureTypeMismatch("array", value)
 	}
 
 	// Check length constraints
 	arrLen := len(arr)
 
 	if v.isNonEmpty && arrLen == 0 {
-		return FailureMessage("Array must not be empty")
+		return FailureWithParams("Array must not be empty", CodeTooSmall, map[string]any{"type": "array", "minimum": 1})
 	}
 
 	if v.minLen != nil && arrLen < *v.minLen {
-		return FailureMessage(fmt.Sprintf("Array must contain at least %d element(s)", *v.minLen))
+		return FailureWithParams(
+			fmt.Sprintf("Array must contain at least %d element(s)", *v.minLen),
+			CodeTooSmall,
+			map[string]any{"type": "array", "minimum": *v.minLen},
+		)
 	}
 
 	if v.maxLen != nil && arrLen > *v.maxLen {
-		return FailureMessage(fmt.Sprintf("Array must contain at most %d element(s)", *v.maxLen))
+		return FailureWithParams(
+			fmt.Sprintf("Array must contain at most %d element(s)", *v.maxLen),
+			CodeTooBig,
+			map[string]any{"type": "array", "maximum": *v.maxLen},
+		)
 	}
 
 	// Validate each element
 	result := make([]interface{}, 0, len(arr))
 	var errors ValidationErrors
 
+	chunkSize := v.chunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	done := 0
 	for i, elem := range arr {
-		elemResult := v.elementValidator.Parse(elem)
+		if st.stopped() {
+			break
+		}
+		if v.progress != nil && i > 0 && i%chunkSize == 0 {
+			v.progress(i, arrLen)
+		}
+		done++
+
+		elemResult := st.parseIndex(i, v.elementValidator, elem)
 
 		if !elemResult.Ok {
 			// Add array index to error path
 			for _, err := range elemResult.Errors {
-				errors = append(errors, ValidationError{
-					Path:    fmt.Sprintf("[%d]%s", i, prependPath(err.Path)),
-					Message: err.Message,
-					Value:   err.Value,
-				})
+				err.nest(fmt.Sprintf("[%d]", i), indexToken(i))
+				errors = append(errors, err)
 			}
 		} else {
 			result = append(result, elemResult.Value)
 		}
 	}
 
+	if v.progress != nil && arrLen > 0 && done == arrLen {
+		v.progress(arrLen, arrLen)
+	}
+
 	// Return errors if any
 	if len(errors) > 0 {
 		return Failure(errors...)
 	}
 
-	return Success(result)
+	return superRefine(st.ctx, v.superRefinements, refine(v.refinements, Success(result)))
 }
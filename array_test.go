@@ -1,6 +1,7 @@
 package zogo
 
 import (
+	"context"
 	"testing"
 )
 
@@ -413,3 +414,87 @@ func TestArrayInObjectErrorPath(t *testing.T) {
 		t.Errorf("Expected error path 'users[1].email', got '%s'", result.Errors[0].Path)
 	}
 }
+
+// Test OnProgress reports after each chunk and at the end
+func TestArrayOnProgress(t *testing.T) {
+	var reports [][2]int
+	schema := Array(Number()).ChunkSize(2).OnProgress(func(done, total int) {
+		reports = append(reports, [2]int{done, total})
+	})
+
+	if !schema.Parse([]interface{}{1, 2, 3, 4, 5}).Ok {
+		t.Fatal("Expected numbers to pass")
+	}
+	want := [][2]int{{2, 5}, {4, 5}, {5, 5}}
+	if len(reports) != len(want) {
+		t.Fatalf("Expected reports %v, got %v", want, reports)
+	}
+	for i := range want {
+		if reports[i] != want[i] {
+			t.Errorf("Expected reports %v, got %v", want, reports)
+			break
+		}
+	}
+}
+
+// Test canceling the context between chunks stops validation
+func TestArrayProgressCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var reports []int
+	schema := Array(Number()).ChunkSize(2).OnProgress(func(done, total int) {
+		reports = append(reports, done)
+		cancel()
+	})
+
+	result := ParseCtx(ctx, schema, []interface{}{1, 2, 3, 4, 5})
+	if result.Ok || result.Errors[len(result.Errors)-1].Code != CodeCanceled {
+		t.Errorf("Expected the parse to be canceled, got %v", result.Errors)
+	}
+	if len(reports) != 1 || reports[0] != 2 {
+		t.Errorf("Expected a single report before cancellation, got %v", reports)
+	}
+}
+
+// Test Default validates a default array in place of a missing one
+func TestArrayDefault(t *testing.T) {
+	schema := Object(Schema{
+		"tags":  Array(String()).Default([]interface{}{}),
+		"roles": Array(String().ToUpperCase()).Default([]interface{}{"viewer"}),
+	})
+
+	result := schema.Parse(map[string]interface{}{})
+	if !result.Ok {
+		t.Fatalf("Expected missing arrays to use their defaults, got %v", result.Errors)
+	}
+	value := result.Value.(map[string]interface{})
+	if tags, ok := value["tags"].([]interface{}); !ok || len(tags) != 0 {
+		t.Errorf("Expected an empty tags array, got %#v", value["tags"])
+	}
+	if roles := value["roles"].([]interface{}); len(roles) != 1 || roles[0] != "VIEWER" {
+		t.Errorf("Expected the default to run through the schema, got %v", roles)
+	}
+
+	result = schema.Parse(map[string]interface{}{"roles": []interface{}{"admin"}})
+	if roles := result.Value.(map[string]interface{})["roles"].([]interface{}); roles[0] != "ADMIN" {
+		t.Errorf("Expected a given array to be used, got %v", roles)
+	}
+	if Array(Number()).Min(1).Default([]interface{}{}).Parse(nil).Ok {
+		t.Error("Expected a default violating the schema to fail")
+	}
+}
+
+// Test DefaultFunc builds a fresh array for each parse, through the schema
+func TestArrayDefaultFunc(t *testing.T) {
+	schema := Array(String().ToUpperCase()).DefaultFunc(func() []interface{} {
+		return []interface{}{"viewer"}
+	})
+
+	first := schema.Parse(nil).Value.([]interface{})
+	first[0] = "changed"
+	second := schema.Parse(nil).Value.([]interface{})
+	if second[0] != "VIEWER" {
+		t.Errorf("Expected each parse to get its own transformed default, got %v", second)
+	}
+}
@@ -0,0 +1,65 @@
+package zogo
+
+import "strings"
+
+// ibanLengths maps the countries of the IBAN registry to the length of
+// their IBANs
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20,
+	"LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+	"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28,
+	"PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31,
+	"SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// isValidIBAN checks an International Bank Account Number: a registered
+// country code, that country's length and the mod-97 check digits. Spaces
+// between groups, as in "DE89 3704 0044 0532 0130 00", are allowed.
+func isValidIBAN(s string) bool {
+	iban := strings.ReplaceAll(s, " ", "")
+	if len(iban) < 4 || ibanLengths[iban[:2]] != len(iban) {
+		return false
+	}
+
+	// Move the country code and check digits to the end, read letters as
+	// 10 to 35 and check the number is 1 modulo 97
+	rearranged := iban[4:] + iban[:4]
+	remainder := 0
+	for i := 0; i < len(rearranged); i++ {
+		switch c := rearranged[i]; {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// isValidBIC checks a Business Identifier Code (SWIFT code): a four letter
+// bank code, a two letter country code, a two character location and an
+// optional three character branch, such as "DEUTDEFF500"
+func isValidBIC(s string) bool {
+	if len(s) != 8 && len(s) != 11 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		isLetter := c >= 'A' && c <= 'Z'
+		if i < 6 && !isLetter {
+			return false
+		}
+		if i >= 6 && !isLetter && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
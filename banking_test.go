@@ -0,0 +1,57 @@
+package zogo
+
+import "testing"
+
+// Test IBAN validation
+func TestStringIBAN(t *testing.T) {
+	schema := String().IBAN()
+
+	valid := []string{
+		"DE89370400440532013000",
+		"DE89 3704 0044 0532 0130 00",
+		"GB29NWBK60161331926819",
+		"NO9386011117947",
+		"FR1420041010050500013M02606",
+	}
+	for _, iban := range valid {
+		if result := schema.Parse(iban); !result.Ok {
+			t.Errorf("Expected valid IBAN %q to pass, got %v", iban, result.Errors)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"DE88370400440532013000",   // Wrong check digits
+		"DE8937040044053201300",    // Too short for DE
+		"XX89370400440532013000",   // Unknown country
+		"de89370400440532013000",   // Lower case
+		"DE89-3704-0044-0532-0130", // Other separators
+	}
+	for _, iban := range invalid {
+		result := schema.Parse(iban)
+		if result.Ok {
+			t.Errorf("Expected invalid IBAN %q to fail", iban)
+			continue
+		}
+		if result.Errors[0].Code != CodeInvalidIBAN {
+			t.Errorf("Expected invalid_string.iban for %q, got %s", iban, result.Errors[0].Code)
+		}
+	}
+}
+
+// Test BIC validation
+func TestStringBIC(t *testing.T) {
+	schema := String().BIC()
+
+	for _, bic := range []string{"DEUTDEFF", "DEUTDEFF500", "NWBKGB2L", "BNPAFRPPXXX"} {
+		if !schema.Parse(bic).Ok {
+			t.Errorf("Expected valid BIC %q to pass", bic)
+		}
+	}
+
+	for _, bic := range []string{"", "DEUTDEF", "DEUTDEFF50", "DEUT1EFF", "deutdeff", "DEUTDEFF50!"} {
+		if schema.Parse(bic).Ok {
+			t.Errorf("Expected invalid BIC %q to fail", bic)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package zogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// BigIntValidator validates arbitrary-precision integers, such as blockchain
+// amounts and 64-bit IDs, without passing them through float64
+type BigIntValidator struct {
+	// Validation rules
+	minVal     *big.Int
+	maxVal     *big.Int
+	multipleOf *big.Int
+
+	// Type checks
+	isPositive    bool
+	isNegative    bool
+	isNonNegative bool
+	isNonPositive bool
+
+	// Modifiers
+	isRequired bool
+	isOptional bool
+	isNullable bool
+
+	// Custom validators
+	refinements []refinement
+}
+
+// BigInt creates a new big integer validator. It accepts decimal strings,
+// json.Number, Go integers, *big.Int and integral float64 values within the
+// safe integer range, and parses them to *big.Int.
+func BigInt() *BigIntValidator {
+	return &BigIntValidator{}
+}
+
+// Min sets the minimum value
+func (v *BigIntValidator) Min(val *big.Int) *BigIntValidator {
+	v.minVal = new(big.Int).Set(val)
+	return v
+}
+
+// Max sets the maximum value
+func (v *BigIntValidator) Max(val *big.Int) *BigIntValidator {
+	v.maxVal = new(big.Int).Set(val)
+	return v
+}
+
+// Positive requires number > 0
+func (v *BigIntValidator) Positive() *BigIntValidator {
+	v.isPositive = true
+	return v
+}
+
+// Negative requires number < 0
+func (v *BigIntValidator) Negative() *BigIntValidator {
+	v.isNegative = true
+	return v
+}
+
+// NonNegative requires number >= 0
+func (v *BigIntValidator) NonNegative() *BigIntValidator {
+	v.isNonNegative = true
+	return v
+}
+
+// NonPositive requires number <= 0
+func (v *BigIntValidator) NonPositive() *BigIntValidator {
+	v.isNonPositive = true
+	return v
+}
+
+// MultipleOf requires number to be a multiple of the given value
+func (v *BigIntValidator) MultipleOf(val *big.Int) *BigIntValidator {
+	v.multipleOf = new(big.Int).Set(val)
+	return v
+}
+
+// Required marks the field as required
+func (v *BigIntValidator) Required() *BigIntValidator {
+	v.isRequired = true
+	v.isOptional = false
+	return v
+}
+
+// Optional allows nil values
+func (v *BigIntValidator) Optional() *BigIntValidator {
+	v.isOptional = true
+	v.isRequired = false
+	return v
+}
+
+// Nullable allows null values
+func (v *BigIntValidator) Nullable() *BigIntValidator {
+	v.isNullable = true
+	return v
+}
+
+// Nullish allows nil values, like Optional and Nullable together
+func (v *BigIntValidator) Nullish() *BigIntValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Refine adds custom validation logic on the parsed integer
+func (v *BigIntValidator) Refine(check func(*big.Int) bool, message string) *BigIntValidator {
+	v.refinements = append(v.refinements, refinement{
+		check:   func(value any) bool { return check(value.(*big.Int)) },
+		message: message,
+	})
+	return v
+}
+
+// Parse validates the input value
+func (v *BigIntValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse,
+// counting strings toward the string quota, as parsing a long number is
+// expensive
+func (v *BigIntValidator) parseWithState(value any, st *parseState) ParseResult {
+	switch str := value.(type) {
+	case string:
+		if failed, ok := st.use(&st.meta.Usage.StringBytes, len(str)); !ok {
+			return failed
+		}
+	case json.Number:
+		if failed, ok := st.use(&st.meta.Usage.StringBytes, len(str)); !ok {
+			return failed
+		}
+	}
+	return v.parseValue(value)
+}
+
+// parseValue validates the input value against the rules
+func (v *BigIntValidator) parseValue(value any) ParseResult {
+	// Handle nil values based on modifiers
+	if value == nil {
+		// If optional, nil is OK
+		if v.isOptional {
+			return Success(nil)
+		}
+
+		// If nullable, nil is OK
+		if v.isNullable {
+			return Success(nil)
+		}
+
+		// Otherwise, nil is not allowed
+		return FailureTypeMismatch("bigint", nil)
+	}
+
+	num, result, ok := toBigInt(value)
+	if !ok {
+		return result
+	}
+
+	// Check minimum value
+	if v.minVal != nil && num.Cmp(v.minVal) < 0 {
+		return FailureWithParams(
+			fmt.Sprintf("Number must be at least %s", v.minVal),
+			CodeTooSmall,
+			map[string]any{"type": "number", "minimum": v.minVal.String()},
+		)
+	}
+
+	// Check maximum value
+	if v.maxVal != nil && num.Cmp(v.maxVal) > 0 {
+		return FailureWithParams(
+			fmt.Sprintf("Number must be at most %s", v.maxVal),
+			CodeTooBig,
+			map[string]any{"type": "number", "maximum": v.maxVal.String()},
+		)
+	}
+
+	// Check sign
+	switch sign := num.Sign(); {
+	case v.isPositive && sign <= 0:
+		return FailureWithCode("Number must be positive", CodeNotPositive)
+	case v.isNegative && sign >= 0:
+		return FailureWithCode("Number must be negative", CodeNotNegative)
+	case v.isNonNegative && sign < 0:
+		return FailureWithCode("Number must be non-negative", CodeNotNonNegative)
+	case v.isNonPositive && sign > 0:
+		return FailureWithCode("Number must be non-positive", CodeNotNonPositive)
+	}
+
+	// Check multiple of
+	if v.multipleOf != nil && v.multipleOf.Sign() != 0 && new(big.Int).Rem(num, v.multipleOf).Sign() != 0 {
+		return FailureWithParams(
+			fmt.Sprintf("Number must be a multiple of %s", v.multipleOf),
+			CodeNotMultipleOf,
+			map[string]any{"multipleOf": v.multipleOf.String()},
+		)
+	}
+
+	return refine(v.refinements, Success(num))
+}
+
+// toBigInt converts an input value to a new *big.Int
+func toBigInt(value any) (*big.Int, ParseResult, bool) {
+	switch n := value.(type) {
+	case string:
+		return parseBigInt(n)
+	case json.Number:
+		return parseBigInt(string(n))
+	case *big.Int:
+		if n == nil {
+			return nil, FailureTypeMismatch("bigint", nil), false
+		}
+		return new(big.Int).Set(n), ParseResult{}, true
+	case big.Int:
+		return new(big.Int).Set(&n), ParseResult{}, true
+	case int:
+		return big.NewInt(int64(n)), ParseResult{}, true
+	case int8:
+		return big.NewInt(int64(n)), ParseResult{}, true
+	case int16:
+		return big.NewInt(int64(n)), ParseResult{}, true
+	case int32:
+		return big.NewInt(int64(n)), ParseResult{}, true
+	case int64:
+		return big.NewInt(n), ParseResult{}, true
+	case uint:
+		return new(big.Int).SetUint64(uint64(n)), ParseResult{}, true
+	case uint8:
+		return new(big.Int).SetUint64(uint64(n)), ParseResult{}, true
+	case uint16:
+		return new(big.Int).SetUint64(uint64(n)), ParseResult{}, true
+	case uint32:
+		return new(big.Int).SetUint64(uint64(n)), ParseResult{}, true
+	case uint64:
+		return new(big.Int).SetUint64(n), ParseResult{}, true
+	case float32:
+		return floatToBigInt(float64(n))
+	case float64:
+		return floatToBigInt(n)
+	}
+	return nil, FailureTypeMismatch("bigint", value), false
+}
+
+// parseBigInt parses a decimal integer string
+func parseBigInt(s string) (*big.Int, ParseResult, bool) {
+	num, ok := new(big.Int).SetString(strings.TrimSpace(s), 10)
+	if !ok {
+		return nil, FailureWithCode("Number must be an integer", CodeNotInteger), false
+	}
+	return num, ParseResult{}, true
+}
+
+// floatToBigInt converts a float64, which decoded JSON numbers are, rejecting
+// values that float64 may already have rounded
+func floatToBigInt(f float64) (*big.Int, ParseResult, bool) {
+	const maxSafeInt = 9007199254740991 // 2^53 - 1
+	if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+		return nil, FailureWithCode("Number must be an integer", CodeNotInteger), false
+	}
+	if math.Abs(f) > maxSafeInt {
+		return nil, FailureWithCode("Number must be within safe integer range; send larger integers as strings", CodeUnsafeInteger), false
+	}
+	return big.NewInt(int64(f)), ParseResult{}, true
+}
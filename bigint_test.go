@@ -0,0 +1,116 @@
+package zogo
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+// Test basic big integer parsing
+func TestBigIntBasic(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	tests := []struct {
+		name  string
+		input any
+		want  *big.Int
+	}{
+		{"string", "123456789012345678901234567890", huge},
+		{"negative string", " -42 ", big.NewInt(-42)},
+		{"json number", json.Number("9007199254740993"), big.NewInt(9007199254740993)},
+		{"int", 7, big.NewInt(7)},
+		{"uint64", uint64(18446744073709551615), new(big.Int).SetUint64(18446744073709551615)},
+		{"big int", huge, huge},
+		{"safe float", float64(1 << 52), big.NewInt(1 << 52)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BigInt().Parse(tt.input)
+			if !result.Ok {
+				t.Fatalf("Expected %v to pass, got %v", tt.input, result.Errors)
+			}
+			if got := result.Value.(*big.Int); got.Cmp(tt.want) != 0 {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	// The parsed value is a copy of a *big.Int input
+	if result := BigInt().Parse(huge); result.Value.(*big.Int) == huge {
+		t.Error("Expected the input not to be shared")
+	}
+}
+
+// Test invalid inputs
+func TestBigIntInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		code  ErrorCode
+	}{
+		{"nil", nil, CodeInvalidType},
+		{"bool", true, CodeInvalidType},
+		{"decimal string", "1.5", CodeNotInteger},
+		{"hex string", "0x10", CodeNotInteger},
+		{"empty string", "", CodeNotInteger},
+		{"fraction", 1.5, CodeNotInteger},
+		{"rounded float", 9007199254740993.0, CodeUnsafeInteger},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BigInt().Parse(tt.input)
+			if result.Ok || result.Errors[0].Code != tt.code {
+				t.Errorf("Expected %s error, got %v", tt.code, result.Errors)
+			}
+		})
+	}
+
+	if !BigInt().Optional().Parse(nil).Ok {
+		t.Error("Expected nil to pass when optional")
+	}
+}
+
+// Test rules
+func TestBigIntRules(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1000000000000000000", 10)
+
+	tests := []struct {
+		name   string
+		schema *BigIntValidator
+		input  string
+		code   ErrorCode
+	}{
+		{"min", BigInt().Min(wei), "999999999999999999", CodeTooSmall},
+		{"max", BigInt().Max(wei), "1000000000000000001", CodeTooBig},
+		{"within", BigInt().Min(big.NewInt(0)).Max(wei), "1000000000000000000", ""},
+		{"positive", BigInt().Positive(), "0", CodeNotPositive},
+		{"negative", BigInt().Negative(), "0", CodeNotNegative},
+		{"nonnegative", BigInt().NonNegative(), "-1", CodeNotNonNegative},
+		{"nonpositive", BigInt().NonPositive(), "1", CodeNotNonPositive},
+		{"multiple of", BigInt().MultipleOf(wei), "1500000000000000000", CodeNotMultipleOf},
+		{"is multiple", BigInt().MultipleOf(wei), "30000000000000000000", ""},
+		{"refine", BigInt().Refine(func(n *big.Int) bool { return n.Bit(0) == 0 }, "Must be even"), "3", CodeCustom},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.schema.Parse(tt.input)
+			if tt.code == "" {
+				if !result.Ok {
+					t.Errorf("Expected %s to pass, got %v", tt.input, result.Errors)
+				}
+				return
+			}
+			if result.Ok || result.Errors[0].Code != tt.code {
+				t.Errorf("Expected %s error, got %v", tt.code, result.Errors)
+			}
+		})
+	}
+
+	result := BigInt().Min(wei).Parse("1")
+	if result.Errors[0].Message != "Number must be at least 1000000000000000000" || result.Errors[0].Params["minimum"] != "1000000000000000000" {
+		t.Errorf("Expected the exact minimum in the error, got %v", result.Errors[0])
+	}
+}
@@ -3,10 +3,16 @@ package zogo
 // BooleanValidator validates boolean values
 type BooleanValidator struct {
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
-	defaultVal *bool
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  *bool
+	defaultFunc func() bool // Set by DefaultFunc, called on each parse
+
+	// Custom validators
+	refinements []refinement
+
+	examples schemaExamples // Declared with Examples
 }
 
 // Boolean creates a new boolean validator
@@ -34,21 +40,66 @@ func (v *BooleanValidator) Nullable() *BooleanValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *BooleanValidator) Nullish() *BooleanValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
 // Default sets a default value if input is nil
 func (v *BooleanValidator) Default(val bool) *BooleanValidator {
-	v.defaultVal = &val
+	v.defaultVal, v.defaultFunc = &val, nil
+	return v
+}
+
+// DefaultFunc sets a function called for the default each time the input
+// is nil, such as a feature flag looked up at parse time
+func (v *BooleanValidator) DefaultFunc(fn func() bool) *BooleanValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
 	return v
 }
 
 // Parse validates the input value
 func (v *BooleanValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// Refine adds custom validation logic, such as requiring a terms checkbox
+// to be checked
+func (v *BooleanValidator) Refine(check func(bool) bool, message string) *BooleanValidator {
+	v.refinements = append(v.refinements, refinement{
+		check:   func(value any) bool { return check(value.(bool)) },
+		message: message,
+	})
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *BooleanValidator) Examples(valid, invalid []any) *BooleanValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
+// parseWithState validates the input value as part of a larger parse,
+// coercing it first when the parse asks for coercion
+func (v *BooleanValidator) parseWithState(value any, st *parseState) ParseResult {
+	if st.opts.Coerce {
+		value = coerceBoolean(value)
+	}
+
+	// Validate the default in place of a missing boolean
+	if value == nil && v.defaultVal != nil {
+		value = *v.defaultVal
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+
 	// Handle nil values based on modifiers
 	if value == nil {
-		// If default is set, use it
-		if v.defaultVal != nil {
-			return Success(*v.defaultVal)
-		}
-
 		// If optional, nil is OK
 		if v.isOptional {
 			return Success(nil)
@@ -60,14 +111,14 @@ func (v *BooleanValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected boolean, received null")
+		return FailureTypeMismatch("boolean", nil)
 	}
 
 	// Check if value is a boolean
 	boolVal, ok := value.(bool)
 	if !ok {
-		return FailureMessage("Expected boolean, received " + typeof(value))
+		return FailureTypeMismatch("boolean", value)
 	}
 
-	return Success(boolVal)
+	return refine(v.refinements, Success(boolVal))
 }
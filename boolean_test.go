@@ -110,6 +110,18 @@ func TestBooleanDefaultTrue(t *testing.T) {
 }
 
 // Test Default with false
+// Test the default is checked against the refinements
+func TestBooleanDefaultRefine(t *testing.T) {
+	schema := Boolean().Default(false).Refine(func(b bool) bool { return b }, "Terms must be accepted")
+	if result := schema.Parse(nil); result.Ok || result.Errors[0].Message != "Terms must be accepted" {
+		t.Errorf("Expected a false default to fail the refinement, got %v", result)
+	}
+	generated := Boolean().DefaultFunc(func() bool { return false }).Refine(func(b bool) bool { return b }, "Terms must be accepted")
+	if generated.Parse(nil).Ok {
+		t.Error("Expected a generated false default to fail the refinement")
+	}
+}
+
 func TestBooleanDefaultFalse(t *testing.T) {
 	schema := Boolean().Default(false)
 
@@ -0,0 +1,90 @@
+package zogo
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// Test the package builds for browser/edge targets and the minimal build
+func TestBuildTargets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping cross-compilation in short mode")
+	}
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not available")
+	}
+
+	targets := []struct {
+		name string
+		env  []string
+		args []string
+	}{
+		{"js/wasm", []string{"GOOS=js", "GOARCH=wasm"}, nil},
+		{"wasip1/wasm", []string{"GOOS=wasip1", "GOARCH=wasm"}, nil},
+		{"minimal", nil, []string{"-tags", "zogo_minimal"}},
+		{"noreflect", nil, []string{"-tags", "zogo_noreflect"}},
+	}
+
+	for _, target := range targets {
+		t.Run(target.name, func(t *testing.T) {
+			args := append([]string{"build"}, target.args...)
+			args = append(args, ".")
+			cmd := exec.Command(goBin, args...)
+			cmd.Env = append(os.Environ(), target.env...)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("build failed for %s: %v\n%s", target.name, err, out)
+			}
+		})
+	}
+}
+
+// Test the noreflect and minimal builds do not import reflect directly
+func TestNoReflectBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping package listing in short mode")
+	}
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not available")
+	}
+
+	for _, tag := range []string{"zogo_noreflect", "zogo_minimal"} {
+		out, err := exec.Command(goBin, "list", "-tags", tag, "-f", "{{join .Imports \" \"}}", ".").CombinedOutput()
+		if err != nil {
+			t.Fatalf("go list failed: %v\n%s", err, out)
+		}
+		for _, imp := range strings.Fields(string(out)) {
+			if imp == "reflect" {
+				t.Errorf("expected -tags %s build not to import reflect", tag)
+			}
+		}
+	}
+}
+
+// Test the core package does not import the net package, which reaches
+// the OS resolver; network checks live in zogomx
+func TestNoNetworkImports(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping package listing in short mode")
+	}
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not available")
+	}
+
+	out, err := exec.Command(goBin, "list", "-f", "{{join .Imports \" \"}}", ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go list failed: %v\n%s", err, out)
+	}
+	for _, imp := range strings.Fields(string(out)) {
+		if imp == "net" {
+			t.Error("expected the core package not to import net")
+		}
+	}
+}
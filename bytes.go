@@ -0,0 +1,213 @@
+package zogo
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"unicode/utf8"
+)
+
+// BytesValidator validates []byte values, such as binary payloads and raw
+// tokens
+type BytesValidator struct {
+	minLen   *int
+	maxLen   *int
+	exactLen *int
+	isUTF8   bool
+	encoding string // "base64" or "hex" when strings are decoded
+
+	// Modifiers
+	isRequired bool
+	isOptional bool
+	isNullable bool
+
+	// Custom validators
+	refinements []refinement
+
+	examples schemaExamples // Declared with Examples
+}
+
+// Bytes creates a new bytes validator
+func Bytes() *BytesValidator {
+	return &BytesValidator{}
+}
+
+// Min sets minimum length in bytes
+func (v *BytesValidator) Min(length int) *BytesValidator {
+	v.minLen = &length
+	return v
+}
+
+// Max sets maximum length in bytes
+func (v *BytesValidator) Max(length int) *BytesValidator {
+	v.maxLen = &length
+	return v
+}
+
+// Length sets exact length in bytes
+func (v *BytesValidator) Length(length int) *BytesValidator {
+	v.exactLen = &length
+	return v
+}
+
+// UTF8 requires the bytes to be valid UTF-8 text
+func (v *BytesValidator) UTF8() *BytesValidator {
+	v.isUTF8 = true
+	return v
+}
+
+// Base64 also accepts standard base64 strings, such as binary fields in
+// JSON, decoding them before the other rules run
+func (v *BytesValidator) Base64() *BytesValidator {
+	v.encoding = "base64"
+	return v
+}
+
+// Hex also accepts hexadecimal strings, decoding them before the other
+// rules run
+func (v *BytesValidator) Hex() *BytesValidator {
+	v.encoding = "hex"
+	return v
+}
+
+// Required marks the field as required
+func (v *BytesValidator) Required() *BytesValidator {
+	v.isRequired = true
+	v.isOptional = false
+	return v
+}
+
+// Optional allows nil values
+func (v *BytesValidator) Optional() *BytesValidator {
+	v.isOptional = true
+	v.isRequired = false
+	return v
+}
+
+// Nullable allows null values
+func (v *BytesValidator) Nullable() *BytesValidator {
+	v.isNullable = true
+	return v
+}
+
+// Nullish allows nil values, like Optional and Nullable together
+func (v *BytesValidator) Nullish() *BytesValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Refine adds custom validation logic, such as checking a file signature
+func (v *BytesValidator) Refine(check func([]byte) bool, message string) *BytesValidator {
+	v.refinements = append(v.refinements, refinement{
+		check:   func(value any) bool { return check(value.([]byte)) },
+		message: message,
+	})
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *BytesValidator) Examples(valid, invalid []any) *BytesValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
+// Parse validates the input value
+func (v *BytesValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse,
+// counting decoded strings toward the string quota
+func (v *BytesValidator) parseWithState(value any, st *parseState) ParseResult {
+	if str, ok := value.(string); ok && v.encoding != "" {
+		if failed, ok := st.use(&st.meta.Usage.StringBytes, len(str)); !ok {
+			return failed
+		}
+	}
+
+	// Handle nil values based on modifiers
+	if value == nil {
+		// If optional, nil is OK
+		if v.isOptional {
+			return Success(nil)
+		}
+
+		// If nullable, nil is OK
+		if v.isNullable {
+			return Success(nil)
+		}
+
+		// Otherwise, nil is not allowed
+		return FailureTypeMismatch("bytes", nil)
+	}
+
+	var data []byte
+	switch value := value.(type) {
+	case []byte:
+		data = value
+	case string:
+		decoded, result, ok := v.decode(value)
+		if !ok {
+			return result
+		}
+		data = decoded
+	default:
+		return FailureTypeMismatch("bytes", value)
+	}
+
+	// Check exact length if specified
+	if v.exactLen != nil && len(data) != *v.exactLen {
+		return FailureWithParams(
+			fmt.Sprintf("Value must be exactly %d bytes", *v.exactLen),
+			CodeInvalidLength,
+			map[string]any{"type": "bytes", "length": *v.exactLen, "received": len(data)},
+		)
+	}
+
+	// Check minimum length
+	if v.minLen != nil && len(data) < *v.minLen {
+		return FailureWithParams(
+			fmt.Sprintf("Value must be at least %d bytes", *v.minLen),
+			CodeTooSmall,
+			map[string]any{"type": "bytes", "minimum": *v.minLen},
+		)
+	}
+
+	// Check maximum length
+	if v.maxLen != nil && len(data) > *v.maxLen {
+		return FailureWithParams(
+			fmt.Sprintf("Value must be at most %d bytes", *v.maxLen),
+			CodeTooBig,
+			map[string]any{"type": "bytes", "maximum": *v.maxLen},
+		)
+	}
+
+	// Check encoding
+	if v.isUTF8 && !utf8.Valid(data) {
+		return FailureWithCode("Invalid UTF-8 text", CodeInvalidUTF8)
+	}
+
+	return refine(v.refinements, Success(data))
+}
+
+// decode decodes a string input with the validator's encoding
+func (v *BytesValidator) decode(str string) ([]byte, ParseResult, bool) {
+	switch v.encoding {
+	case "base64":
+		data, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, FailureWithCode("Invalid base64 string", CodeInvalidBase64), false
+		}
+		return data, ParseResult{}, true
+	case "hex":
+		data, err := hex.DecodeString(str)
+		if err != nil {
+			return nil, FailureWithCode("Invalid hexadecimal string", CodeInvalidHex), false
+		}
+		return data, ParseResult{}, true
+	}
+	return nil, FailureTypeMismatch("bytes", str), false
+}
@@ -0,0 +1,125 @@
+package zogo
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Test basic bytes validation
+func TestBytesBasic(t *testing.T) {
+	schema := Bytes()
+
+	result := schema.Parse([]byte{0x00, 0xff})
+	if !result.Ok {
+		t.Fatal("Expected bytes to pass")
+	}
+	if !bytes.Equal(result.Value.([]byte), []byte{0x00, 0xff}) {
+		t.Errorf("Expected the input bytes, got %v", result.Value)
+	}
+
+	// Strings are only accepted with an encoding
+	result = schema.Parse("AP8=")
+	if result.Ok || result.Errors[0].Code != CodeInvalidType {
+		t.Errorf("Expected string to fail without an encoding, got %v", result.Errors)
+	}
+
+	if schema.Parse(nil).Ok {
+		t.Error("Expected nil to fail")
+	}
+	if !Bytes().Optional().Parse(nil).Ok || !Bytes().Nullable().Parse(nil).Ok {
+		t.Error("Expected nil to pass when optional or nullable")
+	}
+}
+
+// Test length rules
+func TestBytesLength(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *BytesValidator
+		input  []byte
+		code   ErrorCode
+	}{
+		{"min", Bytes().Min(2), []byte{1}, CodeTooSmall},
+		{"max", Bytes().Max(2), []byte{1, 2, 3}, CodeTooBig},
+		{"length", Bytes().Length(2), []byte{1}, CodeInvalidLength},
+		{"within", Bytes().Min(1).Max(2), []byte{1, 2}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.schema.Parse(tt.input)
+			if tt.code == "" {
+				if !result.Ok {
+					t.Errorf("Expected %v to pass, got %v", tt.input, result.Errors)
+				}
+				return
+			}
+			if result.Ok || result.Errors[0].Code != tt.code || result.Errors[0].Params["type"] != "bytes" {
+				t.Errorf("Expected %s error, got %v", tt.code, result.Errors)
+			}
+		})
+	}
+
+	result := Bytes().Min(4).Parse([]byte{1})
+	if got := result.Errors[0].Translate("en"); got != "Value must be at least 4 bytes" {
+		t.Errorf("Expected bytes message, got %q", got)
+	}
+}
+
+// Test UTF8
+func TestBytesUTF8(t *testing.T) {
+	schema := Bytes().UTF8()
+
+	if !schema.Parse([]byte("héllo")).Ok {
+		t.Error("Expected valid UTF-8 to pass")
+	}
+	result := schema.Parse([]byte{'a', 0xc3})
+	if result.Ok || result.Errors[0].Code != CodeInvalidUTF8 {
+		t.Errorf("Expected invalid UTF-8 to fail, got %v", result.Errors)
+	}
+}
+
+// Test decoding strings
+func TestBytesDecoding(t *testing.T) {
+	result := Bytes().Base64().Length(2).Parse("AP8=")
+	if !result.Ok || !bytes.Equal(result.Value.([]byte), []byte{0x00, 0xff}) {
+		t.Errorf("Expected base64 to decode, got %v %v", result.Value, result.Errors)
+	}
+	if result := Bytes().Base64().Parse("AP8"); result.Ok || result.Errors[0].Code != CodeInvalidBase64 {
+		t.Errorf("Expected invalid base64 to fail, got %v", result.Errors)
+	}
+
+	result = Bytes().Hex().Parse("00ff")
+	if !result.Ok || !bytes.Equal(result.Value.([]byte), []byte{0x00, 0xff}) {
+		t.Errorf("Expected hex to decode, got %v %v", result.Value, result.Errors)
+	}
+	if result := Bytes().Hex().Parse("0g"); result.Ok || result.Errors[0].Code != CodeInvalidHex {
+		t.Errorf("Expected invalid hex to fail, got %v", result.Errors)
+	}
+
+	// Raw bytes are still accepted
+	if !Bytes().Hex().Parse([]byte{1}).Ok {
+		t.Error("Expected bytes to pass with an encoding")
+	}
+
+	// Decoded strings count toward the string quota
+	result = ParseWith(Bytes().Base64(), "AP8=", ParseOptions{Quota: Quota{MaxStringBytes: 2}})
+	if result.Ok || result.Errors[0].Code != CodeQuotaExceeded {
+		t.Errorf("Expected the quota to be exceeded, got %v", result.Errors)
+	}
+}
+
+// Test Refine
+func TestBytesRefine(t *testing.T) {
+	png := Bytes().Refine(func(data []byte) bool {
+		return bytes.HasPrefix(data, []byte("\x89PNG"))
+	}, "Must be a PNG image")
+
+	if !png.Parse([]byte("\x89PNG\r\n")).Ok {
+		t.Error("Expected PNG to pass")
+	}
+	result := png.Parse([]byte("GIF89a"))
+	if result.Ok || result.Errors[0].Message != "Must be a PNG image" {
+		t.Errorf("Expected refinement error, got %v", result.Errors)
+	}
+}
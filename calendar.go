@@ -0,0 +1,100 @@
+package zogo
+
+import (
+	"fmt"
+	"time"
+)
+
+// Calendar tells working hours and holidays apart for date rules such as
+// WithinBusinessHours and NotHoliday. Implement it to look up a region's
+// holidays from a service, or use BusinessCalendar for fixed schedules.
+type Calendar interface {
+	// IsOpen reports whether t falls within working hours
+	IsOpen(t time.Time) bool
+
+	// IsHoliday reports whether t falls on a holiday
+	IsHoliday(t time.Time) bool
+}
+
+// BusinessCalendar is a Calendar with weekly opening hours and a list of
+// holidays, evaluated in a time zone
+type BusinessCalendar struct {
+	location *time.Location
+	hours    map[time.Weekday][]openingHours
+	holidays map[string]bool // Dates as "2006-01-02"
+}
+
+// openingHours is an interval of the day, in minutes since midnight
+type openingHours struct {
+	open, close int
+}
+
+// NewBusinessCalendar creates a calendar for the given time zone, with no
+// opening hours and no holidays. Dates are converted to the time zone before
+// they are checked, so "09:00" means 9 AM where the business is.
+func NewBusinessCalendar(location *time.Location) *BusinessCalendar {
+	return &BusinessCalendar{
+		location: location,
+		hours:    map[time.Weekday][]openingHours{},
+		holidays: map[string]bool{},
+	}
+}
+
+// Hours opens the calendar from open to close ("09:00" to "17:30") on the
+// given days. Call it again for split shifts or different hours on other
+// days. It panics if a time is malformed or close is not after open.
+func (c *BusinessCalendar) Hours(open, close string, days ...time.Weekday) *BusinessCalendar {
+	from, to := clockMinutes(open), clockMinutes(close)
+	if to <= from {
+		panic(fmt.Sprintf("zogo: business hours close at %s, before they open at %s", close, open))
+	}
+	for _, day := range days {
+		c.hours[day] = append(c.hours[day], openingHours{open: from, close: to})
+	}
+	return c
+}
+
+// Weekdays opens the calendar from open to close, Monday to Friday
+func (c *BusinessCalendar) Weekdays(open, close string) *BusinessCalendar {
+	return c.Hours(open, close, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+}
+
+// Holidays adds holidays as "2006-01-02" dates. It panics if a date is malformed.
+func (c *BusinessCalendar) Holidays(dates ...string) *BusinessCalendar {
+	for _, date := range dates {
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			panic(fmt.Sprintf("zogo: invalid holiday %q: %v", date, err))
+		}
+		c.holidays[date] = true
+	}
+	return c
+}
+
+// IsOpen reports whether t falls within the opening hours of its weekday.
+// Holidays are not taken into account; check them with IsHoliday.
+func (c *BusinessCalendar) IsOpen(t time.Time) bool {
+	t = t.In(c.location)
+	minute := t.Hour()*60 + t.Minute()
+	for _, hours := range c.hours[t.Weekday()] {
+		if minute >= hours.open && minute < hours.close {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHoliday reports whether t falls on a holiday in the calendar's time zone
+func (c *BusinessCalendar) IsHoliday(t time.Time) bool {
+	return c.holidays[t.In(c.location).Format(time.DateOnly)]
+}
+
+// clockMinutes converts a time of day ("09:00", or "24:00" for the end of
+// the day) to minutes since midnight
+func clockMinutes(clock string) int {
+	var hour, minute int
+	if n, err := fmt.Sscanf(clock, "%d:%d", &hour, &minute); err != nil || n != 2 || len(clock) != 5 ||
+		hour < 0 || minute < 0 || minute > 59 || hour*60+minute > 24*60 {
+		panic(fmt.Sprintf("zogo: invalid time of day %q, expected HH:MM", clock))
+	}
+	return hour*60 + minute
+}
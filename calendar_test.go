@@ -0,0 +1,76 @@
+package zogo
+
+import (
+	"testing"
+	"time"
+)
+
+// Test opening hours are checked in the calendar's time zone
+func TestWithinBusinessHours(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	calendar := NewBusinessCalendar(berlin).
+		Weekdays("09:00", "12:30").
+		Hours("13:30", "17:00", time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday).
+		Hours("10:00", "14:00", time.Saturday)
+	schema := Date().WithinBusinessHours(calendar)
+
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{"2026-03-02T09:00:00+01:00", true},  // Monday, opening time
+		{"2026-03-02T08:30:00Z", true},       // 09:30 in the calendar's zone
+		{"2026-03-02T08:59:00+01:00", false}, // Before opening
+		{"2026-03-02T12:45:00+01:00", false}, // Lunch break
+		{"2026-03-02T16:59:00+01:00", true},
+		{"2026-03-02T17:00:00+01:00", false}, // Closing time
+		{"2026-03-07T11:00:00+01:00", true},  // Saturday
+		{"2026-03-08T11:00:00+01:00", false}, // Sunday
+	}
+	for _, tt := range tests {
+		result := schema.Parse(tt.input)
+		if result.Ok != tt.valid {
+			t.Errorf("Expected %s valid=%v, got %v", tt.input, tt.valid, result.Errors)
+		}
+		if !result.Ok && result.Errors[0].Code != CodeNotBusinessHours {
+			t.Errorf("Expected not_business_hours, got %v", result.Errors[0].Code)
+		}
+	}
+}
+
+// Test holidays are matched by date in the calendar's time zone
+func TestNotHoliday(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	schema := Date().NotHoliday(NewBusinessCalendar(tokyo).Holidays("2026-01-01", "2026-05-05"))
+
+	if result := schema.Parse("2025-12-31T16:00:00Z"); result.Ok || result.Errors[0].Code != CodeHoliday {
+		t.Errorf("Expected New Year's Day in Tokyo to be a holiday, got %v", result.Errors)
+	}
+	if !schema.Parse("2025-12-31T14:00:00Z").Ok {
+		t.Error("Expected New Year's Eve in Tokyo to pass")
+	}
+	if !schema.Parse("2026-05-06").Ok {
+		t.Error("Expected a working day to pass")
+	}
+}
+
+// Test malformed calendar definitions panic
+func TestBusinessCalendarInvalid(t *testing.T) {
+	tests := []func(){
+		func() { NewBusinessCalendar(time.UTC).Weekdays("9:00", "17:00") },
+		func() { NewBusinessCalendar(time.UTC).Weekdays("17:00", "09:00") },
+		func() { NewBusinessCalendar(time.UTC).Weekdays("09:00", "24:01") },
+		func() { NewBusinessCalendar(time.UTC).Holidays("2026-02-30") },
+	}
+	for i, define := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected definition %d to panic", i)
+				}
+			}()
+			define()
+		}()
+	}
+	NewBusinessCalendar(time.UTC).Weekdays("00:00", "24:00")
+}
@@ -0,0 +1,61 @@
+package zogo
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// isValidCIDR checks prefix notation such as "10.0.0.0/8" or "2001:db8::/32"
+// for the given IP version, "v4", "v6" or "" for either. Host bits may be
+// set, as in "192.168.1.10/24".
+func isValidCIDR(s, version string) bool {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return false
+	}
+	switch version {
+	case "v4":
+		return prefix.Addr().Is4()
+	case "v6":
+		return prefix.Addr().Is6()
+	}
+	return true
+}
+
+// parsePrefixes parses the networks of an InCIDR rule
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, len(cidrs))
+	for i, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("zogo: invalid CIDR %q: %w", cidr, err)
+		}
+		prefixes[i] = prefix
+	}
+	return prefixes, nil
+}
+
+// inPrefixes reports whether s is an IP address within one of the prefixes.
+// IPv4-mapped IPv6 addresses match IPv4 networks.
+func inPrefixes(s string, prefixes []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) || prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixList formats networks for messages, such as "10.0.0.0/8, 192.168.0.0/16"
+func prefixList(prefixes []netip.Prefix) string {
+	cidrs := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		cidrs[i] = prefix.String()
+	}
+	return strings.Join(cidrs, ", ")
+}
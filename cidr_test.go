@@ -0,0 +1,98 @@
+package zogo
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+// Test CIDR notation validation
+func TestStringCIDR(t *testing.T) {
+	tests := []struct {
+		cidr   string
+		any    bool
+		v4, v6 bool
+	}{
+		{"10.0.0.0/8", true, true, false},
+		{"192.168.1.10/24", true, true, false},
+		{"0.0.0.0/0", true, true, false},
+		{"2001:db8::/32", true, false, true},
+		{"::/0", true, false, true},
+		{"10.0.0.0", false, false, false},
+		{"10.0.0.0/33", false, false, false},
+		{"2001:db8::/129", false, false, false},
+		{"10.0.0.0/-1", false, false, false},
+		{"010.0.0.0/8", false, false, false},
+		{"", false, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := String().CIDR().Parse(tt.cidr).Ok; got != tt.any {
+			t.Errorf("CIDR().Parse(%q).Ok = %v, want %v", tt.cidr, got, tt.any)
+		}
+		if got := String().CIDRv4().Parse(tt.cidr).Ok; got != tt.v4 {
+			t.Errorf("CIDRv4().Parse(%q).Ok = %v, want %v", tt.cidr, got, tt.v4)
+		}
+		if got := String().CIDRv6().Parse(tt.cidr).Ok; got != tt.v6 {
+			t.Errorf("CIDRv6().Parse(%q).Ok = %v, want %v", tt.cidr, got, tt.v6)
+		}
+	}
+
+	if result := String().CIDRv6().Parse("10.0.0.0/8"); result.Ok || result.Errors[0].Code != CodeInvalidCIDRv6 {
+		t.Errorf("Expected invalid_string.cidrv6, got %v", result.Errors)
+	}
+}
+
+// Test InCIDR allowlists
+func TestStringInCIDR(t *testing.T) {
+	schema := String().InCIDR("10.0.0.0/8", "192.168.1.0/24", "fd00::/8")
+
+	for _, ip := range []string{"10.1.2.3", "192.168.1.255", "fd12::1", "::ffff:10.0.0.1"} {
+		if result := schema.Parse(ip); !result.Ok {
+			t.Errorf("Expected %q to pass, got %v", ip, result.Errors)
+		}
+	}
+
+	result := schema.Parse("192.168.2.1")
+	if result.Ok || result.Errors[0].Code != CodeNotInCIDR {
+		t.Fatalf("Expected invalid_string.in_cidr, got %v", result.Errors)
+	}
+	if got := result.Errors[0].Message; got != "IP address must be within 10.0.0.0/8, 192.168.1.0/24, fd00::/8" {
+		t.Errorf("Unexpected message %q", got)
+	}
+
+	if String().InCIDR("10.0.0.0/8").Parse("not an ip").Ok {
+		t.Error("Expected a non-IP value to fail InCIDR")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected an invalid network to panic")
+		}
+	}()
+	String().InCIDR("10.0.0.0/40")
+}
+
+// Test TryInCIDR and InPrefixes build allowlists without panicking
+func TestStringTryInCIDR(t *testing.T) {
+	schema, err := String().TryInCIDR("10.0.0.0/8", "fd00::/8")
+	if err != nil {
+		t.Fatalf("Expected valid networks to parse, got %v", err)
+	}
+	if !schema.Parse("10.1.2.3").Ok || schema.Parse("11.0.0.1").Ok {
+		t.Error("Expected TryInCIDR to check the networks")
+	}
+
+	schema, err = String().TryInCIDR("10.0.0.0/8", "10.0.0.0/40")
+	if err == nil || schema != nil {
+		t.Fatal("Expected an invalid network to return an error")
+	}
+	if !strings.Contains(err.Error(), `invalid CIDR "10.0.0.0/40"`) {
+		t.Errorf("Expected the error to name the network, got %v", err)
+	}
+
+	prefixes := String().InPrefixes(netip.MustParsePrefix("192.168.1.7/24"))
+	if !prefixes.Parse("192.168.1.200").Ok || prefixes.Parse("192.168.2.1").Ok {
+		t.Error("Expected InPrefixes to check the masked network")
+	}
+}
@@ -0,0 +1,139 @@
+package zogo
+
+// ErrorCode is a machine-readable identifier attached to every ValidationError.
+// Codes are stable across releases and locales, so clients can branch on them
+// instead of parsing messages. It is an alias of string, so the constants
+// compare with and convert to plain strings.
+type ErrorCode = string
+
+// General error codes
+const (
+	CodeInvalidType          ErrorCode = "invalid_type"                // Value has the wrong type (or is null when not allowed)
+	CodeTooSmall             ErrorCode = "too_small"                   // Value, length or size is below the minimum
+	CodeTooBig               ErrorCode = "too_big"                     // Value, length or size is above the maximum
+	CodeInvalidLength        ErrorCode = "invalid_length"              // Length does not match the exact length required
+	CodeEmpty                ErrorCode = "empty"                       // String is empty, or only whitespace when trimmed
+	CodeCustom               ErrorCode = "custom"                      // A Refine check failed
+	CodeInvalidEnumValue     ErrorCode = "invalid_enum_value"          // Value is not one of the allowed enum values
+	CodeInvalidLiteral       ErrorCode = "invalid_literal"             // Value does not equal the expected literal
+	CodeUnrecognizedKeys     ErrorCode = "unrecognized_keys"           // Object contains a field not in a Strict schema
+	CodeInvalidKey           ErrorCode = "invalid_key"                 // Record key failed validation
+	CodeInvalidUnion         ErrorCode = "invalid_union"               // Value matched none of the union members
+	CodeAmbiguousUnion       ErrorCode = "ambiguous_union"             // Value matched more than one member of an Exclusive union
+	CodeInvalidIntersection  ErrorCode = "invalid_intersection_types"  // Intersection members produced conflicting values
+	CodeInvalidDiscriminator ErrorCode = "invalid_union_discriminator" // Discriminator field selects none of the union members
+	CodeRequiredWith         ErrorCode = "required_with"               // Field is missing while a field it depends on is present
+	CodeRequiredWithout      ErrorCode = "required_without"            // Field is missing while its alternative is missing too
+	CodeMutuallyExclusive    ErrorCode = "mutually_exclusive"          // More than one of a set of exclusive fields is present
+	CodeInvalidKeyOrder      ErrorCode = "invalid_key_order"           // Keys in the raw JSON are not in the required order
+	CodeDuplicateKey         ErrorCode = "duplicate_key"               // An object repeats a key, in the raw JSON (with StrictJSON) or through an alias
+)
+
+// Parse control error codes, reported when a parse is cut short rather
+// than for a rule the value breaks
+const (
+	CodeTooDeep         ErrorCode = "too_deep"         // Objects or arrays are nested deeper than MaxDepth
+	CodeQuotaExceeded   ErrorCode = "quota_exceeded"   // The parse did more work than its Quota allows
+	CodeCanceled        ErrorCode = "canceled"         // The parse context was canceled or its deadline passed
+	CodeCheckFailed     ErrorCode = "check_failed"     // A RefineCtx check returned an error other than a validation error
+	CodeRefinementPanic ErrorCode = "refinement_panic" // A custom check, Lazy factory or custom validator panicked
+)
+
+// HTTP request error codes
+const (
+	CodeInvalidJSON          ErrorCode = "invalid_json"           // A request body could not be decoded as JSON
+	CodePayloadTooLarge      ErrorCode = "payload_too_large"      // A request body exceeds the size limit
+	CodeUnreadableBody       ErrorCode = "unreadable_body"        // A request body could not be read
+	CodeUnsupportedMediaType ErrorCode = "unsupported_media_type" // A request body's Content-Type is not one the operation accepts
+	CodeUnsupportedVersion   ErrorCode = "unsupported_version"    // API version header is missing or unknown
+)
+
+// Decimal error codes
+const (
+	CodeInvalidDecimal   ErrorCode = "invalid_decimal"   // Value is not a decimal number
+	CodeDecimalPrecision ErrorCode = "decimal_precision" // Decimal has more digits than its Precision allows
+)
+
+// Phone and account error codes
+const (
+	CodeInvalidPhone    ErrorCode = "invalid_phone"    // Phone number is not valid for its country
+	CodeWeakPassword    ErrorCode = "weak_password"    // Password breaks a rule of its PasswordPolicy, named by the "type" param
+	CodeInvalidUsername ErrorCode = "invalid_username" // Username breaks a rule of its UsernamePolicy, named by the "type" param
+)
+
+// Number error codes
+const (
+	CodeNotInteger     ErrorCode = "not_integer"
+	CodeNotFinite      ErrorCode = "not_finite"
+	CodeUnsafeInteger  ErrorCode = "unsafe_integer"
+	CodeNotPositive    ErrorCode = "not_positive"
+	CodeNotNegative    ErrorCode = "not_negative"
+	CodeNotNonNegative ErrorCode = "not_nonnegative"
+	CodeNotNonPositive ErrorCode = "not_nonpositive"
+	CodeNotMultipleOf  ErrorCode = "not_multiple_of"
+)
+
+// Date error codes
+const (
+	CodeInvalidDate      ErrorCode = "invalid_date"
+	CodeNotFuture        ErrorCode = "not_future"
+	CodeNotPast          ErrorCode = "not_past"
+	CodeNotWithinLast    ErrorCode = "not_within_last"
+	CodeNotWithinNext    ErrorCode = "not_within_next"
+	CodeNotBusinessHours ErrorCode = "not_business_hours"
+	CodeHoliday          ErrorCode = "holiday"
+	CodeNotWeekday       ErrorCode = "not_weekday"
+)
+
+// String format error codes
+const (
+	CodeInvalidString       ErrorCode = "invalid_string"
+	CodeInvalidEmail        ErrorCode = "invalid_string.email"
+	CodeInvalidURL          ErrorCode = "invalid_string.url"
+	CodeInvalidUUID         ErrorCode = "invalid_string.uuid"
+	CodeInvalidDomain       ErrorCode = "invalid_string.domain"
+	CodeInvalidIP           ErrorCode = "invalid_string.ip"
+	CodeInvalidIPv4         ErrorCode = "invalid_string.ipv4"
+	CodeInvalidIPv6         ErrorCode = "invalid_string.ipv6"
+	CodeInvalidCIDR         ErrorCode = "invalid_string.cidr"
+	CodeInvalidCIDRv4       ErrorCode = "invalid_string.cidrv4"
+	CodeInvalidCIDRv6       ErrorCode = "invalid_string.cidrv6"
+	CodeNotInCIDR           ErrorCode = "invalid_string.in_cidr"
+	CodeInvalidBase64       ErrorCode = "invalid_string.base64"
+	CodeInvalidBase64URL    ErrorCode = "invalid_string.base64url"
+	CodeInvalidBase32       ErrorCode = "invalid_string.base32"
+	CodeInvalidDataURI      ErrorCode = "invalid_string.data_uri"
+	CodeInvalidHex          ErrorCode = "invalid_string.hex"
+	CodeInvalidUTF8         ErrorCode = "invalid_string.utf8"
+	CodeInvalidCUID         ErrorCode = "invalid_string.cuid"
+	CodeInvalidCUID2        ErrorCode = "invalid_string.cuid2"
+	CodeInvalidULID         ErrorCode = "invalid_string.ulid"
+	CodeInvalidNanoid       ErrorCode = "invalid_string.nanoid"
+	CodeInvalidObjectID     ErrorCode = "invalid_string.objectid"
+	CodeInvalidGitSHA       ErrorCode = "invalid_string.git_sha"
+	CodeInvalidCron         ErrorCode = "invalid_string.cron"
+	CodeInvalidIBAN         ErrorCode = "invalid_string.iban"
+	CodeInvalidBIC          ErrorCode = "invalid_string.bic"
+	CodeInvalidISBN         ErrorCode = "invalid_string.isbn"
+	CodeInvalidEAN          ErrorCode = "invalid_string.ean"
+	CodeInvalidLanguageTag  ErrorCode = "invalid_string.language_tag"
+	CodeInvalidCountry      ErrorCode = "invalid_string.country"
+	CodeInvalidCurrency     ErrorCode = "invalid_string.currency"
+	CodeInvalidCSSColor     ErrorCode = "invalid_string.css_color"
+	CodeInvalidRegex        ErrorCode = "invalid_string.regex"
+	CodeInvalidStartsWith   ErrorCode = "invalid_string.starts_with"
+	CodeInvalidEndsWith     ErrorCode = "invalid_string.ends_with"
+	CodeInvalidIncludes     ErrorCode = "invalid_string.includes"
+	CodeForbiddenRegex      ErrorCode = "invalid_string.not_regex"
+	CodeForbiddenPrefix     ErrorCode = "invalid_string.not_prefix"
+	CodeForbiddenIncludes   ErrorCode = "invalid_string.not_includes"
+	CodeContainsHTML        ErrorCode = "invalid_string.no_html"
+	CodeInvalidASCII        ErrorCode = "invalid_string.ascii"
+	CodeInvalidPrintable    ErrorCode = "invalid_string.printable"
+	CodeInvalidAlpha        ErrorCode = "invalid_string.alpha"
+	CodeInvalidAlphanumeric ErrorCode = "invalid_string.alphanumeric"
+	CodeInvalidEmoji        ErrorCode = "invalid_string.emoji"
+	CodeContainsEmoji       ErrorCode = "invalid_string.no_emoji"
+	CodeInvalidLowercase    ErrorCode = "invalid_string.lowercase"
+	CodeInvalidUppercase    ErrorCode = "invalid_string.uppercase"
+)
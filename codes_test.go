@@ -0,0 +1,221 @@
+package zogo
+
+import (
+	"testing"
+	"time"
+)
+
+// allCodes lists every exported error code
+var allCodes = []ErrorCode{
+	CodeInvalidType, CodeTooSmall, CodeTooBig, CodeInvalidLength, CodeEmpty, CodeCustom,
+	CodeInvalidEnumValue, CodeInvalidLiteral, CodeUnrecognizedKeys, CodeInvalidKey, CodeInvalidUnion,
+	CodeNotInteger, CodeNotFinite, CodeUnsafeInteger, CodeNotPositive, CodeNotNegative,
+	CodeNotNonNegative, CodeNotNonPositive, CodeNotMultipleOf,
+	CodeInvalidDate, CodeNotFuture, CodeNotPast,
+	CodeInvalidString, CodeInvalidEmail, CodeInvalidURL, CodeInvalidUUID, CodeInvalidDomain, CodeInvalidIP,
+	CodeInvalidIPv4, CodeInvalidIPv6, CodeInvalidCIDR, CodeInvalidCIDRv4, CodeInvalidCIDRv6, CodeNotInCIDR, CodeInvalidBase64, CodeInvalidBase64URL, CodeInvalidBase32, CodeInvalidDataURI, CodeInvalidHex, CodeInvalidUTF8, CodeInvalidCUID,
+	CodeInvalidCUID2, CodeInvalidULID, CodeInvalidNanoid, CodeInvalidObjectID, CodeInvalidGitSHA, CodeInvalidCron, CodeInvalidIBAN, CodeInvalidBIC, CodeInvalidISBN, CodeInvalidEAN, CodeInvalidLanguageTag, CodeInvalidCSSColor, CodeInvalidRegex,
+	CodeInvalidStartsWith, CodeInvalidEndsWith, CodeInvalidIncludes, CodeForbiddenRegex, CodeForbiddenPrefix, CodeForbiddenIncludes, CodeContainsHTML, CodeInvalidASCII, CodeInvalidPrintable,
+	CodeInvalidAlpha, CodeInvalidAlphanumeric, CodeInvalidEmoji, CodeContainsEmoji,
+	CodeInvalidLowercase, CodeInvalidUppercase,
+	CodeInvalidPhone,
+	CodeInvalidCountry,
+	CodeInvalidCurrency,
+	CodeWeakPassword,
+	CodeInvalidUsername,
+	CodeUnsupportedVersion,
+	CodeTooDeep,
+	CodeCanceled,
+	CodeCheckFailed,
+	CodeInvalidJSON,
+	CodePayloadTooLarge,
+	CodeUnreadableBody, CodeUnsupportedMediaType,
+	CodeRequiredWith,
+	CodeRequiredWithout,
+	CodeMutuallyExclusive,
+	CodeInvalidDiscriminator,
+	CodeInvalidKeyOrder,
+	CodeDuplicateKey,
+	CodeAmbiguousUnion,
+	CodeNotBusinessHours,
+	CodeHoliday,
+	CodeNotWeekday,
+	CodeInvalidIntersection,
+	CodeNotWithinLast,
+	CodeNotWithinNext,
+	CodeQuotaExceeded,
+	CodeRefinementPanic,
+	CodeInvalidDecimal,
+	CodeDecimalPrecision,
+}
+
+// Test codes stay plain strings, so code written against string codes
+// keeps compiling
+func TestErrorCodesAreStrings(t *testing.T) {
+	code := "too_small"
+	result := FailureWithCode("Too small", code)
+	if result.Errors[0].Code != CodeTooSmall {
+		t.Errorf("Expected %q, got %q", CodeTooSmall, result.Errors[0].Code)
+	}
+
+	var fromConstant string = CodeInvalidEmail
+	err := ValidationError{Code: fromConstant}
+	if err.Code != "invalid_string.email" {
+		t.Errorf("Expected the constant's value, got %q", err.Code)
+	}
+}
+
+// Test country and currency codes follow the invalid_string.<format>
+// naming of the other string formats
+func TestStringFormatCodesShareNamespace(t *testing.T) {
+	tests := map[ErrorCode]ParseResult{
+		"invalid_string.country":  String().CountryCode().Parse("XX"),
+		"invalid_string.currency": String().CurrencyCode().Parse("XXX"),
+	}
+	for code, result := range tests {
+		if result.Ok || result.Errors[0].Code != code {
+			t.Errorf("Expected %s, got %v", code, result.Errors)
+		}
+	}
+}
+
+// Test every rule emits the expected error code
+func TestErrorCodes(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		schema Validator
+		input  any
+		code   ErrorCode
+	}{
+		{"string type", String(), 1, CodeInvalidType},
+		{"string null", String(), nil, CodeInvalidType},
+		{"string min", String().Min(3), "a", CodeTooSmall},
+		{"string max", String().Max(1), "ab", CodeTooBig},
+		{"string length", String().Length(2), "a", CodeInvalidLength},
+		{"email", String().Email(), "x", CodeInvalidEmail},
+		{"url", String().URL(), "x", CodeInvalidURL},
+		{"uuid", String().UUID(), "x", CodeInvalidUUID},
+		{"domain", String().Domain(), "x", CodeInvalidDomain},
+		{"ip", String().IP(), "x", CodeInvalidIP},
+		{"ipv4", String().IPv4(), "x", CodeInvalidIPv4},
+		{"ipv6", String().IPv6(), "x", CodeInvalidIPv6},
+		{"base64", String().Base64(), "x", CodeInvalidBase64},
+		{"base64url", String().Base64URL(), "a+b", CodeInvalidBase64URL},
+		{"base32", String().Base32(), "abc", CodeInvalidBase32},
+		{"data uri", String().DataURI(), "image/png;base64,AA==", CodeInvalidDataURI},
+		{"hex", String().Hex(), "x", CodeInvalidHex},
+		{"cuid", String().CUID(), "x", CodeInvalidCUID},
+		{"cuid2", String().CUID2(), "x", CodeInvalidCUID2},
+		{"ulid", String().ULID(), "x", CodeInvalidULID},
+		{"nanoid", String().Nanoid(), "x", CodeInvalidNanoid},
+		{"objectid", String().ObjectID(), "x", CodeInvalidObjectID},
+		{"git sha", String().GitSHA(), "abc", CodeInvalidGitSHA},
+		{"cron", String().Cron(), "* * *", CodeInvalidCron},
+		{"iban", String().IBAN(), "DE00", CodeInvalidIBAN},
+		{"bic", String().BIC(), "DEUT", CodeInvalidBIC},
+		{"isbn", String().ISBN(), "123", CodeInvalidISBN},
+		{"ean", String().EAN(), "123", CodeInvalidEAN},
+		{"cidr", String().CIDR(), "10.0.0.0", CodeInvalidCIDR},
+		{"in cidr", String().InCIDR("10.0.0.0/8"), "11.0.0.1", CodeNotInCIDR},
+		{"country code", String().CountryCode(), "XX", CodeInvalidCountry},
+		{"currency code", String().CurrencyCode(), "XXX", CodeInvalidCurrency},
+		{"non-empty", String().Trim().NonEmpty(), "  ", CodeEmpty},
+		{"username", String().Username(), "root", CodeInvalidUsername},
+		{"password", String().Password(PasswordPolicy{RequireDigit: true}), "password", CodeWeakPassword},
+		{"language tag", String().LanguageTag(), "en--US", CodeInvalidLanguageTag},
+		{"css color", String().CSSColor(), "#12", CodeInvalidCSSColor},
+		{"regex", String().Regex("^a$"), "b", CodeInvalidRegex},
+		{"starts with", String().StartsWith("a"), "b", CodeInvalidStartsWith},
+		{"ends with", String().EndsWith("a"), "b", CodeInvalidEndsWith},
+		{"contains", String().Contains("a"), "b", CodeInvalidIncludes},
+		{"not regex", String().NotRegex("^test_"), "test_user", CodeForbiddenRegex},
+		{"not starts with", String().NotStartsWith("test_"), "test_user", CodeForbiddenPrefix},
+		{"not contains", String().NotContains("@"), "a@b", CodeForbiddenIncludes},
+		{"no html", String().NoHTML(), "<b>hi</b>", CodeContainsHTML},
+		{"ascii", String().ASCII(), "é", CodeInvalidASCII},
+		{"printable", String().Printable(), "a\tb", CodeInvalidPrintable},
+		{"alpha", String().Alpha(), "a1", CodeInvalidAlpha},
+		{"alphanumeric", String().Alphanumeric(), "a_1", CodeInvalidAlphanumeric},
+		{"emoji", String().Emoji(), "a", CodeInvalidEmoji},
+		{"no emoji", String().NoEmoji(), "hi 👋", CodeContainsEmoji},
+		{"lowercase", String().Lowercase(), "Abc", CodeInvalidLowercase},
+		{"uppercase", String().Uppercase(), "Abc", CodeInvalidUppercase},
+		{"string refine", String().Refine(func(string) bool { return false }, "no"), "a", CodeCustom},
+		{"number type", Number(), "1", CodeInvalidType},
+		{"number min", Number().Min(5), 1, CodeTooSmall},
+		{"number max", Number().Max(5), 10, CodeTooBig},
+		{"int", Number().Int(), 1.5, CodeNotInteger},
+		{"positive", Number().Positive(), 0, CodeNotPositive},
+		{"negative", Number().Negative(), 0, CodeNotNegative},
+		{"nonnegative", Number().NonNegative(), -1, CodeNotNonNegative},
+		{"nonpositive", Number().NonPositive(), 1, CodeNotNonPositive},
+		{"safe", Number().Safe(), 1e20, CodeUnsafeInteger},
+		{"multiple of", Number().MultipleOf(3), 4, CodeNotMultipleOf},
+		{"boolean type", Boolean(), "true", CodeInvalidType},
+		{"bytes min", Bytes().Min(2), []byte("a"), CodeTooSmall},
+		{"bytes utf8", Bytes().UTF8(), []byte{0xff}, CodeInvalidUTF8},
+		{"bytes base64", Bytes().Base64(), "!", CodeInvalidBase64},
+		{"date string", Date(), "not a date", CodeInvalidDate},
+		{"date future", Date().Future(), past, CodeNotFuture},
+		{"date past", Date().Past(), future, CodeNotPast},
+		{"date min", Date().Min(future), past, CodeTooSmall},
+		{"date max", Date().Max(past), future, CodeTooBig},
+		{"date business hours", Date().WithinBusinessHours(NewBusinessCalendar(time.UTC)), past, CodeNotBusinessHours},
+		{"date holiday", Date().NotHoliday(NewBusinessCalendar(time.UTC).Holidays(past.UTC().Format(time.DateOnly))), past, CodeHoliday},
+		{"date weekday", Date().Weekday((past.Weekday() + 1) % 7), past, CodeNotWeekday},
+		{"array min", Array(String()).Min(1), []interface{}{}, CodeTooSmall},
+		{"array max", Array(String()).Max(0), []interface{}{"a"}, CodeTooBig},
+		{"array nonempty", Array(String()).NonEmpty(), []interface{}{}, CodeTooSmall},
+		{"tuple length", Tuple(String()), []interface{}{}, CodeInvalidLength},
+		{"tuple rest", Tuple(String()).Rest(String()), []interface{}{}, CodeTooSmall},
+		{"enum", Enum([]interface{}{"a"}), "b", CodeInvalidEnumValue},
+		{"literal", Literal("a"), "b", CodeInvalidLiteral},
+		{"literal null", Literal("a"), nil, CodeInvalidLiteral},
+		{"strict object", Object(Schema{}).Strict(), map[string]interface{}{"x": 1}, CodeUnrecognizedKeys},
+		{"record key", Record(String().Min(3), Any()), map[string]interface{}{"x": 1}, CodeTooSmall},
+		{"union", Union(String(), Number()), true, CodeInvalidUnion},
+		{"nested", Object(Schema{"a": Number().Int()}), map[string]interface{}{"a": 1.5}, CodeNotInteger},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.schema.Parse(tt.input)
+			if result.Ok {
+				t.Fatalf("Expected %v to fail", tt.input)
+			}
+			if result.Errors[0].Code != tt.code {
+				t.Errorf("Expected code %q, got %q (%s)", tt.code, result.Errors[0].Code, result.Errors[0].Message)
+			}
+		})
+	}
+}
+
+// Test every exported code has an English message template
+func TestErrorCodesHaveEnglishTemplates(t *testing.T) {
+	for _, code := range allCodes {
+		if _, ok := englishMessages[string(code)]; !ok {
+			t.Errorf("Missing English template for code %q", code)
+		}
+	}
+}
+
+// Test parameters interpolate into the built-in English templates
+func TestErrorParamsMatchMessage(t *testing.T) {
+	for _, schema := range []Validator{String().Min(3), Number().Max(5), Array(Any()).Min(2)} {
+		var input any = "a"
+		switch schema.(type) {
+		case *NumberValidator:
+			input = 10
+		case *ArrayValidator:
+			input = []interface{}{}
+		}
+
+		err := schema.Parse(input).Errors[0]
+		if got := err.Translate("en"); got != err.Message {
+			t.Errorf("Expected English translation %q to match message %q", got, err.Message)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package zogo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// coerceNumber converts numeric strings and booleans to float64,
+// returning other values unchanged
+func coerceNumber(value any) any {
+	switch v := value.(type) {
+	case string:
+		if num, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return num
+		}
+	case bool:
+		if v {
+			return float64(1)
+		}
+		return float64(0)
+	}
+	return value
+}
+
+// coerceBoolean converts "true"/"false" (and "1"/"0") strings and 0/1 numbers
+// to bool, returning other values unchanged
+func coerceBoolean(value any) any {
+	switch v := value.(type) {
+	case string:
+		if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+			return b
+		}
+	case float64:
+		if v == 0 || v == 1 {
+			return v == 1
+		}
+	case int:
+		if v == 0 || v == 1 {
+			return v == 1
+		}
+	}
+	return value
+}
+
+// coerceString converts numbers and booleans to their string form,
+// returning other values unchanged
+func coerceString(value any) any {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return value
+}
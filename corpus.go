@@ -0,0 +1,175 @@
+package zogo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// CorpusEntry is a payload recorded by a Recorder, one JSON object per line
+// of a corpus file
+type CorpusEntry struct {
+	Schema   string   `json:"schema,omitempty"`   // Registered name of the schema, or the WithSchemaName option
+	Payload  any      `json:"payload"`            // The input, with sensitive values redacted
+	Ok       bool     `json:"ok"`                 // Whether the payload passed validation when it was recorded
+	Redacted []string `json:"redacted,omitempty"` // Paths of the redacted values
+}
+
+// Recorder samples validated payloads into a corpus, for replaying against
+// new schema versions with ReplayCorpus before they are deployed. Values
+// marked Sensitive and fields named by RedactKeys are redacted before they
+// are written. Install one for every parse with SetRecorder, or for a single
+// call with WithRecorder:
+//
+//	file, _ := os.OpenFile("orders.corpus.jsonl", os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+//	zogo.SetRecorder(zogo.NewRecorder(file).Sample(0.001))
+type Recorder struct {
+	mu         sync.Mutex
+	w          io.Writer
+	err        error
+	sampleRate float64
+	redactKeys map[string]bool
+	random     func() float64 // Source for sampling, replaced in tests
+}
+
+// NewRecorder creates a recorder writing every validated payload to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{
+		w:          w,
+		sampleRate: 1,
+		redactKeys: map[string]bool{},
+		random:     rand.Float64,
+	}
+}
+
+// Sample records the given fraction of payloads (0 to 1)
+func (r *Recorder) Sample(rate float64) *Recorder {
+	r.sampleRate = rate
+	return r
+}
+
+// RedactKeys also redacts fields with the given names (case-insensitive)
+// anywhere in the payload, whether or not the schema marks them Sensitive
+func (r *Recorder) RedactKeys(keys ...string) *Recorder {
+	for _, key := range keys {
+		r.redactKeys[strings.ToLower(key)] = true
+	}
+	return r
+}
+
+// Err returns the first error writing to the corpus, after which nothing
+// more is recorded
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+var globalRecorder atomic.Pointer[Recorder]
+
+// SetRecorder installs a recorder for all parse calls; nil removes it
+func SetRecorder(recorder *Recorder) {
+	globalRecorder.Store(recorder)
+}
+
+// record writes a payload to the corpus, if it is sampled
+func (r *Recorder) record(validator Validator, value any, result ParseResult, name string) {
+	if r.sampleRate < 1 && r.random() >= r.sampleRate {
+		return
+	}
+	if name == "" {
+		name = registeredName(validator)
+	}
+
+	entry := CorpusEntry{Schema: name, Ok: result.Ok}
+	entry.Payload, _ = SanitizeJSON(redactPayload(value, r.redactKeys, result.Meta.Sensitive, &entry.Redacted))
+	line, err := json.Marshal(entry)
+	if err != nil {
+		// Values JSON cannot represent, such as channels, are not recorded
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err == nil {
+		_, r.err = r.w.Write(append(line, '\n'))
+	}
+}
+
+// ReplayReport summarizes a corpus replayed against a schema
+type ReplayReport struct {
+	Total        int             // Number of entries replayed
+	NewlyFailing []ReplayFailure // Entries that passed when recorded and fail now
+	NewlyPassing int             // Entries that failed when recorded and pass now
+}
+
+// ReplayFailure is a recorded payload the replayed schema rejects
+type ReplayFailure struct {
+	Line   int // Line of the entry in the corpus, from 1
+	Entry  CorpusEntry
+	Errors ValidationErrors
+}
+
+// ReplayCorpus validates the payloads of a corpus written by a Recorder
+// against validator, and reports those that passed when recorded but fail
+// now. Only entries recorded for schema are replayed, unless schema is empty.
+// Errors at redacted values are ignored, since the original value is unknown.
+//
+//	report, err := zogo.ReplayCorpus(file, "Order", orderV2)
+//	for _, failure := range report.NewlyFailing {
+//		t.Errorf("line %d: %v", failure.Line, failure.Errors)
+//	}
+func ReplayCorpus(r io.Reader, schema string, validator Validator) (ReplayReport, error) {
+	var report ReplayReport
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64<<20)
+
+	for line := 1; scanner.Scan(); line++ {
+		data := scanner.Bytes()
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
+		}
+
+		var entry CorpusEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return report, fmt.Errorf("zogo: corpus line %d: %w", line, err)
+		}
+		if schema != "" && entry.Schema != schema {
+			continue
+		}
+
+		report.Total++
+		result := ParseCtx(context.Background(), validator, entry.Payload)
+
+		var errors ValidationErrors
+		for _, err := range result.Errors {
+			if !underPaths(err.Path, entry.Redacted) {
+				errors = append(errors, err)
+			}
+		}
+
+		switch {
+		case entry.Ok && len(errors) > 0:
+			report.NewlyFailing = append(report.NewlyFailing, ReplayFailure{Line: line, Entry: entry, Errors: errors})
+		case !entry.Ok && len(errors) == 0:
+			report.NewlyPassing++
+		}
+	}
+	return report, scanner.Err()
+}
+
+// underPaths reports whether a path is at or below one of the given paths
+func underPaths(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if prefix == "" || path == prefix || strings.HasPrefix(path, prefix+".") || strings.HasPrefix(path, prefix+"[") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package zogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// Test recorded payloads are redacted and replayed against a new schema
+func TestRecorderReplay(t *testing.T) {
+	var corpus bytes.Buffer
+	recorder := NewRecorder(&corpus).RedactKeys("token")
+
+	v1 := Object(Schema{
+		"sku":      String(),
+		"quantity": Number(),
+		"password": Sensitive(String()),
+		"auth":     Object(Schema{"token": String()}),
+	})
+	inputs := []map[string]interface{}{
+		{"sku": "A-1", "quantity": 2, "password": "hunter2", "auth": map[string]interface{}{"token": "t0k3n"}},
+		{"sku": "A-2", "quantity": 0.5, "password": "hunter2", "auth": map[string]interface{}{"token": "t0k3n"}},
+		{"sku": 7, "quantity": 1, "password": "hunter2", "auth": map[string]interface{}{"token": "t0k3n"}},
+	}
+	for _, input := range inputs {
+		ParseCtx(context.Background(), v1, input, WithRecorder(recorder), WithSchemaName("Order"))
+	}
+	ParseCtx(context.Background(), String(), "other", WithRecorder(recorder), WithSchemaName("Note"))
+	if recorder.Err() != nil {
+		t.Fatal(recorder.Err())
+	}
+
+	lines := strings.Split(strings.TrimSpace(corpus.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected 4 corpus entries, got %d", len(lines))
+	}
+	var entry CorpusEntry
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	payload := entry.Payload.(map[string]interface{})
+	if entry.Schema != "Order" || !entry.Ok || payload["password"] != Redacted || payload["auth"].(map[string]interface{})["token"] != Redacted {
+		t.Errorf("Expected a redacted entry, got %s", lines[0])
+	}
+	if strings.Contains(corpus.String(), "hunter2") || strings.Contains(corpus.String(), "t0k3n") {
+		t.Error("Expected no sensitive values in the corpus")
+	}
+
+	// v2 requires integer quantities and longer passwords, and accepts numeric SKUs
+	v2 := Object(Schema{
+		"sku":      Union(String(), Number()),
+		"quantity": Number().Int(),
+		"password": Sensitive(String().Min(12)),
+		"auth":     Object(Schema{"token": String().Length(5)}),
+	})
+	report, err := ReplayCorpus(strings.NewReader(corpus.String()), "Order", v2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 3 || report.NewlyPassing != 1 || len(report.NewlyFailing) != 1 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	failure := report.NewlyFailing[0]
+	if failure.Line != 2 || len(failure.Errors) != 1 || !failure.Errors.HasPath("quantity") {
+		t.Errorf("Expected only the quantity error on line 2, got %+v", failure)
+	}
+}
+
+// Test corrupt corpus lines are reported
+func TestReplayCorpusInvalid(t *testing.T) {
+	_, err := ReplayCorpus(strings.NewReader("{\"payload\":1,\"ok\":true}\nnot json\n"), "", Number())
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected an error for line 2, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package zogo
+
+import "testing"
+
+// Test ISO 3166-1 country code validation
+func TestStringCountryCode(t *testing.T) {
+	alpha2 := String().CountryCode()
+	alpha3 := String().CountryCodeAlpha3()
+
+	for _, code := range []string{"DE", "US", "JP", "AX", "SS"} {
+		if !alpha2.Parse(code).Ok {
+			t.Errorf("Expected %q to pass CountryCode()", code)
+		}
+	}
+	for _, code := range []string{"DEU", "USA", "JPN", "ALA", "SSD"} {
+		if !alpha3.Parse(code).Ok {
+			t.Errorf("Expected %q to pass CountryCodeAlpha3()", code)
+		}
+	}
+
+	for _, code := range []string{"", "XX", "de", "DEU", "UK"} {
+		result := alpha2.Parse(code)
+		if result.Ok || result.Errors[0].Code != CodeInvalidCountry {
+			t.Errorf("Expected %q to fail CountryCode() with invalid_string.country", code)
+		}
+	}
+	for _, code := range []string{"DE", "XXX", "deu"} {
+		if alpha3.Parse(code).Ok {
+			t.Errorf("Expected %q to fail CountryCodeAlpha3()", code)
+		}
+	}
+
+	// Every alpha-2 code has a distinct alpha-3 code
+	if len(alpha3Countries) != len(countryAlpha3) {
+		t.Errorf("Expected %d alpha-3 codes, got %d", len(countryAlpha3), len(alpha3Countries))
+	}
+}
@@ -0,0 +1,149 @@
+package zogo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cronField describes the values allowed in one field of a cron expression
+type cronField struct {
+	min, max int
+	names    []string // Names for the values from min, accepted outside strict mode
+}
+
+// cronFields lists the fields of a six-field expression. Five-field
+// expressions omit the leading seconds.
+var cronFields = [...]cronField{
+	{min: 0, max: 59}, // Second
+	{min: 0, max: 59}, // Minute
+	{min: 0, max: 23}, // Hour
+	{min: 1, max: 31}, // Day of month
+	{min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}, // Day of week, 0 and 7 are Sunday
+}
+
+const (
+	cronDayOfMonth = 3
+	cronDayOfWeek  = 5
+)
+
+// cronMacros are the predefined schedules accepted outside strict mode
+var cronMacros = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// isValidCron checks a cron expression with five fields (minute, hour, day
+// of month, month, day of week) or six with leading seconds. Each field is
+// "*", a value, a range "a-b", either followed by a step "/n", or a comma
+// separated list of those. Unless strict, it also accepts month and day
+// names, @daily style macros, and the "?", "L", "W" and "#" day extensions.
+func isValidCron(expr string, strict bool) bool {
+	fields := strings.Fields(expr)
+	if !strict && len(fields) == 1 {
+		return cronMacros[strings.ToLower(fields[0])]
+	}
+
+	offset := 0
+	switch len(fields) {
+	case 5:
+		offset = 1
+	case 6:
+	default:
+		return false
+	}
+
+	for i, field := range fields {
+		index := i + offset
+		if !strict && field == "?" && (index == cronDayOfMonth || index == cronDayOfWeek) {
+			continue
+		}
+		for _, part := range strings.Split(field, ",") {
+			if !isValidCronPart(part, index, strict) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isValidCronPart checks one list element of the field at index
+func isValidCronPart(part string, index int, strict bool) bool {
+	spec := cronFields[index]
+	if !strict && isValidCronDayExtension(part, index) {
+		return true
+	}
+
+	base, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		if n, ok := cronNumber(step); !ok || n < 1 || n > spec.max {
+			return false
+		}
+	}
+	if base == "*" {
+		return true
+	}
+
+	lo, hi, isRange := strings.Cut(base, "-")
+	from, ok := cronValue(lo, spec, strict)
+	if !ok {
+		return false
+	}
+	if !isRange {
+		return true
+	}
+	to, ok := cronValue(hi, spec, strict)
+	return ok && from <= to
+}
+
+// isValidCronDayExtension checks the day extensions: "L" (last day), "LW"
+// (last weekday), "15W" (weekday nearest the 15th) in the day of month, and
+// "5L" (last Friday) and "5#3" (third Friday) in the day of week
+func isValidCronDayExtension(part string, index int) bool {
+	spec := cronFields[index]
+	switch index {
+	case cronDayOfMonth:
+		if part == "L" || part == "LW" {
+			return true
+		}
+		if day, ok := strings.CutSuffix(part, "W"); ok {
+			_, ok := cronValue(day, spec, true)
+			return ok
+		}
+	case cronDayOfWeek:
+		if day, ok := strings.CutSuffix(part, "L"); ok {
+			_, ok := cronValue(day, spec, false)
+			return ok
+		}
+		if day, nth, ok := strings.Cut(part, "#"); ok {
+			n, ok := cronNumber(nth)
+			_, valid := cronValue(day, spec, false)
+			return ok && valid && n >= 1 && n <= 5
+		}
+	}
+	return false
+}
+
+// cronValue parses a number, or unless strict a name, within the field's range
+func cronValue(s string, spec cronField, strict bool) (int, bool) {
+	if n, ok := cronNumber(s); ok {
+		return n, n >= spec.min && n <= spec.max
+	}
+	if !strict {
+		for i, name := range spec.names {
+			if strings.EqualFold(s, name) {
+				return spec.min + i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// cronNumber parses a plain non-negative decimal number
+func cronNumber(s string) (int, bool) {
+	if s == "" || len(s) > 2 || !isDigits(s) {
+		return 0, false
+	}
+	n, _ := strconv.Atoi(s)
+	return n, true
+}
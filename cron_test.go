@@ -0,0 +1,54 @@
+package zogo
+
+import "testing"
+
+// Test cron expression validation
+func TestStringCron(t *testing.T) {
+	tests := []struct {
+		expr   string
+		cron   bool // Valid for Cron
+		strict bool // Valid for CronStrict
+	}{
+		{"* * * * *", true, true},
+		{"*/15 9-17 * * 1-5", true, true},
+		{"0 0 1,15 * *", true, true},
+		{"30 0 0 1 1 *", true, true},           // With seconds
+		{"0 0 * * 7", true, true},              // 7 is Sunday
+		{"0 8-18/2 * * *", true, true},         // Range with step
+		{"0 0 * JAN-MAR MON-FRI", true, false}, // Names
+		{"@daily", true, false},
+		{"0 0 ? * MON", true, false},
+		{"0 0 L * *", true, false},
+		{"0 0 15W * *", true, false},
+		{"0 0 * * 5L", true, false},
+		{"0 0 * * FRI#3", true, false},
+		{"", false, false},
+		{"* * * *", false, false},       // Too few fields
+		{"* * * * * * *", false, false}, // Too many fields
+		{"60 * * * *", false, false},    // Minute out of range
+		{"* 24 * * *", false, false},    // Hour out of range
+		{"* * 0 * *", false, false},     // Day of month out of range
+		{"* * * 13 *", false, false},    // Month out of range
+		{"* * * * 8", false, false},     // Day of week out of range
+		{"5-1 * * * *", false, false},   // Reversed range
+		{"*/0 * * * *", false, false},   // Zero step
+		{"1,,2 * * * *", false, false},  // Empty list element
+		{"0 0 * * FRI#6", false, false}, // No sixth Friday
+		{"0 0 * * MON,?", false, false}, // ? in a list
+		{"@sometimes", false, false},
+	}
+
+	for _, tt := range tests {
+		if got := String().Cron().Parse(tt.expr).Ok; got != tt.cron {
+			t.Errorf("Cron().Parse(%q).Ok = %v, want %v", tt.expr, got, tt.cron)
+		}
+		if got := String().CronStrict().Parse(tt.expr).Ok; got != tt.strict {
+			t.Errorf("CronStrict().Parse(%q).Ok = %v, want %v", tt.expr, got, tt.strict)
+		}
+	}
+
+	result := String().Cron().Parse("every day")
+	if result.Ok || result.Errors[0].Code != CodeInvalidCron {
+		t.Errorf("Expected invalid_string.cron, got %v", result.Errors)
+	}
+}
@@ -0,0 +1,172 @@
+package zogo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cssNamedColors are the named colors of CSS Color Level 4, with the
+// transparent and currentcolor keywords
+var cssNamedColors = map[string]bool{
+	"aliceblue": true, "antiquewhite": true, "aqua": true, "aquamarine": true,
+	"azure": true, "beige": true, "bisque": true, "black": true, "blanchedalmond": true,
+	"blue": true, "blueviolet": true, "brown": true, "burlywood": true, "cadetblue": true,
+	"chartreuse": true, "chocolate": true, "coral": true, "cornflowerblue": true,
+	"cornsilk": true, "crimson": true, "cyan": true, "darkblue": true, "darkcyan": true,
+	"darkgoldenrod": true, "darkgray": true, "darkgreen": true, "darkgrey": true,
+	"darkkhaki": true, "darkmagenta": true, "darkolivegreen": true, "darkorange": true,
+	"darkorchid": true, "darkred": true, "darksalmon": true, "darkseagreen": true,
+	"darkslateblue": true, "darkslategray": true, "darkslategrey": true,
+	"darkturquoise": true, "darkviolet": true, "deeppink": true, "deepskyblue": true,
+	"dimgray": true, "dimgrey": true, "dodgerblue": true, "firebrick": true,
+	"floralwhite": true, "forestgreen": true, "fuchsia": true, "gainsboro": true,
+	"ghostwhite": true, "gold": true, "goldenrod": true, "gray": true, "green": true,
+	"greenyellow": true, "grey": true, "honeydew": true, "hotpink": true, "indianred": true,
+	"indigo": true, "ivory": true, "khaki": true, "lavender": true, "lavenderblush": true,
+	"lawngreen": true, "lemonchiffon": true, "lightblue": true, "lightcoral": true,
+	"lightcyan": true, "lightgoldenrodyellow": true, "lightgray": true, "lightgreen": true,
+	"lightgrey": true, "lightpink": true, "lightsalmon": true, "lightseagreen": true,
+	"lightskyblue": true, "lightslategray": true, "lightslategrey": true,
+	"lightsteelblue": true, "lightyellow": true, "lime": true, "limegreen": true,
+	"linen": true, "magenta": true, "maroon": true, "mediumaquamarine": true,
+	"mediumblue": true, "mediumorchid": true, "mediumpurple": true, "mediumseagreen": true,
+	"mediumslateblue": true, "mediumspringgreen": true, "mediumturquoise": true,
+	"mediumvioletred": true, "midnightblue": true, "mintcream": true, "mistyrose": true,
+	"moccasin": true, "navajowhite": true, "navy": true, "oldlace": true, "olive": true,
+	"olivedrab": true, "orange": true, "orangered": true, "orchid": true,
+	"palegoldenrod": true, "palegreen": true, "paleturquoise": true, "palevioletred": true,
+	"papayawhip": true, "peachpuff": true, "peru": true, "pink": true, "plum": true,
+	"powderblue": true, "purple": true, "rebeccapurple": true, "red": true,
+	"rosybrown": true, "royalblue": true, "saddlebrown": true, "salmon": true,
+	"sandybrown": true, "seagreen": true, "seashell": true, "sienna": true, "silver": true,
+	"skyblue": true, "slateblue": true, "slategray": true, "slategrey": true, "snow": true,
+	"springgreen": true, "steelblue": true, "tan": true, "teal": true, "thistle": true,
+	"tomato": true, "turquoise": true, "violet": true, "wheat": true, "white": true,
+	"whitesmoke": true, "yellow": true, "yellowgreen": true,
+	"transparent": true, "currentcolor": true,
+}
+
+// isValidCSSColor checks a CSS color value: a hex color ("#0af", "#00aaff80"),
+// a named color, or an rgb(), rgba(), hsl() or hsla() function in either the
+// comma separated or the space separated syntax, such as "rgb(0 170 255 / 50%)".
+// Names and functions are case-insensitive. Components must be within their
+// range rather than being clamped.
+func isValidCSSColor(s string) bool {
+	lower := strings.ToLower(s)
+	if hex, ok := strings.CutPrefix(lower, "#"); ok {
+		return (len(hex) == 3 || len(hex) == 4 || len(hex) == 6 || len(hex) == 8) && isValidHex(hex)
+	}
+	if cssNamedColors[lower] {
+		return true
+	}
+
+	name, args, ok := strings.Cut(lower, "(")
+	if !ok || !strings.HasSuffix(args, ")") {
+		return false
+	}
+	components, alpha, legacy, ok := cssColorArgs(strings.TrimSuffix(args, ")"))
+	if !ok || (alpha != "" && !isCSSAlpha(alpha)) {
+		return false
+	}
+
+	switch name {
+	case "rgb", "rgba":
+		percents := 0
+		for _, component := range components {
+			if !isCSSChannel(component) {
+				return false
+			}
+			if strings.HasSuffix(component, "%") {
+				percents++
+			}
+		}
+		// The comma syntax may not mix numbers and percentages
+		return !legacy || percents == 0 || percents == 3
+	case "hsl", "hsla":
+		return isCSSHue(components[0]) &&
+			isCSSPercentage(components[1], legacy) && isCSSPercentage(components[2], legacy)
+	}
+	return false
+}
+
+// cssColorArgs splits the arguments of a color function into its three
+// components and optional alpha, reporting whether the comma syntax was used
+func cssColorArgs(args string) (components []string, alpha string, legacy bool, ok bool) {
+	if strings.Contains(args, ",") {
+		parts := strings.Split(args, ",")
+		if len(parts) != 3 && len(parts) != 4 {
+			return nil, "", false, false
+		}
+		for i, part := range parts {
+			parts[i] = strings.TrimSpace(part)
+		}
+		if len(parts) == 4 {
+			alpha = parts[3]
+			if alpha == "" {
+				return nil, "", false, false
+			}
+		}
+		return parts[:3], alpha, true, true
+	}
+
+	main, alpha, hasAlpha := strings.Cut(args, "/")
+	components = strings.Fields(main)
+	alpha = strings.TrimSpace(alpha)
+	if len(components) != 3 || (hasAlpha && alpha == "") {
+		return nil, "", false, false
+	}
+	return components, alpha, false, true
+}
+
+// isCSSChannel checks an rgb() channel: 0 to 255, or 0% to 100%
+func isCSSChannel(s string) bool {
+	if pct, ok := strings.CutSuffix(s, "%"); ok {
+		n, ok := cssNumber(pct)
+		return ok && n >= 0 && n <= 100
+	}
+	n, ok := cssNumber(s)
+	return ok && n >= 0 && n <= 255
+}
+
+// isCSSPercentage checks the saturation or lightness of hsl(): 0% to 100%,
+// which the space separated syntax also allows without the percent sign
+func isCSSPercentage(s string, legacy bool) bool {
+	pct, ok := strings.CutSuffix(s, "%")
+	if !ok && legacy {
+		return false
+	}
+	n, ok := cssNumber(pct)
+	return ok && n >= 0 && n <= 100
+}
+
+// isCSSHue checks a hue: a number of degrees, or an angle in deg, rad, grad
+// or turn
+func isCSSHue(s string) bool {
+	for _, unit := range []string{"deg", "grad", "rad", "turn"} {
+		if angle, ok := strings.CutSuffix(s, unit); ok {
+			s = angle
+			break
+		}
+	}
+	_, ok := cssNumber(s)
+	return ok
+}
+
+// isCSSAlpha checks an alpha value: 0 to 1, or 0% to 100%
+func isCSSAlpha(s string) bool {
+	if pct, ok := strings.CutSuffix(s, "%"); ok {
+		n, ok := cssNumber(pct)
+		return ok && n >= 0 && n <= 100
+	}
+	n, ok := cssNumber(s)
+	return ok && n >= 0 && n <= 1
+}
+
+// cssNumber parses a CSS number such as "12", "-0.5", ".5" or "1e2"
+func cssNumber(s string) (float64, bool) {
+	if s == "" || strings.Trim(s, "0123456789.+-e") != "" {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	return n, err == nil
+}
@@ -0,0 +1,42 @@
+package zogo
+
+import "testing"
+
+// Test CSS color validation
+func TestStringCSSColor(t *testing.T) {
+	schema := String().CSSColor()
+
+	valid := []string{
+		"#0af", "#0af8", "#00AAFF", "#00aaff80",
+		"red", "RebeccaPurple", "transparent", "currentColor",
+		"rgb(0, 170, 255)", "rgba(0, 170, 255, 0.5)", "rgb(0%, 50%, 100%)",
+		"rgb(0 170 255)", "rgb(0 170 255 / 50%)", "RGBA(0 66.7% 255 / .5)",
+		"rgba(0,0,0,0)", "rgb(0, 170, 255, 1)",
+		"hsl(200, 100%, 50%)", "hsla(200, 100%, 50%, 0.3)", "hsl(0.5turn 100% 50%)",
+		"hsl(200deg 100 50 / 25%)", "hsl(-120, 0%, 0%)", "hsl(3.14rad, 50%, 50%)",
+	}
+	for _, color := range valid {
+		if result := schema.Parse(color); !result.Ok {
+			t.Errorf("Expected %q to pass, got %v", color, result.Errors)
+		}
+	}
+
+	invalid := []string{
+		"", "#", "#12", "#12345", "#ggg", "notacolor",
+		"rgb(256, 0, 0)", "rgb(0, 0)", "rgb(0, 0, 0, 0, 0)", "rgb(0, 50%, 0)",
+		"rgb(0 0 0 0)", "rgb(0 0 0 /)", "rgb(0, 0, 0 / 1)", "rgba(0, 0, 0, 1.5)",
+		"rgb(0, 0, 0", "rgb(inf, 0, 0)", "rgb(0x10, 0, 0)",
+		"hsl(200, 100, 50)", "hsl(200, 101%, 50%)", "hsl(200px 50% 50%)",
+		"cmyk(0, 0, 0, 0)",
+	}
+	for _, color := range invalid {
+		result := schema.Parse(color)
+		if result.Ok {
+			t.Errorf("Expected %q to fail", color)
+			continue
+		}
+		if result.Errors[0].Code != CodeInvalidCSSColor {
+			t.Errorf("Expected invalid_string.css_color for %q, got %s", color, result.Errors[0].Code)
+		}
+	}
+}
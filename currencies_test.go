@@ -0,0 +1,22 @@
+package zogo
+
+import "testing"
+
+// Test ISO 4217 currency code validation
+func TestStringCurrencyCode(t *testing.T) {
+	schema := String().CurrencyCode()
+
+	for _, code := range []string{"EUR", "USD", "JPY", "CHF", "XAU", "SLE", "ZWG"} {
+		if !schema.Parse(code).Ok {
+			t.Errorf("Expected %q to pass CurrencyCode()", code)
+		}
+	}
+
+	// Unknown, lower case, withdrawn and non-currency codes
+	for _, code := range []string{"", "eur", "EURO", "ABC", "DEM", "HRK", "SLL", "XXX", "XTS"} {
+		result := schema.Parse(code)
+		if result.Ok || result.Errors[0].Code != CodeInvalidCurrency {
+			t.Errorf("Expected %q to fail CurrencyCode() with invalid_string.currency", code)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package zogo
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+)
+
+// parseDataURI parses a data URI (RFC 2397), "data:[<mediatype>][;base64],<data>",
+// such as "data:image/png;base64,iVBORw0KGgo=", and returns the size of its
+// decoded content. The media type, when present, must be a valid MIME type
+// with optional parameters.
+func parseDataURI(s string) (int, bool) {
+	rest, ok := cutPrefixFold(s, "data:")
+	if !ok {
+		return 0, false
+	}
+	header, payload, ok := strings.Cut(rest, ",")
+	if !ok {
+		return 0, false
+	}
+
+	header, isBase64 := strings.CutSuffix(header, ";base64")
+	if header != "" && !isValidMediaType(header) {
+		return 0, false
+	}
+
+	if isBase64 {
+		data, err := base64.StdEncoding.DecodeString(payload)
+		return len(data), err == nil
+	}
+	data, err := url.PathUnescape(payload)
+	return len(data), err == nil
+}
+
+// isValidMediaType checks a MIME type such as "image/png", optionally
+// followed by parameters such as ";charset=utf-8". Parameter values may be
+// quoted. A data URI may omit the type and give only parameters.
+func isValidMediaType(s string) bool {
+	params := strings.Split(s, ";")
+	if params[0] != "" {
+		typ, subtype, ok := strings.Cut(params[0], "/")
+		if !ok || !isMIMEToken(typ) || !isMIMEToken(subtype) {
+			return false
+		}
+	}
+	for _, param := range params[1:] {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok || !isMIMEToken(name) {
+			return false
+		}
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			continue
+		}
+		if !isMIMEToken(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// isMIMEToken checks a non-empty RFC 2045 token: printable ASCII other than
+// space and the special characters ()<>@,;:\"/[]?=
+func isMIMEToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c <= ' ' || c >= 0x7F || strings.IndexByte(`()<>@,;:\"/[]?=`, c) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// cutPrefixFold is strings.CutPrefix ignoring ASCII case
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
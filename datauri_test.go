@@ -0,0 +1,56 @@
+package zogo
+
+import "testing"
+
+// Test DataURI checks the media type and the encoded content
+func TestStringDataURI(t *testing.T) {
+	schema := String().DataURI()
+
+	valid := []string{
+		"data:image/png;base64,iVBORw0KGgo=",
+		"DATA:image/svg+xml;base64,PHN2Zz4=",
+		"data:text/plain;charset=utf-8,Hello%20World",
+		`data:text/plain;charset="utf-8",hi`,
+		"data:,Hello",
+		"data:;base64,SGk=",
+		"data:application/vnd.ms-excel;base64,",
+	}
+	for _, s := range valid {
+		if result := schema.Parse(s); !result.Ok {
+			t.Errorf("Expected %q to pass DataURI(), got %v", s, result.Errors)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"image/png;base64,AA==",
+		"data:image/png;base64",       // No payload separator
+		"data:image;base64,AA==",      // No subtype
+		"data:image/p ng;base64,AA==", // Space in the type
+		"data:image/png;base64,AA=A",  // Bad base64
+		"data:image/png;charset,AA",   // Parameter without value
+		"data:text/plain,100%",        // Bad percent encoding
+	}
+	for _, s := range invalid {
+		if schema.Parse(s).Ok {
+			t.Errorf("Expected %q to fail DataURI()", s)
+		}
+	}
+}
+
+// Test MaxDataSize limits the decoded size, not the encoded length
+func TestStringDataURIMaxSize(t *testing.T) {
+	schema := String().DataURI().MaxDataSize(4)
+
+	if !schema.Parse("data:application/octet-stream;base64,AAECAw==").Ok {
+		t.Error("Expected 4 decoded bytes to pass MaxDataSize(4)")
+	}
+	if !schema.Parse("data:text/plain,%41%42%43%44").Ok {
+		t.Error("Expected 4 percent-decoded bytes to pass MaxDataSize(4)")
+	}
+
+	result := schema.Parse("data:application/octet-stream;base64,AAECAwQ=")
+	if result.Ok || result.Errors[0].Code != CodeTooBig || result.Errors[0].Params["maximum"] != 4 {
+		t.Errorf("Expected 5 decoded bytes to fail with too_big, got %v", result.Errors)
+	}
+}
@@ -2,27 +2,61 @@ package zogo
 
 import (
 	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"time"
 )
 
 // DateValidator validates date/time values
 type DateValidator struct {
 	// Validation rules
-	minDate *time.Time
-	maxDate *time.Time
+	minDate      *time.Time
+	maxDate      *time.Time
+	minExclusive bool // Set by Gt, so minDate itself fails
+	maxExclusive bool // Set by Lt, so maxDate itself fails
 
 	// Type checks
 	isFuture bool
 	isPast   bool
+	dateOnly bool
+
+	// Unit of numeric Unix timestamps, or 0 if numbers are rejected
+	unixUnit time.Duration
+
+	// Accepted string layouts, or nil for the defaults
+	layouts []string
+
+	// Timezone rules
+	requireUTC bool
+	location   *time.Location
+
+	// Relative rules, evaluated against the clock
+	withinLast *time.Duration
+	withinNext *time.Duration
+	minAge     *int
+	maxAge     *int
+	clock      func() time.Time
+
+	// Calendar rules
+	businessHours Calendar
+	holidays      Calendar
+	weekdays      []time.Weekday // Allowed days, or nil for any day
 
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
-	defaultVal *time.Time
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  *time.Time
+	defaultFunc func() time.Time // Set by DefaultFunc, called on each parse
 
 	// Custom validators
-	refinements []DateRefinement
+	refinements      []DateRefinement
+	ctxRefinements   []CheckFunc
+	superRefinements []SuperRefineFunc
+
+	examples schemaExamples // Declared with Examples
 }
 
 // DateRefinement holds custom validation logic for dates
@@ -38,13 +72,94 @@ func Date() *DateValidator {
 
 // Min sets the minimum date
 func (v *DateValidator) Min(date time.Time) *DateValidator {
-	v.minDate = &date
+	v.minDate, v.minExclusive = &date, false
 	return v
 }
 
 // Max sets the maximum date
 func (v *DateValidator) Max(date time.Time) *DateValidator {
-	v.maxDate = &date
+	v.maxDate, v.maxExclusive = &date, false
+	return v
+}
+
+// Gt requires the date to be strictly after date
+func (v *DateValidator) Gt(date time.Time) *DateValidator {
+	v.minDate, v.minExclusive = &date, true
+	return v
+}
+
+// Gte requires the date to be at or after date, like Min
+func (v *DateValidator) Gte(date time.Time) *DateValidator {
+	return v.Min(date)
+}
+
+// Lt requires the date to be strictly before date
+func (v *DateValidator) Lt(date time.Time) *DateValidator {
+	v.maxDate, v.maxExclusive = &date, true
+	return v
+}
+
+// Lte requires the date to be at or before date, like Max
+func (v *DateValidator) Lte(date time.Time) *DateValidator {
+	return v.Max(date)
+}
+
+// Between requires the date to be within min and max, inclusive
+func (v *DateValidator) Between(min, max time.Time) *DateValidator {
+	return v.Min(min).Max(max)
+}
+
+// DateOnly accepts only calendar dates: "YYYY-MM-DD" strings, and
+// time.Time values without a time of day. Other string formats and
+// timestamps are rejected.
+func (v *DateValidator) DateOnly() *DateValidator {
+	v.dateOnly = true
+	return v
+}
+
+// Layouts restricts the accepted string formats to the given time.Parse
+// layouts, tried in order, such as Layouts(time.RFC3339). To extend the
+// defaults, pass them along: Layouts(append(DefaultDateLayouts(), "02.01.2006")...).
+func (v *DateValidator) Layouts(layouts ...string) *DateValidator {
+	v.layouts = append([]string(nil), layouts...)
+	return v
+}
+
+// Layout adds a layout to the accepted string formats, which are then
+// limited to the layouts added: Layout("02/01/2006") accepts only DD/MM/YYYY
+// dates
+func (v *DateValidator) Layout(layout string) *DateValidator {
+	v.layouts = append(v.layouts, layout)
+	return v
+}
+
+// UTC requires dates to be given in UTC: strings must end in "Z" or a zero
+// offset, and time.Time values must have a zero offset. Strings without a
+// timezone are rejected.
+func (v *DateValidator) UTC() *DateValidator {
+	v.requireUTC = true
+	return v
+}
+
+// InLocation converts parsed dates to loc, so that results are in a
+// predictable location. Strings without a timezone are read as wall time in
+// loc rather than in UTC.
+func (v *DateValidator) InLocation(loc *time.Location) *DateValidator {
+	v.location = loc
+	return v
+}
+
+// FromUnix also accepts numbers as Unix timestamps in seconds, such as
+// 1772409600 or 1772409600.5, parsed to UTC times
+func (v *DateValidator) FromUnix() *DateValidator {
+	v.unixUnit = time.Second
+	return v
+}
+
+// FromUnixMillis also accepts numbers as Unix timestamps in milliseconds,
+// like JavaScript's Date.now(), parsed to UTC times
+func (v *DateValidator) FromUnixMillis() *DateValidator {
+	v.unixUnit = time.Millisecond
 	return v
 }
 
@@ -60,6 +175,93 @@ func (v *DateValidator) Past() *DateValidator {
 	return v
 }
 
+// WithinLast requires the date to lie between d before now and now, such as
+// a webhook timestamp that must be at most 15 minutes old
+func (v *DateValidator) WithinLast(d time.Duration) *DateValidator {
+	v.withinLast = &d
+	return v
+}
+
+// WithinNext requires the date to lie between now and d after now
+func (v *DateValidator) WithinNext(d time.Duration) *DateValidator {
+	v.withinNext = &d
+	return v
+}
+
+// MinAge requires a birthdate at least years ago, in whole years as of
+// today, so MinAge(18) accepts people from their 18th birthday on
+func (v *DateValidator) MinAge(years int) *DateValidator {
+	v.minAge = &years
+	return v
+}
+
+// MaxAge requires a birthdate whose age in whole years as of today is at
+// most years
+func (v *DateValidator) MaxAge(years int) *DateValidator {
+	v.maxAge = &years
+	return v
+}
+
+// Clock sets the source of the current time for Past, Future, WithinLast,
+// WithinNext, MinAge and MaxAge, so tests can fix the time. It overrides the
+// clock set with SetClock for this schema.
+func (v *DateValidator) Clock(now func() time.Time) *DateValidator {
+	v.clock = now
+	return v
+}
+
+var globalClock atomic.Pointer[func() time.Time]
+
+// SetClock sets the source of the current time for the relative date checks
+// of all schemas; nil restores time.Now. The clock is read at most once per
+// parse call, so every date in a payload is checked against the same instant.
+func SetClock(now func() time.Time) {
+	if now == nil {
+		globalClock.Store(nil)
+		return
+	}
+	globalClock.Store(&now)
+}
+
+// clockNow returns the current time from the clock set with SetClock
+func clockNow() time.Time {
+	if now := globalClock.Load(); now != nil {
+		return (*now)()
+	}
+	return time.Now()
+}
+
+// WithinBusinessHours requires the date to fall within the working hours of
+// the calendar
+func (v *DateValidator) WithinBusinessHours(calendar Calendar) *DateValidator {
+	v.businessHours = calendar
+	return v
+}
+
+// NotHoliday requires the date not to fall on a holiday of the calendar
+func (v *DateValidator) NotHoliday(calendar Calendar) *DateValidator {
+	v.holidays = calendar
+	return v
+}
+
+// Weekday requires the date to fall on one of the given days of the week,
+// in the date's own location (see InLocation). It replaces any days set
+// before.
+func (v *DateValidator) Weekday(days ...time.Weekday) *DateValidator {
+	v.weekdays = append([]time.Weekday(nil), days...)
+	return v
+}
+
+// Weekdays requires the date to fall on Monday to Friday
+func (v *DateValidator) Weekdays() *DateValidator {
+	return v.Weekday(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+}
+
+// NotWeekend rejects dates on Saturday or Sunday, like Weekdays
+func (v *DateValidator) NotWeekend() *DateValidator {
+	return v.Weekdays()
+}
+
 // Required marks the field as required
 func (v *DateValidator) Required() *DateValidator {
 	v.isRequired = true
@@ -80,9 +282,24 @@ func (v *DateValidator) Nullable() *DateValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *DateValidator) Nullish() *DateValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
 // Default sets a default value if input is nil
 func (v *DateValidator) Default(val time.Time) *DateValidator {
-	v.defaultVal = &val
+	v.defaultVal, v.defaultFunc = &val, nil
+	return v
+}
+
+// DefaultFunc sets a function called for the default each time the input
+// is nil, such as time.Now for creation timestamps
+func (v *DateValidator) DefaultFunc(fn func() time.Time) *DateValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
 	return v
 }
 
@@ -95,15 +312,54 @@ func (v *DateValidator) Refine(check func(time.Time) bool, message string) *Date
 	return v
 }
 
+// RefineCtx adds a context-aware check, such as a database lookup. It runs
+// after all other rules pass and receives the context given to ParseCtx.
+func (v *DateValidator) RefineCtx(check CheckFunc) *DateValidator {
+	v.ctxRefinements = append(v.ctxRefinements, check)
+	return v
+}
+
+// SuperRefine adds a refinement that can report several issues, each with
+// its own path, code and message. It runs after all other rules pass.
+func (v *DateValidator) SuperRefine(refine SuperRefineFunc) *DateValidator {
+	v.superRefinements = append(v.superRefinements, refine)
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *DateValidator) Examples(valid, invalid []any) *DateValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value
 func (v *DateValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *DateValidator) parseWithState(value any, st *parseState) ParseResult {
+	// Validate the default in place of a missing date
+	if value == nil && v.defaultVal != nil {
+		value = *v.defaultVal
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+
+	result := v.parseValue(value, st.currentTime)
+	if result.Ok && value != nil {
+		return runChecks(st.ctx, v.ctxRefinements, superRefine(st.ctx, v.superRefinements, result))
+	}
+	return result
+}
+
+// parseValue validates the input value against the synchronous rules, with
+// relative checks comparing against the time returned by now
+func (v *DateValidator) parseValue(value any, now func() time.Time) ParseResult {
 	// Handle nil values based on modifiers
 	if value == nil {
-		// If default is set, use it
-		if v.defaultVal != nil {
-			return Success(*v.defaultVal)
-		}
-
 		// If optional, nil is OK
 		if v.isOptional {
 			return Success(nil)
@@ -115,87 +371,314 @@ func (v *DateValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected date, received null")
+		return FailureTypeMismatch("date", nil)
 	}
 
 	// Try to convert to time.Time
 	var dateVal time.Time
 
-	switch v := value.(type) {
+	// Whether the value states its timezone
+	hasZone := true
+
+	switch val := value.(type) {
 	case time.Time:
-		dateVal = v
+		dateVal = val
 	case string:
 		// Try parsing string as date
-		parsed, err := parseDate(v)
+		location := time.UTC
+		if v.location != nil {
+			location = v.location
+		}
+		parsed, layout, err := parseDate(val, v.stringLayouts(), location)
 		if err != nil {
-			return FailureMessage("Invalid date string: " + err.Error())
+			if v.dateOnly {
+				return dateOnlyFailure()
+			}
+			return FailureWithCode("Invalid date string: "+err.Error(), CodeInvalidDate)
 		}
-		dateVal = parsed
+		dateVal, hasZone = parsed, layoutHasZone(layout)
 	default:
-		return FailureMessage("Expected date, received " + typeof(value))
+		// Numbers are Unix timestamps if enabled
+		parsed, ok := unixTime(value, v.unixUnit)
+		if !ok {
+			return FailureTypeMismatch("date", value)
+		}
+		dateVal = parsed
+	}
+
+	// Check that there is no time of day
+	if v.dateOnly && hasTimeOfDay(dateVal) {
+		return dateOnlyFailure()
 	}
 
-	// Get current time for future/past checks
-	now := time.Now()
+	// Check the timezone
+	if _, offset := dateVal.Zone(); v.requireUTC && (!hasZone || offset != 0) {
+		return FailureWithParams("Date must be in UTC", CodeInvalidDate, map[string]any{"type": "utc"})
+	}
+	if v.location != nil {
+		dateVal = dateVal.In(v.location)
+	}
+
+	// Get current time for relative checks
+	if v.clock != nil {
+		now = v.clock
+	}
+	var current time.Time
+	if v.isFuture || v.isPast || v.withinLast != nil || v.withinNext != nil || v.minAge != nil || v.maxAge != nil {
+		current = now()
+	}
 
 	// Check if future
-	if v.isFuture && !dateVal.After(now) {
-		return FailureMessage("Date must be in the future")
+	if v.isFuture && !dateVal.After(current) {
+		return FailureWithCode("Date must be in the future", CodeNotFuture)
 	}
 
 	// Check if past
-	if v.isPast && !dateVal.Before(now) {
-		return FailureMessage("Date must be in the past")
+	if v.isPast && !dateVal.Before(current) {
+		return FailureWithCode("Date must be in the past", CodeNotPast)
+	}
+
+	// Check relative windows
+	if v.withinLast != nil && (dateVal.Before(current.Add(-*v.withinLast)) || dateVal.After(current)) {
+		return FailureWithParams(
+			fmt.Sprintf("Date must be within the last %s", *v.withinLast),
+			CodeNotWithinLast,
+			map[string]any{"duration": v.withinLast.String()},
+		)
+	}
+	if v.withinNext != nil && (dateVal.Before(current) || dateVal.After(current.Add(*v.withinNext))) {
+		return FailureWithParams(
+			fmt.Sprintf("Date must be within the next %s", *v.withinNext),
+			CodeNotWithinNext,
+			map[string]any{"duration": v.withinNext.String()},
+		)
+	}
+
+	// Check age
+	if v.minAge != nil || v.maxAge != nil {
+		age := ageAt(dateVal, current)
+		if v.minAge != nil && age < *v.minAge {
+			return FailureWithParams(
+				fmt.Sprintf("Age must be at least %d years", *v.minAge),
+				CodeTooSmall,
+				map[string]any{"type": "age", "minimum": *v.minAge},
+			)
+		}
+		if v.maxAge != nil && age > *v.maxAge {
+			return FailureWithParams(
+				fmt.Sprintf("Age must be at most %d years", *v.maxAge),
+				CodeTooBig,
+				map[string]any{"type": "age", "maximum": *v.maxAge},
+			)
+		}
 	}
 
 	// Check minimum date
+	if v.minDate != nil && v.minExclusive && !dateVal.After(*v.minDate) {
+		return FailureWithParams(
+			fmt.Sprintf("Date must be after %s", v.minDate.Format(time.RFC3339)),
+			CodeTooSmall,
+			map[string]any{"type": "date", "minimum": v.minDate.Format(time.RFC3339), "inclusive": false},
+		)
+	}
 	if v.minDate != nil && dateVal.Before(*v.minDate) {
-		return FailureMessage(fmt.Sprintf("Date must be at or after %s", v.minDate.Format(time.RFC3339)))
+		return FailureWithParams(
+			fmt.Sprintf("Date must be at or after %s", v.minDate.Format(time.RFC3339)),
+			CodeTooSmall,
+			map[string]any{"type": "date", "minimum": v.minDate.Format(time.RFC3339)},
+		)
 	}
 
 	// Check maximum date
+	if v.maxDate != nil && v.maxExclusive && !dateVal.Before(*v.maxDate) {
+		return FailureWithParams(
+			fmt.Sprintf("Date must be before %s", v.maxDate.Format(time.RFC3339)),
+			CodeTooBig,
+			map[string]any{"type": "date", "maximum": v.maxDate.Format(time.RFC3339), "inclusive": false},
+		)
+	}
 	if v.maxDate != nil && dateVal.After(*v.maxDate) {
-		return FailureMessage(fmt.Sprintf("Date must be at or before %s", v.maxDate.Format(time.RFC3339)))
+		return FailureWithParams(
+			fmt.Sprintf("Date must be at or before %s", v.maxDate.Format(time.RFC3339)),
+			CodeTooBig,
+			map[string]any{"type": "date", "maximum": v.maxDate.Format(time.RFC3339)},
+		)
+	}
+
+	// Check the calendar
+	if v.businessHours != nil && !v.businessHours.IsOpen(dateVal) {
+		return FailureWithCode("Date must be within business hours", CodeNotBusinessHours)
+	}
+	if v.holidays != nil && v.holidays.IsHoliday(dateVal) {
+		return FailureWithCode("Date must not be a holiday", CodeHoliday)
+	}
+	if v.weekdays != nil && !slices.Contains(v.weekdays, dateVal.Weekday()) {
+		days := weekdayList(v.weekdays)
+		return FailureWithParams(
+			fmt.Sprintf("Date must fall on %s", days),
+			CodeNotWeekday,
+			map[string]any{"weekdays": days},
+		)
 	}
 
 	// Run custom refinements
 	for _, refinement := range v.refinements {
 		if !refinement.Check(dateVal) {
-			return FailureMessage(refinement.Message)
+			return FailureWithCode(refinement.Message, CodeCustom)
 		}
 	}
 
 	return Success(dateVal)
 }
 
-// parseDate tries to parse a string as a date using multiple common formats
-func parseDate(s string) (time.Time, error) {
-	// List of common date formats to try
-	formats := []string{
-		time.RFC3339,          // "2006-01-02T15:04:05Z07:00"
-		time.RFC3339Nano,      // "2006-01-02T15:04:05.999999999Z07:00"
-		"2006-01-02",          // "YYYY-MM-DD"
-		"2006-01-02 15:04:05", // "YYYY-MM-DD HH:MM:SS"
-		"2006-01-02T15:04:05", // "YYYY-MM-DDTHH:MM:SS"
-		time.RFC1123,          // "Mon, 02 Jan 2006 15:04:05 MST"
-		time.RFC1123Z,         // "Mon, 02 Jan 2006 15:04:05 -0700"
-		time.RFC822,           // "02 Jan 06 15:04 MST"
-		time.RFC822Z,          // "02 Jan 06 15:04 -0700"
-		time.RFC850,           // "Monday, 02-Jan-06 15:04:05 MST"
-		"01/02/2006",          // "MM/DD/YYYY"
-		"01/02/2006 15:04:05", // "MM/DD/YYYY HH:MM:SS"
-		"02-01-2006",          // "DD-MM-YYYY"
-		"02-01-2006 15:04:05", // "DD-MM-YYYY HH:MM:SS"
+// weekdayList names the days in week order, such as "Monday, Wednesday or Friday"
+func weekdayList(days []time.Weekday) string {
+	var names []string
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if slices.Contains(days, day) {
+			names = append(names, day.String())
+		}
+	}
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// dateOnlyFailure reports a value that is not a calendar date
+func dateOnlyFailure() ParseResult {
+	return FailureWithParams("Date must be a calendar date (YYYY-MM-DD) without a time", CodeInvalidDate, map[string]any{"type": "date_only"})
+}
+
+// unixTime converts a number to the UTC time of a Unix timestamp in the
+// given unit. It reports false if value is not a number, unit is 0 or the
+// time is out of range.
+func unixTime(value any, unit time.Duration) (time.Time, bool) {
+	if unit == 0 {
+		return time.Time{}, false
 	}
 
+	var n int64
+	switch val := value.(type) {
+	case int:
+		n = int64(val)
+	case int8:
+		n = int64(val)
+	case int16:
+		n = int64(val)
+	case int32:
+		n = int64(val)
+	case int64:
+		n = val
+	case uint:
+		n = int64(val)
+	case uint8:
+		n = int64(val)
+	case uint16:
+		n = int64(val)
+	case uint32:
+		n = int64(val)
+	case uint64:
+		if val > math.MaxInt64 {
+			return time.Time{}, false
+		}
+		n = int64(val)
+	case float32:
+		return unixFloatTime(float64(val), unit)
+	case float64:
+		return unixFloatTime(val, unit)
+	default:
+		return time.Time{}, false
+	}
+
+	if unit == time.Millisecond {
+		return time.UnixMilli(n).UTC(), true
+	}
+	return time.Unix(n, 0).UTC(), true
+}
+
+// unixFloatTime converts a fractional Unix timestamp, which must lie within
+// the range of time.Duration around 1970
+func unixFloatTime(f float64, unit time.Duration) (time.Time, bool) {
+	// Convert the whole and fractional parts separately, so whole timestamps
+	// are exact
+	whole, frac := math.Modf(f)
+	if math.IsNaN(f) || math.Abs(whole) >= float64(math.MaxInt64/int64(unit)) {
+		return time.Time{}, false
+	}
+	nanos := int64(whole)*int64(unit) + int64(math.Round(frac*float64(unit)))
+	return time.Unix(0, nanos).UTC(), true
+}
+
+// ageAt returns the age in whole years at now of someone born on birth. A
+// February 29 birthday is reached on March 1 in other years.
+func ageAt(birth, now time.Time) int {
+	now = now.In(birth.Location())
+	age := now.Year() - birth.Year()
+	if birth.AddDate(age, 0, 0).After(now) {
+		age--
+	}
+	return age
+}
+
+// hasTimeOfDay reports whether t is not midnight in its location
+func hasTimeOfDay(t time.Time) bool {
+	hour, min, sec := t.Clock()
+	return hour != 0 || min != 0 || sec != 0 || t.Nanosecond() != 0
+}
+
+// stringLayouts returns the layouts strings are parsed with
+func (v *DateValidator) stringLayouts() []string {
+	switch {
+	case v.layouts != nil:
+		return v.layouts
+	case v.dateOnly:
+		return []string{time.DateOnly}
+	}
+	return defaultDateLayouts
+}
+
+// DefaultDateLayouts returns the layouts Date accepts unless restricted with
+// Layouts or DateOnly
+func DefaultDateLayouts() []string {
+	return append([]string(nil), defaultDateLayouts...)
+}
+
+// defaultDateLayouts are the common date formats Date accepts by default
+var defaultDateLayouts = []string{
+	time.RFC3339,          // "2006-01-02T15:04:05Z07:00"
+	time.RFC3339Nano,      // "2006-01-02T15:04:05.999999999Z07:00"
+	"2006-01-02",          // "YYYY-MM-DD"
+	"2006-01-02 15:04:05", // "YYYY-MM-DD HH:MM:SS"
+	"2006-01-02T15:04:05", // "YYYY-MM-DDTHH:MM:SS"
+	time.RFC1123,          // "Mon, 02 Jan 2006 15:04:05 MST"
+	time.RFC1123Z,         // "Mon, 02 Jan 2006 15:04:05 -0700"
+	time.RFC822,           // "02 Jan 06 15:04 MST"
+	time.RFC822Z,          // "02 Jan 06 15:04 -0700"
+	time.RFC850,           // "Monday, 02-Jan-06 15:04:05 MST"
+	"01/02/2006",          // "MM/DD/YYYY"
+	"01/02/2006 15:04:05", // "MM/DD/YYYY HH:MM:SS"
+	"02-01-2006",          // "DD-MM-YYYY"
+	"02-01-2006 15:04:05", // "DD-MM-YYYY HH:MM:SS"
+}
+
+// parseDate tries to parse a string as a date using each of the layouts,
+// reading times without a timezone in loc. It returns the layout that
+// matched.
+func parseDate(s string, layouts []string, loc *time.Location) (time.Time, string, error) {
 	var lastErr error
-	for _, format := range formats {
-		parsed, err := time.Parse(format, s)
+	for _, format := range layouts {
+		parsed, err := time.ParseInLocation(format, s, loc)
 		if err == nil {
-			return parsed, nil
+			return parsed, format, nil
 		}
 		lastErr = err
 	}
 
-	return time.Time{}, lastErr
+	return time.Time{}, "", lastErr
+}
+
+// layoutHasZone reports whether a layout parses a timezone offset or name
+func layoutHasZone(layout string) bool {
+	return strings.Contains(layout, "07") || strings.Contains(layout, "MST")
 }
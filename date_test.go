@@ -1,6 +1,7 @@
 package zogo
 
 import (
+	"math"
 	"testing"
 	"time"
 )
@@ -274,6 +275,44 @@ func TestDateDefault(t *testing.T) {
 	}
 }
 
+// Test the default is checked against the date's rules
+func TestDateDefaultRules(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	if result := Date().Past().Default(future).Parse(nil); result.Ok {
+		t.Errorf("Expected a future default to fail Past(), got %v", result.Value)
+	}
+	if !Date().Past().Default(time.Unix(0, 0)).Parse(nil).Ok {
+		t.Error("Expected a past default to pass Past()")
+	}
+	tomorrow := func() time.Time { return time.Now().Add(24 * time.Hour) }
+	if Date().Past().DefaultFunc(tomorrow).Parse(nil).Ok {
+		t.Error("Expected a generated future default to fail Past()")
+	}
+}
+
+// Test DefaultFunc computes the default on each parse
+func TestDateDefaultFunc(t *testing.T) {
+	calls := 0
+	schema := Date().DefaultFunc(func() time.Time {
+		calls++
+		return time.Date(2024, 1, calls, 0, 0, 0, 0, time.UTC)
+	})
+
+	first := schema.Parse(nil).Value.(time.Time)
+	second := schema.Parse(nil).Value.(time.Time)
+	if calls != 2 || first.Day() != 1 || second.Day() != 2 {
+		t.Errorf("Expected a new default per parse, got %v and %v", first, second)
+	}
+
+	schema.Parse(time.Now())
+	if calls != 2 {
+		t.Error("Expected DefaultFunc not to be called for a given value")
+	}
+	if result := schema.Default(time.Unix(0, 0)).Parse(nil); calls != 2 || !result.Value.(time.Time).Equal(time.Unix(0, 0)) {
+		t.Error("Expected Default to replace DefaultFunc")
+	}
+}
+
 // Test Refine
 func TestDateRefine(t *testing.T) {
 	// Must be a Monday
@@ -364,3 +403,357 @@ func TestDateInObjectString(t *testing.T) {
 		t.Errorf("Expected object with date string to pass. Errors: %v", result.Errors)
 	}
 }
+
+// Test WithinLast and WithinNext against a fixed clock
+func TestDateWithinLastNext(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	fresh := Date().WithinLast(15 * time.Minute).Clock(clock)
+	tests := []struct {
+		input time.Time
+		valid bool
+	}{
+		{now, true},
+		{now.Add(-15 * time.Minute), true},
+		{now.Add(-16 * time.Minute), false},
+		{now.Add(time.Second), false},
+	}
+	for _, tt := range tests {
+		result := fresh.Parse(tt.input)
+		if result.Ok != tt.valid {
+			t.Errorf("WithinLast: expected %v valid=%v, got %v", tt.input, tt.valid, result.Errors)
+		}
+	}
+
+	result := fresh.Parse("2026-03-02T11:00:00Z")
+	if result.Ok || result.Errors[0].Code != CodeNotWithinLast || result.Errors[0].Message != "Date must be within the last 15m0s" {
+		t.Errorf("Unexpected WithinLast error: %v", result.Errors)
+	}
+
+	upcoming := Date().WithinNext(30 * 24 * time.Hour).Clock(clock)
+	if !upcoming.Parse("2026-03-20").Ok {
+		t.Error("Expected a date in 18 days to pass WithinNext(30 days)")
+	}
+	if result := upcoming.Parse("2026-04-10"); result.Ok || result.Errors[0].Code != CodeNotWithinNext {
+		t.Errorf("Expected a date in 39 days to fail, got %v", result.Errors)
+	}
+	if upcoming.Parse("2026-03-01").Ok {
+		t.Error("Expected a past date to fail WithinNext")
+	}
+
+	// The clock also drives Past and Future
+	if !Date().Past().Clock(clock).Parse("2026-03-02T11:59:00Z").Ok || Date().Future().Clock(clock).Parse("2026-03-02T11:59:00Z").Ok {
+		t.Error("Expected Past and Future to use the clock")
+	}
+}
+
+// Test SetClock drives relative checks and is read once per parse
+func TestSetClock(t *testing.T) {
+	start := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	SetClock(func() time.Time {
+		calls++
+		return start.Add(time.Duration(calls-1) * time.Hour)
+	})
+	defer SetClock(nil)
+
+	schema := Array(Date().WithinLast(30 * time.Minute).Past())
+	result := schema.Parse([]interface{}{"2026-03-02T11:45:00Z", "2026-03-02T11:50:00Z", "2026-03-02T11:55:00Z"})
+	if !result.Ok {
+		t.Errorf("Expected all dates to be checked against one instant, got %v", result.Errors)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the clock to be read once, got %d", calls)
+	}
+
+	if schema.Parse([]interface{}{"2026-03-02T11:45:00Z"}).Ok {
+		t.Error("Expected the next parse to read the clock again")
+	}
+	if Array(String()).Parse([]interface{}{"a"}); calls != 2 {
+		t.Errorf("Expected parses without date checks not to read the clock, got %d calls", calls)
+	}
+
+	// A schema's own clock takes precedence
+	fixed := Date().Future().Clock(func() time.Time { return start.Add(-48 * time.Hour) })
+	if !fixed.Parse("2026-03-01").Ok {
+		t.Error("Expected the schema clock to override SetClock")
+	}
+}
+
+// Test DateOnly
+func TestDateOnly(t *testing.T) {
+	schema := Date().DateOnly()
+
+	result := schema.Parse("2026-03-02")
+	if !result.Ok || !result.Value.(time.Time).Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected a calendar date to pass, got %v %v", result.Value, result.Errors)
+	}
+	if !schema.Parse(time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)).Ok {
+		t.Error("Expected a midnight time.Time to pass")
+	}
+
+	for _, input := range []any{
+		"2026-03-02T10:00:00Z",
+		"2026-03-02 00:00:00",
+		"03/02/2026",
+		"02-03-2026",
+		"2026-3-2",
+		time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+	} {
+		result := schema.Parse(input)
+		if result.Ok || result.Errors[0].Code != CodeInvalidDate || result.Errors[0].Params["type"] != "date_only" {
+			t.Errorf("Expected %v to fail, got %v", input, result.Errors)
+		}
+	}
+
+	result = schema.Parse("2026-03-02T10:00:00Z")
+	if got := result.Errors[0].Translate("en"); got != "Date must be a calendar date (YYYY-MM-DD) without a time" {
+		t.Errorf("Unexpected message %q", got)
+	}
+}
+
+// Test FromUnix and FromUnixMillis
+func TestDateFromUnix(t *testing.T) {
+	want := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		schema *DateValidator
+		input  any
+		want   time.Time
+	}{
+		{"seconds int", Date().FromUnix(), 1772409600, want},
+		{"seconds int64", Date().FromUnix(), int64(1772409600), want},
+		{"seconds float", Date().FromUnix(), 1772409600.5, want.Add(500 * time.Millisecond)},
+		{"millis", Date().FromUnixMillis(), int64(1772409600123), want.Add(123 * time.Millisecond)},
+		{"millis float", Date().FromUnixMillis(), 1772409600123.0, want.Add(123 * time.Millisecond)},
+		{"string still parsed", Date().FromUnix(), "2026-03-02T00:00:00Z", want},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.schema.Parse(tt.input)
+			if !result.Ok {
+				t.Fatalf("Expected %v to pass, got %v", tt.input, result.Errors)
+			}
+			got := result.Value.(time.Time)
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// Numbers are rejected unless enabled
+	if result := Date().Parse(1772409600); result.Ok || result.Errors[0].Code != CodeInvalidType {
+		t.Errorf("Expected numbers to be rejected by default, got %v", result.Errors)
+	}
+	if Date().FromUnix().Parse(math.NaN()).Ok || Date().FromUnix().Parse(1e300).Ok {
+		t.Error("Expected non-finite and out of range timestamps to fail")
+	}
+
+	// Timestamps go through the other rules
+	if Date().FromUnix().Max(want).Parse(1772409601).Ok {
+		t.Error("Expected Max to apply to timestamps")
+	}
+	if !Date().FromUnix().DateOnly().Parse(1772409600).Ok || Date().FromUnix().DateOnly().Parse(1772409601).Ok {
+		t.Error("Expected DateOnly to require midnight UTC timestamps")
+	}
+}
+
+// Test Layouts and Layout
+func TestDateLayouts(t *testing.T) {
+	rfc3339 := Date().Layouts(time.RFC3339)
+	if !rfc3339.Parse("2026-03-02T10:00:00+01:00").Ok {
+		t.Error("Expected RFC 3339 to pass")
+	}
+	for _, input := range []string{"2026-03-02", "03/02/2026", "Mon, 02 Mar 2026 10:00:00 UTC"} {
+		if result := rfc3339.Parse(input); result.Ok || result.Errors[0].Code != CodeInvalidDate {
+			t.Errorf("Expected %q to be rejected, got %v", input, result.Errors)
+		}
+	}
+
+	// Layout resolves the DD/MM ambiguity of the defaults
+	european := Date().Layout("02/01/2006").Layout("02.01.2006")
+	for _, input := range []string{"01/03/2026", "01.03.2026"} {
+		result := european.Parse(input)
+		if !result.Ok || result.Value.(time.Time).Month() != time.March {
+			t.Errorf("Expected %q to parse as the 1st of March, got %v %v", input, result.Value, result.Errors)
+		}
+	}
+	if european.Parse("2026-03-01").Ok {
+		t.Error("Expected the default layouts to be rejected")
+	}
+
+	// The defaults can be extended
+	extended := Date().Layouts(append(DefaultDateLayouts(), "02.01.2006")...)
+	if !extended.Parse("01.03.2026").Ok || !extended.Parse("2026-03-01").Ok {
+		t.Error("Expected both the defaults and the added layout to pass")
+	}
+
+	// DateOnly still rejects a time of day from a custom layout
+	if Date().DateOnly().Layout("02/01/2006 15:04").Parse("01/03/2026 10:00").Ok {
+		t.Error("Expected DateOnly to reject a time of day")
+	}
+}
+
+// Test UTC
+func TestDateUTC(t *testing.T) {
+	schema := Date().UTC()
+
+	for _, input := range []any{
+		"2026-03-02T10:00:00Z",
+		"2026-03-02T10:00:00+00:00",
+		time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+	} {
+		if result := schema.Parse(input); !result.Ok {
+			t.Errorf("Expected %v to pass, got %v", input, result.Errors)
+		}
+	}
+	for _, input := range []any{
+		"2026-03-02T10:00:00+01:00",
+		"2026-03-02 10:00:00", // No timezone
+		"2026-03-02",
+		time.Date(2026, 3, 2, 10, 0, 0, 0, time.FixedZone("CET", 3600)),
+	} {
+		result := schema.Parse(input)
+		if result.Ok || result.Errors[0].Code != CodeInvalidDate || result.Errors[0].Params["type"] != "utc" {
+			t.Errorf("Expected %v to fail, got %v", input, result.Errors)
+		}
+	}
+	if !Date().UTC().FromUnix().Parse(1772409600).Ok {
+		t.Error("Expected Unix timestamps to be in UTC")
+	}
+}
+
+// Test InLocation
+func TestDateInLocation(t *testing.T) {
+	berlin := time.FixedZone("Europe/Berlin", 3600)
+	schema := Date().InLocation(berlin)
+
+	result := schema.Parse("2026-03-02T10:00:00Z")
+	got := result.Value.(time.Time)
+	if !result.Ok || got.Location() != berlin || got.Hour() != 11 {
+		t.Errorf("Expected the time converted to the location, got %v", got)
+	}
+
+	// Wall times without a timezone are read in the location
+	result = schema.Parse("2026-03-02 10:00:00")
+	got = result.Value.(time.Time)
+	if !result.Ok || got.Hour() != 10 || !got.Equal(time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected a wall time in the location, got %v", got)
+	}
+
+	result = Date().DateOnly().InLocation(berlin).Parse("2026-03-02")
+	if got := result.Value.(time.Time); !result.Ok || got.Day() != 2 || hasTimeOfDay(got) {
+		t.Errorf("Expected midnight in the location, got %v", got)
+	}
+}
+
+// Test MinAge and MaxAge
+func TestDateAge(t *testing.T) {
+	today := func() time.Time { return time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC) }
+	adult := Date().DateOnly().MinAge(18).MaxAge(120).Clock(today)
+
+	tests := []struct {
+		birth string
+		code  ErrorCode
+	}{
+		{"2008-03-02", ""},           // 18th birthday today
+		{"2008-03-03", CodeTooSmall}, // 18 tomorrow
+		{"1906-03-03", ""},           // 119
+		{"1906-03-02", ""},           // 120 today
+		{"1905-03-02", CodeTooBig},   // 121
+	}
+
+	for _, tt := range tests {
+		result := adult.Parse(tt.birth)
+		if tt.code == "" {
+			if !result.Ok {
+				t.Errorf("Expected %s to pass, got %v", tt.birth, result.Errors)
+			}
+			continue
+		}
+		if result.Ok || result.Errors[0].Code != tt.code || result.Errors[0].Params["type"] != "age" {
+			t.Errorf("Expected %s to fail with %s, got %v", tt.birth, tt.code, result.Errors)
+		}
+	}
+
+	result := adult.Parse("2010-01-01")
+	if got := result.Errors[0].Translate("en"); got != "Age must be at least 18 years" {
+		t.Errorf("Unexpected message %q", got)
+	}
+
+	// A leap day birthday is reached on March 1
+	leap := Date().MinAge(18).Clock(func() time.Time { return time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC) })
+	if leap.Parse("2008-02-29").Ok {
+		t.Error("Expected the leap day birthday not to be reached on February 28")
+	}
+	leap.Clock(func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) })
+	if !leap.Parse("2008-02-29").Ok {
+		t.Error("Expected the leap day birthday to be reached on March 1")
+	}
+}
+
+// Test exclusive and inclusive date bounds
+func TestDateExclusiveBounds(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	strict := Date().Gt(start).Lt(end)
+	if !strict.Parse(start.Add(time.Second)).Ok {
+		t.Error("Expected a date after the lower bound to pass")
+	}
+	result := strict.Parse(start)
+	if result.Ok || result.Errors[0].Code != CodeTooSmall {
+		t.Errorf("Expected the lower bound to fail Gt, got %v", result.Errors)
+	}
+	if got := result.Errors[0].Translate("en"); got != "Date must be after 2026-01-01T00:00:00Z" {
+		t.Errorf("Unexpected message %q", got)
+	}
+	if result := strict.Parse(end); result.Ok || result.Errors[0].Code != CodeTooBig {
+		t.Errorf("Expected the upper bound to fail Lt, got %v", result.Errors)
+	}
+
+	between := Date().Between(start, end)
+	if !between.Parse(start).Ok || !between.Parse(end).Ok {
+		t.Error("Expected Between to include its bounds")
+	}
+	if between.Parse(end.Add(time.Second)).Ok {
+		t.Error("Expected Between to reject a date after its upper bound")
+	}
+}
+
+// Test weekday rules
+func TestDateWeekday(t *testing.T) {
+	friday := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	saturday := friday.AddDate(0, 0, 1)
+
+	workday := Date().Weekdays()
+	if !workday.Parse(friday).Ok {
+		t.Error("Expected Friday to pass Weekdays()")
+	}
+	result := workday.Parse(saturday)
+	if result.Ok || result.Errors[0].Code != CodeNotWeekday {
+		t.Errorf("Expected Saturday to fail Weekdays(), got %v", result.Errors)
+	}
+	if got := result.Errors[0].Message; got != "Date must fall on Monday, Tuesday, Wednesday, Thursday or Friday" {
+		t.Errorf("Unexpected message %q", got)
+	}
+
+	if Date().NotWeekend().Parse("2026-10-18").Ok {
+		t.Error("Expected Sunday to fail NotWeekend()")
+	}
+
+	weekend := Date().Weekday(time.Saturday, time.Sunday)
+	if !weekend.Parse(saturday).Ok || weekend.Parse(friday).Ok {
+		t.Error("Expected Weekday to allow only the given days")
+	}
+
+	// The day is taken in the date's location
+	late := time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	if Date().Weekdays().InLocation(tokyo).Parse(late).Ok {
+		t.Error("Expected Friday 23:00 UTC to be Saturday in Tokyo")
+	}
+}
@@ -0,0 +1,291 @@
+package zogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// DecimalValidator validates decimal strings such as "19.99" exactly, so
+// that monetary values never pass through float64
+type DecimalValidator struct {
+	// Validation rules
+	minVal    *big.Rat
+	maxVal    *big.Rat
+	minText   string // The bounds as given, for error messages
+	maxText   string
+	precision *int
+	scale     int
+
+	// Type checks
+	isPositive    bool
+	isNegative    bool
+	isNonNegative bool
+	isNonPositive bool
+
+	// Output
+	asRat bool
+
+	// Modifiers
+	isRequired bool
+	isOptional bool
+	isNullable bool
+
+	// Custom validators
+	refinements []refinement
+}
+
+// Decimal creates a new decimal validator. It accepts strings and
+// json.Number holding a decimal number without an exponent, such as "-0.50",
+// and Go integers. Floats are rejected, as they may already be rounded.
+func Decimal() *DecimalValidator {
+	return &DecimalValidator{}
+}
+
+// Min sets the minimum value, given as a decimal string. It panics if min is
+// not a decimal number.
+func (v *DecimalValidator) Min(min string) *DecimalValidator {
+	v.minVal, v.minText = mustParseRat(min), min
+	return v
+}
+
+// Max sets the maximum value, given as a decimal string. It panics if max is
+// not a decimal number.
+func (v *DecimalValidator) Max(max string) *DecimalValidator {
+	v.maxVal, v.maxText = mustParseRat(max), max
+	return v
+}
+
+// Precision limits the number to precision significant digits, scale of
+// them after the decimal point, like SQL's DECIMAL(precision, scale).
+// Precision(10, 2) accepts up to 99999999.99.
+func (v *DecimalValidator) Precision(precision, scale int) *DecimalValidator {
+	v.precision = &precision
+	v.scale = scale
+	return v
+}
+
+// Positive requires number > 0
+func (v *DecimalValidator) Positive() *DecimalValidator {
+	v.isPositive = true
+	return v
+}
+
+// Negative requires number < 0
+func (v *DecimalValidator) Negative() *DecimalValidator {
+	v.isNegative = true
+	return v
+}
+
+// NonNegative requires number >= 0
+func (v *DecimalValidator) NonNegative() *DecimalValidator {
+	v.isNonNegative = true
+	return v
+}
+
+// NonPositive requires number <= 0
+func (v *DecimalValidator) NonPositive() *DecimalValidator {
+	v.isNonPositive = true
+	return v
+}
+
+// Rat parses the value to a *big.Rat instead of keeping the input string
+func (v *DecimalValidator) Rat() *DecimalValidator {
+	v.asRat = true
+	return v
+}
+
+// Required marks the field as required
+func (v *DecimalValidator) Required() *DecimalValidator {
+	v.isRequired = true
+	v.isOptional = false
+	return v
+}
+
+// Optional allows nil values
+func (v *DecimalValidator) Optional() *DecimalValidator {
+	v.isOptional = true
+	v.isRequired = false
+	return v
+}
+
+// Nullable allows null values
+func (v *DecimalValidator) Nullable() *DecimalValidator {
+	v.isNullable = true
+	return v
+}
+
+// Nullish allows nil values, like Optional and Nullable together
+func (v *DecimalValidator) Nullish() *DecimalValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Refine adds custom validation logic on the exact value of the number
+func (v *DecimalValidator) Refine(check func(*big.Rat) bool, message string) *DecimalValidator {
+	v.refinements = append(v.refinements, refinement{
+		check:   func(value any) bool { return check(value.(*big.Rat)) },
+		message: message,
+	})
+	return v
+}
+
+// Parse validates the input value
+func (v *DecimalValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse,
+// counting strings toward the string quota
+func (v *DecimalValidator) parseWithState(value any, st *parseState) ParseResult {
+	switch str := value.(type) {
+	case string:
+		if failed, ok := st.use(&st.meta.Usage.StringBytes, len(str)); !ok {
+			return failed
+		}
+	case json.Number:
+		if failed, ok := st.use(&st.meta.Usage.StringBytes, len(str)); !ok {
+			return failed
+		}
+	}
+	return v.parseValue(value)
+}
+
+// parseValue validates the input value against the rules
+func (v *DecimalValidator) parseValue(value any) ParseResult {
+	// Handle nil values based on modifiers
+	if value == nil {
+		// If optional, nil is OK
+		if v.isOptional {
+			return Success(nil)
+		}
+
+		// If nullable, nil is OK
+		if v.isNullable {
+			return Success(nil)
+		}
+
+		// Otherwise, nil is not allowed
+		return FailureTypeMismatch("decimal", nil)
+	}
+
+	var str string
+	switch n := value.(type) {
+	case string:
+		str = strings.TrimSpace(n)
+	case json.Number:
+		str = string(n)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		str = fmt.Sprint(n)
+	default:
+		return FailureTypeMismatch("decimal", value)
+	}
+
+	digits, ok := scanDecimal(str)
+	if !ok {
+		return FailureWithCode("Invalid decimal number", CodeInvalidDecimal)
+	}
+
+	// Check precision and scale
+	if v.precision != nil && (digits.integer > *v.precision-v.scale || digits.fraction > v.scale) {
+		return FailureWithParams(
+			fmt.Sprintf("Decimal must have at most %d digits, %d of them after the decimal point", *v.precision, v.scale),
+			CodeDecimalPrecision,
+			map[string]any{"precision": *v.precision, "scale": v.scale},
+		)
+	}
+
+	num, _ := new(big.Rat).SetString(str)
+
+	// Check minimum value
+	if v.minVal != nil && num.Cmp(v.minVal) < 0 {
+		return FailureWithParams(
+			fmt.Sprintf("Number must be at least %s", v.minText),
+			CodeTooSmall,
+			map[string]any{"type": "number", "minimum": v.minText},
+		)
+	}
+
+	// Check maximum value
+	if v.maxVal != nil && num.Cmp(v.maxVal) > 0 {
+		return FailureWithParams(
+			fmt.Sprintf("Number must be at most %s", v.maxText),
+			CodeTooBig,
+			map[string]any{"type": "number", "maximum": v.maxText},
+		)
+	}
+
+	// Check sign
+	switch sign := num.Sign(); {
+	case v.isPositive && sign <= 0:
+		return FailureWithCode("Number must be positive", CodeNotPositive)
+	case v.isNegative && sign >= 0:
+		return FailureWithCode("Number must be negative", CodeNotNegative)
+	case v.isNonNegative && sign < 0:
+		return FailureWithCode("Number must be non-negative", CodeNotNonNegative)
+	case v.isNonPositive && sign > 0:
+		return FailureWithCode("Number must be non-positive", CodeNotNonPositive)
+	}
+
+	if result := refine(v.refinements, Success(num)); !result.Ok {
+		return result
+	}
+	if v.asRat {
+		return Success(num)
+	}
+	return Success(str)
+}
+
+// decimalDigits counts the significant digits of a decimal number
+type decimalDigits struct {
+	integer  int // Digits before the point, without leading zeros
+	fraction int // Digits after the point, without trailing zeros
+}
+
+// scanDecimal checks that s is a decimal number without an exponent, like
+// "-12.50", and counts its significant digits
+func scanDecimal(s string) (decimalDigits, bool) {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	integer, fraction, hasPoint := strings.Cut(s, ".")
+	if (integer == "" && fraction == "") || (hasPoint && fraction == "") || !isDigits(integer) || !isDigits(fraction) {
+		return decimalDigits{}, false
+	}
+	return decimalDigits{
+		integer:  len(strings.TrimLeft(integer, "0")),
+		fraction: len(strings.TrimRight(fraction, "0")),
+	}, true
+}
+
+// isDigits reports whether s holds only ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isLettersOrDigits reports whether s holds only ASCII letters and digits
+func isLettersOrDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; (c < '0' || c > '9') && ((c|0x20) < 'a' || (c|0x20) > 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+// mustParseRat parses a decimal string for a rule, panicking on invalid input
+func mustParseRat(s string) *big.Rat {
+	if _, ok := scanDecimal(s); !ok {
+		panic(fmt.Sprintf("zogo: invalid decimal %q", s))
+	}
+	num, _ := new(big.Rat).SetString(s)
+	return num
+}
@@ -0,0 +1,119 @@
+package zogo
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+// Test basic decimal parsing
+func TestDecimalBasic(t *testing.T) {
+	tests := []struct {
+		input any
+		want  string
+	}{
+		{"19.99", "19.99"},
+		{" -0.50 ", "-0.50"},
+		{"+3", "+3"},
+		{".5", ".5"},
+		{json.Number("100.10"), "100.10"},
+		{42, "42"},
+	}
+
+	for _, tt := range tests {
+		result := Decimal().Parse(tt.input)
+		if !result.Ok || result.Value != tt.want {
+			t.Errorf("Expected %v to parse as %q, got %v %v", tt.input, tt.want, result.Value, result.Errors)
+		}
+	}
+
+	for _, input := range []any{"", "1.", "1e3", "1.2.3", "abc", "--1", "0x10"} {
+		if result := Decimal().Parse(input); result.Ok || result.Errors[0].Code != CodeInvalidDecimal {
+			t.Errorf("Expected %q to fail as invalid_decimal, got %v", input, result.Errors)
+		}
+	}
+	for _, input := range []any{nil, 19.99, true} {
+		if result := Decimal().Parse(input); result.Ok || result.Errors[0].Code != CodeInvalidType {
+			t.Errorf("Expected %v to fail as invalid_type, got %v", input, result.Errors)
+		}
+	}
+}
+
+// Test Precision
+func TestDecimalPrecision(t *testing.T) {
+	schema := Decimal().Precision(5, 2)
+
+	for _, input := range []string{"999.99", "0.1", "-123.4", "001.50", "999.990"} {
+		if result := schema.Parse(input); !result.Ok {
+			t.Errorf("Expected %s to pass, got %v", input, result.Errors)
+		}
+	}
+	for _, input := range []string{"1000", "0.001", "1234.5"} {
+		result := schema.Parse(input)
+		if result.Ok || result.Errors[0].Code != CodeDecimalPrecision {
+			t.Errorf("Expected %s to fail, got %v", input, result.Errors)
+		}
+	}
+
+	result := schema.Parse("0.001")
+	if got := result.Errors[0].Translate("en"); got != "Decimal must have at most 5 digits, 2 of them after the decimal point" {
+		t.Errorf("Unexpected message %q", got)
+	}
+}
+
+// Test range and sign rules
+func TestDecimalRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *DecimalValidator
+		input  string
+		code   ErrorCode
+	}{
+		{"min", Decimal().Min("0.01"), "0.009", CodeTooSmall},
+		{"max", Decimal().Max("100"), "100.0001", CodeTooBig},
+		{"within", Decimal().Min("0.01").Max("100"), "100.00", ""},
+		{"positive", Decimal().Positive(), "0.00", CodeNotPositive},
+		{"negative", Decimal().Negative(), "0", CodeNotNegative},
+		{"nonnegative", Decimal().NonNegative(), "-0.01", CodeNotNonNegative},
+		{"nonpositive", Decimal().NonPositive(), "0.01", CodeNotNonPositive},
+		{"refine", Decimal().Refine(func(r *big.Rat) bool { return r.IsInt() }, "Must be whole"), "1.5", CodeCustom},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.schema.Parse(tt.input)
+			if tt.code == "" {
+				if !result.Ok {
+					t.Errorf("Expected %s to pass, got %v", tt.input, result.Errors)
+				}
+				return
+			}
+			if result.Ok || result.Errors[0].Code != tt.code {
+				t.Errorf("Expected %s error, got %v", tt.code, result.Errors)
+			}
+		})
+	}
+
+	result := Decimal().Min("0.10").Parse("0.05")
+	if result.Errors[0].Message != "Number must be at least 0.10" {
+		t.Errorf("Expected the bound as given, got %q", result.Errors[0].Message)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected an invalid bound to panic")
+		}
+	}()
+	Decimal().Min("1e3")
+}
+
+// Test Rat output
+func TestDecimalRat(t *testing.T) {
+	result := Decimal().Rat().Parse("0.10")
+	if !result.Ok {
+		t.Fatalf("Expected to pass, got %v", result.Errors)
+	}
+	if got := result.Value.(*big.Rat); got.Cmp(big.NewRat(1, 10)) != 0 {
+		t.Errorf("Expected 1/10, got %s", got)
+	}
+}
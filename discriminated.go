@@ -0,0 +1,171 @@
+package zogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiscriminatedUnionValidator validates objects against one of several object
+// schemas, chosen by the value of a discriminator field
+type DiscriminatedUnionValidator struct {
+	discriminator string
+	options       []discriminatedOption
+	index         map[string]*ObjectValidator // String discriminator values, for direct dispatch
+
+	// Modifiers
+	isRequired bool
+	isOptional bool
+	isNullable bool
+
+	examples schemaExamples // Declared with Examples
+}
+
+// discriminatedOption is a member schema and the discriminator values selecting it
+type discriminatedOption struct {
+	values []interface{}
+	schema *ObjectValidator
+}
+
+// DiscriminatedUnion creates a union of object schemas that share a
+// discriminator field. Each schema must declare the field as a Literal or
+// Enum; the discriminator is read once and the input is validated only
+// against the matching schema:
+//
+//	DiscriminatedUnion("status", successResponse, errorResponse)
+//
+// It panics if a schema does not declare the discriminator, or if two
+// schemas claim the same value.
+func DiscriminatedUnion(discriminator string, schemas ...*ObjectValidator) *DiscriminatedUnionValidator {
+	v := &DiscriminatedUnionValidator{
+		discriminator: discriminator,
+		index:         map[string]*ObjectValidator{},
+	}
+
+	for i, schema := range schemas {
+		var values []interface{}
+		switch field := schema.schema[discriminator].(type) {
+		case *LiteralValidator:
+			values = []interface{}{field.expectedValue}
+		case *EnumValidator:
+			values = field.allowedValues
+		default:
+			panic(fmt.Sprintf("zogo: DiscriminatedUnion option %d must declare %q as a Literal or Enum", i+1, discriminator))
+		}
+
+		for _, value := range values {
+			if v.lookup(value) != nil {
+				panic(fmt.Sprintf("zogo: DiscriminatedUnion value %v of %q is used by more than one option", value, discriminator))
+			}
+			if s, ok := value.(string); ok {
+				v.index[s] = schema
+			}
+		}
+		v.options = append(v.options, discriminatedOption{values: values, schema: schema})
+	}
+	return v
+}
+
+// Required marks the field as required
+func (v *DiscriminatedUnionValidator) Required() *DiscriminatedUnionValidator {
+	v.isRequired = true
+	v.isOptional = false
+	return v
+}
+
+// Optional allows nil values
+func (v *DiscriminatedUnionValidator) Optional() *DiscriminatedUnionValidator {
+	v.isOptional = true
+	v.isRequired = false
+	return v
+}
+
+// Nullable allows null values
+func (v *DiscriminatedUnionValidator) Nullable() *DiscriminatedUnionValidator {
+	v.isNullable = true
+	return v
+}
+
+// Nullish allows nil values, like Optional and Nullable together
+func (v *DiscriminatedUnionValidator) Nullish() *DiscriminatedUnionValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *DiscriminatedUnionValidator) Examples(valid, invalid []any) *DiscriminatedUnionValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
+// Parse validates the input value against the schema selected by its discriminator
+func (v *DiscriminatedUnionValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *DiscriminatedUnionValidator) parseWithState(value any, st *parseState) ParseResult {
+	// Handle nil values based on modifiers
+	if value == nil {
+		if v.isOptional || v.isNullable {
+			return Success(nil)
+		}
+		return FailureTypeMismatch("object", nil)
+	}
+
+	objMap, ok := value.(map[string]interface{})
+	if !ok {
+		return FailureTypeMismatch("object", value)
+	}
+
+	discriminator := objMap[v.discriminator]
+	schema := v.lookup(discriminator)
+	if schema == nil {
+		options := v.optionList()
+		return Failure(ValidationError{
+			Path:    v.discriminator,
+			Message: "Invalid discriminator value. Expected " + options,
+			Value:   discriminator,
+			Code:    CodeInvalidDiscriminator,
+			Params:  map[string]any{"options": options},
+		})
+	}
+	return st.parse(schema, value)
+}
+
+// lookup returns the schema selected by a discriminator value
+func (v *DiscriminatedUnionValidator) lookup(discriminator any) *ObjectValidator {
+	if s, ok := discriminator.(string); ok {
+		return v.index[s]
+	}
+	if discriminator == nil {
+		return nil
+	}
+
+	// Numbers and booleans are compared the same way as Literal and Enum do
+	for _, option := range v.options {
+		for _, value := range option.values {
+			if deepEqual(discriminator, value) {
+				return option.schema
+			}
+		}
+	}
+	return nil
+}
+
+// optionList formats the discriminator values for error messages
+func (v *DiscriminatedUnionValidator) optionList() string {
+	var values []string
+	for _, option := range v.options {
+		for _, value := range option.values {
+			if s, ok := value.(string); ok {
+				values = append(values, "'"+s+"'")
+			} else {
+				values = append(values, fmt.Sprint(value))
+			}
+		}
+	}
+	return strings.Join(values, " | ")
+}
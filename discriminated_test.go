@@ -0,0 +1,100 @@
+package zogo
+
+import (
+	"testing"
+)
+
+func shapeSchema() *DiscriminatedUnionValidator {
+	return DiscriminatedUnion("kind",
+		Object(Schema{"kind": Literal("circle"), "radius": Number().Positive()}),
+		Object(Schema{"kind": Enum([]interface{}{"square", "rect"}), "width": Number(), "height": Number().Optional()}),
+		Object(Schema{"kind": Literal(3), "sides": Array(Number()).Length(3)}),
+	)
+}
+
+// Test the discriminator selects the member schema
+func TestDiscriminatedUnion(t *testing.T) {
+	schema := shapeSchema()
+
+	valid := []map[string]interface{}{
+		{"kind": "circle", "radius": float64(2)},
+		{"kind": "rect", "width": float64(2), "height": float64(3)},
+		{"kind": "square", "width": float64(2)},
+		{"kind": float64(3), "sides": []interface{}{float64(3), float64(4), float64(5)}},
+	}
+	for _, input := range valid {
+		if result := schema.Parse(input); !result.Ok {
+			t.Errorf("Expected %v to pass: %v", input, result.Errors)
+		}
+	}
+
+	// Errors come from the selected member only, with their own paths
+	result := schema.Parse(map[string]interface{}{"kind": "circle", "radius": float64(-1)})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "radius" || result.Errors[0].Code != CodeNotPositive {
+		t.Errorf("Expected a single radius error, got %v", result.Errors)
+	}
+}
+
+// Test unknown discriminator values and non-objects
+func TestDiscriminatedUnionInvalid(t *testing.T) {
+	schema := shapeSchema()
+
+	result := schema.Parse(map[string]interface{}{"kind": "hexagon"})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected one error, got %v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Path != "kind" || err.Code != CodeInvalidDiscriminator || err.Message != "Invalid discriminator value. Expected 'circle' | 'square' | 'rect' | 3" {
+		t.Errorf("Unexpected discriminator error: %+v", err)
+	}
+
+	if result := schema.Parse(map[string]interface{}{"radius": float64(1)}); !result.Errors.HasPath("kind") {
+		t.Errorf("Expected missing discriminator error, got %v", result.Errors)
+	}
+	if result := schema.Parse("circle"); result.Ok || result.Errors[0].Code != CodeInvalidType {
+		t.Errorf("Expected type error for non-object, got %v", result.Errors)
+	}
+	if result := schema.Parse(nil); result.Ok {
+		t.Error("Expected nil to fail")
+	}
+	if result := shapeSchema().Optional().Parse(nil); !result.Ok {
+		t.Error("Expected nil to pass when optional")
+	}
+}
+
+// Test nested discriminated unions prefix error paths
+func TestDiscriminatedUnionNested(t *testing.T) {
+	schema := Object(Schema{"shapes": Array(shapeSchema())})
+	result := schema.Parse(map[string]interface{}{
+		"shapes": []interface{}{
+			map[string]interface{}{"kind": "circle", "radius": float64(1)},
+			map[string]interface{}{"kind": "blob"},
+		},
+	})
+	if !result.Errors.HasPath("shapes[1].kind") {
+		t.Errorf("Expected error at shapes[1].kind, got %v", result.Errors)
+	}
+}
+
+// Test invalid option schemas panic at construction
+func TestDiscriminatedUnionConstruction(t *testing.T) {
+	expectPanic := func(name string, build func()) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%s: expected a panic", name)
+			}
+		}()
+		build()
+	}
+
+	expectPanic("missing discriminator", func() {
+		DiscriminatedUnion("kind", Object(Schema{"radius": Number()}))
+	})
+	expectPanic("duplicate value", func() {
+		DiscriminatedUnion("kind",
+			Object(Schema{"kind": Literal("a")}),
+			Object(Schema{"kind": Enum([]interface{}{"b", "a"})}),
+		)
+	})
+}
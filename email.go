@@ -0,0 +1,126 @@
+package zogo
+
+import (
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+)
+
+// EmailMode selects the address syntax Email accepts
+type EmailMode int
+
+const (
+	// EmailBasic accepts common addresses: letters, digits and "._%+-"
+	// before the "@" and a dotted domain. It is the default.
+	EmailBasic EmailMode = iota
+	// EmailRFC5322 accepts any RFC 5322 address, including quoted local
+	// parts such as "john doe"@example.com, the "!#$&'*/=?^`{|}~"
+	// characters, domains without a dot and domain literals such as
+	// user@[192.168.0.1]
+	EmailRFC5322
+	// EmailStrict accepts a bare address that net/mail parses, without a
+	// display name, angle brackets or quoted local part, whose domain is a
+	// valid host name, within the RFC 5321 limits of 64 characters before
+	// the "@" and 254 overall
+	EmailStrict
+)
+
+// EmailOptions configures Email
+type EmailOptions struct {
+	Mode           EmailMode
+	AllowIDN       bool // Accept internationalized addresses, as AllowIDN does
+	NoPlus         bool // Reject plus addressing such as "jane+news@example.com"
+	MaxLocalLength int  // Longest part before the "@" in characters, unchecked when 0
+}
+
+// splitEmail splits an address at its last "@", so quoted local parts may
+// contain one
+func splitEmail(s string) (local, domain string, ok bool) {
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 || at == len(s)-1 {
+		return "", "", false
+	}
+	return s[:at], s[at+1:], true
+}
+
+// isValidRFC5322Email checks an addr-spec of RFC 5322, allowing UTF-8 as
+// RFC 6532 does when idn is set
+func isValidRFC5322Email(s string, idn bool) bool {
+	local, domain, ok := splitEmail(s)
+	if !ok || (!idn && !isASCII(s)) {
+		return false
+	}
+	if !isDotAtom(local) && !isQuotedString(local) {
+		return false
+	}
+	if strings.HasPrefix(domain, "[") {
+		return isDomainLiteral(domain)
+	}
+	return isDotAtom(domain)
+}
+
+// isValidStrictEmail checks an address with net/mail, then holds its
+// domain and lengths to the rules of mail servers
+func isValidStrictEmail(s string, idn bool) bool {
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Name != "" || addr.Address != s || len(s) > 254 {
+		return false
+	}
+	local, domain, _ := splitEmail(s)
+	if utf8.RuneCountInString(local) > 64 {
+		return false
+	}
+	if idn {
+		return isValidIDNDomain(domain)
+	}
+	return isASCII(local) && isValidDomain(domain)
+}
+
+// isDotAtom checks atoms of atext separated by single dots
+func isDotAtom(s string) bool {
+	for _, atom := range strings.Split(s, ".") {
+		if atom == "" {
+			return false
+		}
+		for _, r := range atom {
+			if r < utf8.RuneSelf && !isLettersOrDigits(string(r)) && !strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isQuotedString checks a quoted local part: printable characters and
+// spaces between double quotes, with backslash escapes
+func isQuotedString(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+			if i == len(s)-1 || s[i] < ' ' || s[i] == 0x7F {
+				return false
+			}
+		case c == '"' || (c < ' ' && c != '\t') || c == 0x7F:
+			return false
+		}
+	}
+	return true
+}
+
+// isDomainLiteral checks a bracketed domain literal such as "[192.0.2.1]"
+// or "[IPv6:2001:db8::1]"
+func isDomainLiteral(s string) bool {
+	if len(s) < 3 || s[len(s)-1] != ']' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		if c := s[i]; c <= ' ' || c >= 0x7F || c == '[' || c == ']' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package zogo
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test Email in RFC 5322 mode
+func TestStringEmailRFC5322(t *testing.T) {
+	schema := String().Email(EmailOptions{Mode: EmailRFC5322})
+
+	valid := []string{
+		"user@example.com",
+		"user@localhost",
+		"o'brien@example.com",
+		"a!#$%&'*+-/=?^_`{|}~b@example.com",
+		`"john doe"@example.com`,
+		`"a@b"@example.com`,
+		`"quote\"d"@example.com`,
+		"user@[192.168.0.1]",
+		"user@[IPv6:2001:db8::1]",
+	}
+	for _, email := range valid {
+		if result := schema.Parse(email); !result.Ok {
+			t.Errorf("Expected %q to be a valid RFC 5322 address, got %v", email, result.Errors)
+		}
+	}
+
+	invalid := []string{
+		"user",
+		"@example.com",
+		"user@",
+		".user@example.com",
+		"us..er@example.com",
+		"user.@example.com",
+		"john doe@example.com",
+		`"unterminated@example.com`,
+		`"a"b"@example.com`,
+		"user@exa mple.com",
+		"user@[192.168.0.1",
+		"usér@example.com",
+	}
+	for _, email := range invalid {
+		if schema.Parse(email).Ok {
+			t.Errorf("Expected %q to be an invalid RFC 5322 address", email)
+		}
+	}
+
+	if !String().Email(EmailOptions{Mode: EmailRFC5322, AllowIDN: true}).Parse("usér@bücher.de").Ok {
+		t.Error("Expected AllowIDN to accept Unicode in RFC 5322 mode")
+	}
+}
+
+// Test Email in strict mode
+func TestStringEmailStrict(t *testing.T) {
+	schema := String().Email(EmailOptions{Mode: EmailStrict})
+
+	valid := []string{
+		"user@example.com",
+		"first.last+tag@sub.example.co.uk",
+		"o'brien@example.com",
+	}
+	for _, email := range valid {
+		if result := schema.Parse(email); !result.Ok {
+			t.Errorf("Expected %q to pass strict mode, got %v", email, result.Errors)
+		}
+	}
+
+	invalid := []string{
+		"Jane <jane@example.com>",
+		"<jane@example.com>",
+		`"john doe"@example.com`,
+		"jane@example.com (Jane)",
+		"user@localhost",
+		"user@[192.168.0.1]",
+		"user@-example.com",
+		"user@example.c0m",
+		strings.Repeat("a", 65) + "@example.com",
+		"a@" + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 63) + "." + strings.Repeat("e", 58) + ".com",
+		"usér@example.com",
+	}
+	for _, email := range invalid {
+		if schema.Parse(email).Ok {
+			t.Errorf("Expected %q to fail strict mode", email)
+		}
+	}
+
+	idn := String().Email(EmailOptions{Mode: EmailStrict, AllowIDN: true})
+	if !idn.Parse("user@bücher.de").Ok {
+		t.Error("Expected AllowIDN to accept an internationalized domain in strict mode")
+	}
+}
+
+// Test Email rejecting plus addressing and long local parts
+func TestStringEmailOptions(t *testing.T) {
+	schema := String().Email(EmailOptions{NoPlus: true, MaxLocalLength: 10})
+
+	if result := schema.Parse("jane@example.com"); !result.Ok {
+		t.Errorf("Expected a plain address to pass, got %v", result.Errors)
+	}
+
+	tests := []struct {
+		input string
+		rule  any
+	}{
+		{"not-an-email", nil},
+		{"jane+news@example.com", "plus"},
+		{"jane.doe.smith@example.com", "local"},
+	}
+	for _, tt := range tests {
+		result := schema.Parse(tt.input)
+		if result.Ok || result.Errors[0].Code != CodeInvalidEmail || result.Errors[0].Params["type"] != tt.rule {
+			t.Errorf("Expected %q to fail with %s (%v), got %v", tt.input, CodeInvalidEmail, tt.rule, result.Errors)
+		}
+	}
+
+	result := schema.Parse("jane.doe.smith@example.com")
+	if msg := result.Errors[0].Message; msg != "Email local part must be at most 10 characters" {
+		t.Errorf("Unexpected message %q", msg)
+	}
+	if !String().Email().Parse("jane+news@example.com").Ok {
+		t.Error("Expected plus addressing to pass by default")
+	}
+}
@@ -0,0 +1,94 @@
+package zogo
+
+import "unicode"
+
+// emojiRanges are the code points that display as emoji by default: the
+// pictographic blocks from U+1F000 (which include regional indicators and
+// skin tone modifiers), Miscellaneous Symbols and Dingbats, and the few
+// emoji in other BMP blocks
+var emojiRanges = [][2]rune{
+	{0x231A, 0x231B}, {0x23E9, 0x23F3}, {0x23F8, 0x23FA}, {0x25FD, 0x25FE},
+	{0x2600, 0x27BF}, {0x2B1B, 0x2B1C}, {0x2B50, 0x2B50}, {0x2B55, 0x2B55},
+	{0x1F000, 0x1FAFF},
+}
+
+// textEmojiRanges are the code points that display as text unless followed
+// by the emoji presentation selector U+FE0F, such as "©" and "↔"
+var textEmojiRanges = [][2]rune{
+	{0x00A9, 0x00A9}, {0x00AE, 0x00AE}, {0x203C, 0x203C}, {0x2049, 0x2049},
+	{0x2122, 0x2122}, {0x2139, 0x2139}, {0x2194, 0x2199}, {0x21A9, 0x21AA},
+	{0x2328, 0x2328}, {0x23CF, 0x23CF}, {0x24C2, 0x24C2}, {0x25AA, 0x25AB},
+	{0x25B6, 0x25B6}, {0x25C0, 0x25C0}, {0x25FB, 0x25FC}, {0x2934, 0x2935},
+	{0x2B05, 0x2B07}, {0x3030, 0x3030}, {0x303D, 0x303D}, {0x3297, 0x3297},
+	{0x3299, 0x3299},
+}
+
+// Code points that join or modify the emoji before them
+const (
+	emojiZWJ          = 0x200D // Zero width joiner, as in 👩‍💻
+	emojiPresentation = 0xFE0F // Variation selector 16
+	textPresentation  = 0xFE0E // Variation selector 15
+	emojiKeycap       = 0x20E3 // Combining enclosing keycap, as in 1️⃣
+)
+
+// inRanges reports whether r is within one of the ranges
+func inRanges(r rune, ranges [][2]rune) bool {
+	for _, rng := range ranges {
+		if r >= rng[0] && r <= rng[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmojiModifier reports whether r continues an emoji sequence: a joiner,
+// a variation selector, a keycap or a tag of a subdivision flag
+func isEmojiModifier(r rune) bool {
+	return r == emojiZWJ || r == emojiPresentation || r == textPresentation ||
+		r == emojiKeycap || (r >= 0xE0020 && r <= 0xE007F)
+}
+
+// isOnlyEmoji reports whether s is made only of emoji, including sequences
+// such as flags, skin tones, keycaps and joined emoji
+func isOnlyEmoji(s string) bool {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return false
+	}
+	for i, r := range runes {
+		next := rune(0)
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		switch {
+		case inRanges(r, emojiRanges):
+		case inRanges(r, textEmojiRanges):
+			if next != emojiPresentation {
+				return false
+			}
+		case r == '#' || r == '*' || (r >= '0' && r <= '9'):
+			if next != emojiPresentation && next != emojiKeycap {
+				return false
+			}
+		case isEmojiModifier(r):
+			if i == 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// containsEmoji reports whether s contains an emoji, a symbol outside the
+// Basic Multilingual Plane, or a character given emoji presentation
+func containsEmoji(s string) bool {
+	for _, r := range s {
+		if inRanges(r, emojiRanges) || r == emojiPresentation || r == emojiKeycap ||
+			(r > 0xFFFF && unicode.IsSymbol(r)) {
+			return true
+		}
+	}
+	return false
+}
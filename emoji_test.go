@@ -0,0 +1,52 @@
+package zogo
+
+import "testing"
+
+// Test Emoji accepts only emoji and emoji sequences
+func TestStringEmoji(t *testing.T) {
+	schema := String().Emoji()
+
+	valid := []string{
+		"🙂",
+		"👍🏽",              // Skin tone
+		"👩\u200d💻",        // Joined
+		"👨\u200d👩\u200d👧", // Family
+		"🇩🇪",              // Flag
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", // Scotland
+		"1\ufe0f\u20e3", // Keycap
+		"❤\ufe0f",       // Heart with emoji presentation
+		"©\ufe0f",
+		"☕⌛⭐",
+		"🎉🎉🎉",
+	}
+	for _, s := range valid {
+		if result := schema.Parse(s); !result.Ok {
+			t.Errorf("Expected %q to pass Emoji(), got %v", s, result.Errors)
+		}
+	}
+
+	invalid := []string{"", "a", "🙂 ", "hi🙂", "©", "1", "#", "\u200d🙂", "\ufe0f"}
+	for _, s := range invalid {
+		if schema.Parse(s).Ok {
+			t.Errorf("Expected %q to fail Emoji()", s)
+		}
+	}
+}
+
+// Test NoEmoji rejects emoji and non-BMP symbols
+func TestStringNoEmoji(t *testing.T) {
+	schema := String().NoEmoji()
+
+	for _, s := range []string{"", "Jane Doe", "Müller", "日本語", "© 2026", "a → b", "𝔘𝔫𝔦", "𠜎"} {
+		if !schema.Parse(s).Ok {
+			t.Errorf("Expected %q to pass NoEmoji()", s)
+		}
+	}
+
+	for _, s := range []string{"hi 🙂", "🇩🇪", "coffee ☕", "©\ufe0f", "1\u20e3", "clef 𝄞"} {
+		result := schema.Parse(s)
+		if result.Ok || result.Errors[0].Code != CodeContainsEmoji {
+			t.Errorf("Expected %q to fail NoEmoji() with invalid_string.no_emoji", s)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package zogo
 
 import (
 	"fmt"
-	"reflect"
 )
 
 // EnumValidator validates that a value is one of the allowed values
@@ -10,10 +9,16 @@ type EnumValidator struct {
 	allowedValues []interface{}
 
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
-	defaultVal *interface{}
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  *interface{}
+	defaultFunc func() interface{} // Set by DefaultFunc, called on each parse
+
+	// Custom validators
+	refinements []refinement
+
+	examples schemaExamples // Declared with Examples
 }
 
 // Enum creates a new enum validator with the given allowed values
@@ -43,21 +48,58 @@ func (v *EnumValidator) Nullable() *EnumValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *EnumValidator) Nullish() *EnumValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
 // Default sets a default value if input is nil
 func (v *EnumValidator) Default(val interface{}) *EnumValidator {
-	v.defaultVal = &val
+	v.defaultVal, v.defaultFunc = &val, nil
+	return v
+}
+
+// DefaultFunc sets a function called for the default each time the input
+// is nil, such as a region read from configuration
+func (v *EnumValidator) DefaultFunc(fn func() interface{}) *EnumValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
+	return v
+}
+
+// Refine adds custom validation logic on an allowed value
+func (v *EnumValidator) Refine(check func(any) bool, message string) *EnumValidator {
+	v.refinements = append(v.refinements, refinement{check: check, message: message})
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *EnumValidator) Examples(valid, invalid []any) *EnumValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
 	return v
 }
 
 // Parse validates the input value
 func (v *EnumValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *EnumValidator) parseWithState(value any, st *parseState) ParseResult {
+	// Validate the default in place of a missing value, so it must be one
+	// of the allowed values
+	if value == nil && v.defaultVal != nil {
+		value = *v.defaultVal
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+
 	// Handle nil values based on modifiers
 	if value == nil {
-		// If default is set, use it
-		if v.defaultVal != nil {
-			return Success(*v.defaultVal)
-		}
-
 		// If optional, nil is OK
 		if v.isOptional {
 			return Success(nil)
@@ -69,64 +111,20 @@ func (v *EnumValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected enum value, received null")
+		return FailureTypeMismatch("enum value", nil)
 	}
 
 	// Check if value is in allowed values
 	for _, allowed := range v.allowedValues {
 		if deepEqual(value, allowed) {
-			return Success(value)
+			return refine(v.refinements, Success(value))
 		}
 	}
 
 	// Value not found in allowed values
-	return FailureMessage(fmt.Sprintf("Invalid enum value. Expected one of: %v, received: %v", v.allowedValues, value))
-}
-
-// deepEqual compares two values for equality, handling different numeric types
-func deepEqual(a, b interface{}) bool {
-	// Use reflect.DeepEqual for most cases
-	if reflect.DeepEqual(a, b) {
-		return true
-	}
-
-	// Handle numeric type conversions
-	// This allows comparing int(1) with float64(1), etc.
-	aVal := reflect.ValueOf(a)
-	bVal := reflect.ValueOf(b)
-
-	// Check if both are numeric
-	if isNumeric(aVal.Kind()) && isNumeric(bVal.Kind()) {
-		// Convert both to float64 for comparison
-		aFloat := toFloat64(a)
-		bFloat := toFloat64(b)
-		return aFloat == bFloat
-	}
-
-	return false
-}
-
-// isNumeric checks if a reflect.Kind is a numeric type
-func isNumeric(k reflect.Kind) bool {
-	switch k {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-		reflect.Float32, reflect.Float64:
-		return true
-	}
-	return false
-}
-
-// toFloat64 converts numeric values to float64
-func toFloat64(val interface{}) float64 {
-	v := reflect.ValueOf(val)
-	switch v.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return float64(v.Int())
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return float64(v.Uint())
-	case reflect.Float32, reflect.Float64:
-		return v.Float()
-	}
-	return 0
+	return FailureWithParams(
+		fmt.Sprintf("Invalid enum value. Expected one of: %v, received: %v", v.allowedValues, value),
+		CodeInvalidEnumValue,
+		map[string]any{"options": fmt.Sprint(v.allowedValues), "received": fmt.Sprint(value)},
+	)
 }
@@ -215,6 +215,17 @@ func TestEnumNullable(t *testing.T) {
 }
 
 // Test Default
+// Test a default outside the enum fails like any other value
+func TestEnumDefaultNotAllowed(t *testing.T) {
+	result := Enum([]interface{}{"a", "b"}).Default("z").Parse(nil)
+	if result.Ok || result.Errors[0].Code != CodeInvalidEnumValue {
+		t.Errorf("Expected a default outside the enum to fail, got %v", result)
+	}
+	if Enum([]interface{}{"a", "b"}).DefaultFunc(func() interface{} { return "z" }).Parse(nil).Ok {
+		t.Error("Expected a generated default outside the enum to fail")
+	}
+}
+
 func TestEnumDefault(t *testing.T) {
 	schema := Enum([]interface{}{"a", "b", "c"}).Default("b")
 
@@ -0,0 +1,55 @@
+//go:build !zogo_noreflect && !zogo_minimal
+
+package zogo
+
+import (
+	"reflect"
+)
+
+// deepEqual compares two values for equality, handling different numeric types
+func deepEqual(a, b interface{}) bool {
+	// Use reflect.DeepEqual for most cases
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+
+	// Handle numeric type conversions
+	// This allows comparing int(1) with float64(1), etc.
+	aVal := reflect.ValueOf(a)
+	bVal := reflect.ValueOf(b)
+
+	// Check if both are numeric
+	if isNumeric(aVal.Kind()) && isNumeric(bVal.Kind()) {
+		// Convert both to float64 for comparison
+		aFloat := toFloat64(a)
+		bFloat := toFloat64(b)
+		return aFloat == bFloat
+	}
+
+	return false
+}
+
+// isNumeric checks if a reflect.Kind is a numeric type
+func isNumeric(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// toFloat64 converts numeric values to float64
+func toFloat64(val interface{}) float64 {
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+	return 0
+}
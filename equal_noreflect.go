@@ -0,0 +1,89 @@
+//go:build zogo_noreflect || zogo_minimal
+
+package zogo
+
+// deepEqual compares two values for equality without using reflection.
+// It understands the value shapes produced by encoding/json (numbers, strings,
+// booleans, []interface{} and map[string]interface{}); any other values are
+// compared with == when they are comparable.
+func deepEqual(a, b interface{}) (equal bool) {
+	// Handle numeric type conversions
+	// This allows comparing int(1) with float64(1), etc.
+	if aFloat, ok := numericValue(a); ok {
+		bFloat, ok := numericValue(b)
+		return ok && aFloat == bFloat
+	}
+
+	switch av := a.(type) {
+	case nil:
+		return b == nil
+	case string:
+		bv, ok := b.(string)
+		return ok && av == bv
+	case bool:
+		bv, ok := b.(bool)
+		return ok && av == bv
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) || (av == nil) != (bv == nil) {
+			return false
+		}
+		for i := range av {
+			if !deepEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) || (av == nil) != (bv == nil) {
+			return false
+		}
+		for key, aElem := range av {
+			bElem, exists := bv[key]
+			if !exists || !deepEqual(aElem, bElem) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Uncomparable dynamic types (e.g. other slices or maps) panic on ==
+	defer func() {
+		if recover() != nil {
+			equal = false
+		}
+	}()
+	return a == b
+}
+
+// numericValue converts built-in numeric types to float64
+func numericValue(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,35 @@
+package zogo
+
+import "testing"
+
+// Test deepEqual behaves the same with and without reflection
+func TestDeepEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  interface{}
+		equal bool
+	}{
+		{"same strings", "a", "a", true},
+		{"different strings", "a", "b", false},
+		{"int and float", 1, 1.0, true},
+		{"int and uint8", 200, uint8(200), true},
+		{"different numbers", 1, 2, false},
+		{"number and string", 1, "1", false},
+		{"bools", true, true, true},
+		{"nil and nil", nil, nil, true},
+		{"nil and value", nil, "", false},
+		{"arrays", []interface{}{1.5, "a"}, []interface{}{1.5, "a"}, true},
+		{"arrays of different length", []interface{}{1}, []interface{}{1, 2}, false},
+		{"maps", map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1}, true},
+		{"maps with different keys", map[string]interface{}{"a": 1}, map[string]interface{}{"b": 1}, false},
+		{"uncomparable values", []string{"a"}, []string{"b"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deepEqual(tt.a, tt.b); got != tt.equal {
+				t.Errorf("deepEqual(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.equal)
+			}
+		})
+	}
+}
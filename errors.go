@@ -2,15 +2,34 @@ package zogo
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
 // ValidationError represents a single validation error
 type ValidationError struct {
-	Path    string // Field path (e.g., "user.email" or "items[0].name")
-	Message string // Human-readable error message
-	Value   any    // The value that failed validation
-	Code    string // Error code (e.g., "invalid_type", "too_small")
+	Path    string         // Field path (e.g., "user.email" or "items[0].name")
+	Message string         // Human-readable error message
+	Value   any            // The value that failed validation
+	Code    string         // Error code (e.g., "invalid_type", "too_small"), one of the Code* constants
+	Params  map[string]any // Message parameters (e.g., "minimum": 5) used for translation
+	Cause   error          // Underlying error, for checks that could not complete
+
+	// Branches holds the errors of each union member, for invalid_union errors
+	Branches []UnionBranch
+
+	// segments is Path split into field names and array indices by the
+	// validators that built it, so a record key such as "[5]" is not taken
+	// for an index. It only describes Path while Path equals segmentsPath.
+	segments     []pathToken
+	segmentsPath string
+}
+
+// UnionBranch holds the errors of a union member that did not match
+type UnionBranch struct {
+	Index  int              // Position of the member in the union, from 0
+	Name   string           // Name the member's schema is registered under, if any
+	Errors ValidationErrors // The member's errors, with paths relative to the union value
 }
 
 // Error returns the error message
@@ -21,6 +40,40 @@ func (e ValidationError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error, if any
+func (e ValidationError) Unwrap() error {
+	return e.Cause
+}
+
+// tokens returns the segments of the error's path, parsing Path when the
+// validators did not record them or Path was changed since
+func (e ValidationError) tokens() []pathToken {
+	if e.segments != nil && e.segmentsPath == e.Path {
+		return e.segments
+	}
+	return pathTokens(e.Path)
+}
+
+// indexToken is the path segment of an array or tuple element
+func indexToken(i int) pathToken {
+	return pathToken{name: strconv.Itoa(i), index: true}
+}
+
+// atKey returns the error placed at an input key, which stays a single
+// field name even when it looks like a path or an index
+func (e ValidationError) atKey(key string) ValidationError {
+	e.nest(key, pathToken{name: key})
+	return e
+}
+
+// nest moves the error under a parent value whose path is written as
+// prefix and made of the given segments
+func (e *ValidationError) nest(prefix string, parent ...pathToken) {
+	e.segments = append(append([]pathToken(nil), parent...), e.tokens()...)
+	e.Path = prefix + prependPath(e.Path)
+	e.segmentsPath = e.Path
+}
+
 // ValidationErrors is a collection of validation errors
 type ValidationErrors []ValidationError
 
@@ -42,6 +95,73 @@ func (e ValidationErrors) Error() string {
 	return sb.String()
 }
 
+// Unwrap returns each error of the collection, so errors.As and errors.Is
+// see the individual errors and their causes
+func (e ValidationErrors) Unwrap() []error {
+	return e.WrappedErrors()
+}
+
+// WrappedErrors returns each error of the collection, for code written
+// against hashicorp/go-multierror
+func (e ValidationErrors) WrappedErrors() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// ErrorOrNil returns the collection as an error, or nil if it is empty, since
+// an empty collection returned as an error is not nil
+func (e ValidationErrors) ErrorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// CollectErrors gathers the validation errors found anywhere in err, which
+// may join several failures with errors.Join, fmt.Errorf's %w or a
+// multierror. Other errors in the tree are ignored. It lets a service merge
+// the validation failures of its sub-requests into one response:
+//
+//	err := errors.Join(checkUser(req), checkOrder(req))
+//	writeErrors(w, zogo.CollectErrors(err))
+func CollectErrors(err error) ValidationErrors {
+	var collected ValidationErrors
+	collectErrors(err, &collected)
+	return collected
+}
+
+// collectErrors walks an error tree, appending validation errors
+func collectErrors(err error, collected *ValidationErrors) {
+	switch err := err.(type) {
+	case nil:
+	case ValidationErrors:
+		*collected = append(*collected, err...)
+	case *ValidationErrors:
+		if err != nil {
+			*collected = append(*collected, *err...)
+		}
+	case ValidationError:
+		*collected = append(*collected, err)
+	case *ValidationError:
+		if err != nil {
+			*collected = append(*collected, *err)
+		}
+	case interface{ Unwrap() []error }:
+		for _, wrapped := range err.Unwrap() {
+			collectErrors(wrapped, collected)
+		}
+	case interface{ WrappedErrors() []error }:
+		for _, wrapped := range err.WrappedErrors() {
+			collectErrors(wrapped, collected)
+		}
+	case interface{ Unwrap() error }:
+		collectErrors(err.Unwrap(), collected)
+	}
+}
+
 // First returns the first error, or nil if there are no errors
 func (e ValidationErrors) First() *ValidationError {
 	if len(e) == 0 {
@@ -81,8 +201,251 @@ func (e ValidationErrors) Issues() []map[string]interface{} {
 			"code":    err.Code,
 		}
 		if err.Value != nil {
-			issues[i]["received"] = err.Value
+			issues[i]["received"], _ = SanitizeJSON(err.Value)
+		}
+		if len(err.Branches) > 0 {
+			branches := make([]map[string]interface{}, len(err.Branches))
+			for j, branch := range err.Branches {
+				branches[j] = map[string]interface{}{
+					"index":  branch.Index,
+					"errors": branch.Errors.Issues(),
+				}
+				if branch.Name != "" {
+					branches[j]["name"] = branch.Name
+				}
+			}
+			issues[i]["branches"] = branches
 		}
 	}
 	return issues
 }
+
+// Pointer returns the error location as an RFC 6901 JSON Pointer
+// (e.g., "users[1].email" becomes "/users/1/email"). Errors on the root
+// value return the empty pointer "".
+func (e ValidationError) Pointer() string {
+	var sb strings.Builder
+	for _, token := range e.tokens() {
+		sb.WriteByte('/')
+		sb.WriteString(pointerEscaper.Replace(token.name))
+	}
+	return sb.String()
+}
+
+// Pointers returns the JSON Pointer of each error, in the same order as the errors
+func (e ValidationErrors) Pointers() []string {
+	pointers := make([]string, len(e))
+	for i, err := range e {
+		pointers[i] = err.Pointer()
+	}
+	return pointers
+}
+
+// pointerEscaper escapes reference tokens per RFC 6901 ("~" before "/")
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// pathToken is a single field name or array index in an error path
+type pathToken struct {
+	name  string
+	index bool // Set for "[n]" segments
+}
+
+// pathTokens splits an error path into its field names and indices
+// ("items[0].name" -> items, [0], name). Record key errors ("key(foo)")
+// resolve to the key itself.
+func pathTokens(path string) []pathToken {
+	var tokens []pathToken
+	for path != "" {
+		switch {
+		case path[0] == '.':
+			path = path[1:]
+		case strings.HasPrefix(path, `["`):
+			// A quoted key, as written by tokensPath
+			quoted, err := strconv.QuotedPrefix(path[1:])
+			if err != nil || !strings.HasPrefix(path[1+len(quoted):], "]") {
+				tokens = append(tokens, pathToken{name: path[1:]})
+				return tokens
+			}
+			name, _ := strconv.Unquote(quoted)
+			tokens = append(tokens, pathToken{name: name})
+			path = path[len(quoted)+2:]
+		case path[0] == '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				tokens = append(tokens, pathToken{name: path[1:], index: true})
+				return tokens
+			}
+			tokens = append(tokens, pathToken{name: path[1:end], index: true})
+			path = path[end+1:]
+		case strings.HasPrefix(path, "key(") && strings.Contains(path, ")"):
+			end := strings.IndexByte(path, ')')
+			tokens = append(tokens, pathToken{name: path[4:end]})
+			path = path[end+1:]
+		default:
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			tokens = append(tokens, pathToken{name: path[:end]})
+			path = path[end:]
+		}
+	}
+	return tokens
+}
+
+// tokensPath formats path tokens as a path that pathTokens reads back: names
+// joined by dots and indices in brackets, with names that hold ".", "[" or
+// "]" quoted in brackets (limits["a.b"])
+func tokensPath(tokens []pathToken) string {
+	var sb strings.Builder
+	for i, token := range tokens {
+		switch {
+		case token.index:
+			sb.WriteString("[" + token.name + "]")
+		case strings.ContainsAny(token.name, ".[]"):
+			sb.WriteString("[" + strconv.Quote(token.name) + "]")
+		default:
+			if i > 0 {
+				sb.WriteByte('.')
+			}
+			sb.WriteString(token.name)
+		}
+	}
+	return sb.String()
+}
+
+// FlattenedErrors groups error messages by top-level field, the shape most
+// web frontends expect when rendering per-field messages
+type FlattenedErrors struct {
+	FormErrors  []string            `json:"formErrors"`  // Errors on the root value
+	FieldErrors map[string][]string `json:"fieldErrors"` // Errors keyed by top-level field
+}
+
+// Flatten groups error messages by top-level field. Errors on nested paths
+// ("address.city") are reported under their top-level field ("address");
+// errors without a path are collected in FormErrors.
+func (e ValidationErrors) Flatten() FlattenedErrors {
+	flat := FlattenedErrors{
+		FormErrors:  []string{},
+		FieldErrors: map[string][]string{},
+	}
+	for _, err := range e {
+		tokens := err.tokens()
+		if len(tokens) == 0 {
+			flat.FormErrors = append(flat.FormErrors, err.Message)
+			continue
+		}
+		field := tokens[0].name
+		flat.FieldErrors[field] = append(flat.FieldErrors[field], err.Message)
+	}
+	return flat
+}
+
+// FieldErrors maps field paths to the first error message for each, for
+// server-rendered forms. Errors without a path are under "", and keys
+// holding ".", "[" or "]" are quoted in brackets (limits["a.b"]) so they
+// are not mistaken for nested fields.
+type FieldErrors map[string]string
+
+// Fields returns the first error message per field path, for rendering a
+// form with html/template or templ, where one message per input is shown:
+//
+//	<input name="email" {{if .Errors.Has "email"}}aria-invalid="true"{{end}}>
+//	<small>{{.Errors.Get "email"}}</small>
+//
+// Messages are plain text, so templates escape them as usual.
+func (e ValidationErrors) Fields() FieldErrors {
+	fields := FieldErrors{}
+	for _, err := range e {
+		path := tokensPath(err.tokens())
+		if _, ok := fields[path]; !ok {
+			fields[path] = err.Message
+		}
+	}
+	return fields
+}
+
+// Has reports whether the field has an error. It is safe to call on a nil
+// FieldErrors, such as when a form is first rendered.
+func (f FieldErrors) Has(field string) bool {
+	_, ok := f[field]
+	return ok
+}
+
+// Get returns the field's error message, or "" if it has none
+func (f FieldErrors) Get(field string) string {
+	return f[field]
+}
+
+// ErrorTree is a nested view of validation errors mirroring the input shape.
+// Each node holds the messages for its own value plus the subtrees of its
+// object fields (Properties) or array elements (Items). Items only holds
+// the indices of failing elements.
+type ErrorTree struct {
+	Errors     []string              `json:"errors"`
+	Properties map[string]*ErrorTree `json:"properties,omitempty"`
+	Items      map[int]*ErrorTree    `json:"items,omitempty"`
+}
+
+// Tree returns the errors as a nested ErrorTree, so deeply nested forms can
+// consume errors hierarchically instead of by flat path
+func (e ValidationErrors) Tree() *ErrorTree {
+	root := &ErrorTree{Errors: []string{}}
+	for _, err := range e {
+		node := root
+		for _, token := range err.tokens() {
+			node = node.child(token)
+		}
+		node.Errors = append(node.Errors, err.Message)
+	}
+	return root
+}
+
+// Get returns the subtree at the given path ("users[1].email"), or nil if
+// there are no errors at or below it. Keys holding ".", "[" or "]" are
+// quoted in brackets, as in FieldErrors (limits["a.b"]).
+func (t *ErrorTree) Get(path string) *ErrorTree {
+	node := t
+	for _, token := range pathTokens(path) {
+		if node == nil {
+			return nil
+		}
+		if token.index {
+			i, err := strconv.Atoi(token.name)
+			if err != nil {
+				return nil
+			}
+			node = node.Items[i]
+		} else {
+			node = node.Properties[token.name]
+		}
+	}
+	return node
+}
+
+// child returns the subtree for a path token, creating it if needed
+func (t *ErrorTree) child(token pathToken) *ErrorTree {
+	if token.index {
+		if i, err := strconv.Atoi(token.name); err == nil && i >= 0 {
+			if t.Items == nil {
+				t.Items = map[int]*ErrorTree{}
+			}
+			node, ok := t.Items[i]
+			if !ok {
+				node = &ErrorTree{Errors: []string{}}
+				t.Items[i] = node
+			}
+			return node
+		}
+	}
+
+	if t.Properties == nil {
+		t.Properties = map[string]*ErrorTree{}
+	}
+	node, ok := t.Properties[token.name]
+	if !ok {
+		node = &ErrorTree{Errors: []string{}}
+		t.Properties[token.name] = node
+	}
+	return node
+}
@@ -1,6 +1,11 @@
 package zogo
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
 	"strings"
 	"testing"
 )
@@ -273,3 +278,291 @@ func TestEmptyErrors(t *testing.T) {
 		t.Error("Expected Issues() to return empty array")
 	}
 }
+
+// Test ValidationError.Pointer()
+func TestValidationErrorPointer(t *testing.T) {
+	tests := map[string]string{
+		"":                   "",
+		"email":              "/email",
+		"users[1].email":     "/users/1/email",
+		"[0]":                "/0",
+		"[2][3].name":        "/2/3/name",
+		"matrix[0][1]":       "/matrix/0/1",
+		"key(bad-key)":       "/bad-key",
+		"links.a/b":          "/links/a~1b",
+		"settings.~tilde":    "/settings/~0tilde",
+		"order.items[10].id": "/order/items/10/id",
+	}
+
+	for path, expected := range tests {
+		err := ValidationError{Path: path}
+		if got := err.Pointer(); got != expected {
+			t.Errorf("Pointer() for %q = %q, want %q", path, got, expected)
+		}
+	}
+}
+
+// Test ValidationErrors.Pointers() from a real validation
+func TestValidationErrorsPointers(t *testing.T) {
+	schema := Object(Schema{
+		"users": Array(Object(Schema{
+			"email": String().Email(),
+		})),
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{"email": "a@example.com"},
+			map[string]interface{}{"email": "invalid"},
+		},
+	})
+
+	pointers := result.Errors.Pointers()
+	if len(pointers) != 1 || pointers[0] != "/users/1/email" {
+		t.Errorf("Expected [/users/1/email], got %v", pointers)
+	}
+}
+
+// Test ValidationErrors.Flatten()
+func TestValidationErrorsFlatten(t *testing.T) {
+	errors := ValidationErrors{
+		{Path: "", Message: "Passwords do not match"},
+		{Path: "email", Message: "Invalid email"},
+		{Path: "email", Message: "Too short"},
+		{Path: "address.city", Message: "Required"},
+		{Path: "tags[2]", Message: "Too long"},
+	}
+
+	flat := errors.Flatten()
+
+	if len(flat.FormErrors) != 1 || flat.FormErrors[0] != "Passwords do not match" {
+		t.Errorf("Unexpected form errors: %v", flat.FormErrors)
+	}
+	if len(flat.FieldErrors["email"]) != 2 || flat.FieldErrors["email"][1] != "Too short" {
+		t.Errorf("Expected 2 email errors in order, got %v", flat.FieldErrors["email"])
+	}
+	if len(flat.FieldErrors["address"]) != 1 {
+		t.Errorf("Expected nested error under top-level field, got %v", flat.FieldErrors)
+	}
+	if len(flat.FieldErrors["tags"]) != 1 {
+		t.Errorf("Expected indexed error under top-level field, got %v", flat.FieldErrors)
+	}
+}
+
+// Test Flatten() on empty errors and its JSON shape
+func TestValidationErrorsFlattenJSON(t *testing.T) {
+	flat := ValidationErrors{}.Flatten()
+	if flat.FormErrors == nil || flat.FieldErrors == nil {
+		t.Error("Expected empty, non-nil collections")
+	}
+
+	data, err := json.Marshal(ValidationErrors{{Path: "name", Message: "Required"}}.Flatten())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"formErrors":[],"fieldErrors":{"name":["Required"]}}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+// Test ValidationErrors.Tree()
+func TestValidationErrorsTree(t *testing.T) {
+	errors := ValidationErrors{
+		{Path: "", Message: "Invalid form"},
+		{Path: "name", Message: "Required"},
+		{Path: "address.city", Message: "Too short"},
+		{Path: "users[2].email", Message: "Invalid email"},
+		{Path: "users[2].email", Message: "Too long"},
+		{Path: "tags[0]", Message: "Empty tag"},
+		{Path: "meta.key(bad key)", Message: "Invalid key"},
+	}
+
+	tree := errors.Tree()
+
+	if len(tree.Errors) != 1 || tree.Errors[0] != "Invalid form" {
+		t.Errorf("Unexpected root errors: %v", tree.Errors)
+	}
+	if tree.Properties["name"].Errors[0] != "Required" {
+		t.Errorf("Unexpected name errors: %v", tree.Properties["name"].Errors)
+	}
+	if tree.Properties["address"].Properties["city"].Errors[0] != "Too short" {
+		t.Error("Expected nested object errors under address.city")
+	}
+
+	users := tree.Properties["users"]
+	if len(users.Items) != 1 || users.Items[2] == nil {
+		t.Fatalf("Expected only index 2 in items, got %v", users.Items)
+	}
+	if len(users.Items[2].Properties["email"].Errors) != 2 {
+		t.Error("Expected both errors under users[2].email")
+	}
+	if tree.Properties["meta"].Properties["bad key"] == nil {
+		t.Error("Expected record key errors under the key")
+	}
+
+	if got := tree.Get("users[2].email"); got == nil || len(got.Errors) != 2 {
+		t.Errorf("Expected Get to find users[2].email, got %v", got)
+	}
+	if tree.Get("users[1].email") != nil || tree.Get("users[9]") != nil || tree.Get("missing.field") != nil {
+		t.Error("Expected Get to return nil for paths without errors")
+	}
+}
+
+// Test Tree() JSON shape from a real validation
+func TestValidationErrorsTreeJSON(t *testing.T) {
+	schema := Object(Schema{
+		"items": Array(Object(Schema{"qty": Number().Min(1)})),
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"qty": 2},
+			map[string]interface{}{"qty": 0},
+		},
+	})
+
+	data, err := json.Marshal(result.Errors.Tree())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"errors":[],"properties":{"items":{"errors":[],"items":{"1":{"errors":[],"properties":{"qty":{"errors":["Number must be at least 1"]}}}}}}}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+// Test Tree() keeps record keys that look like indices as properties
+func TestValidationErrorsTreeIndexLikeKeys(t *testing.T) {
+	schema := Record(String(), Array(Number().Min(1)))
+
+	result := schema.Parse(map[string]interface{}{
+		"[200000000]": "not an array",
+		"[1]":         []interface{}{5, 0},
+	})
+	tree := result.Errors.Tree()
+
+	if len(tree.Items) != 0 {
+		t.Fatalf("Expected record keys not to become items, got %v", tree.Items)
+	}
+	if node := tree.Properties["[200000000]"]; node == nil || len(node.Errors) != 1 {
+		t.Errorf("Expected the error under the key, got %v", tree.Properties)
+	}
+	if node := tree.Properties["[1]"]; node == nil || node.Items[1] == nil || len(node.Items) != 1 {
+		t.Errorf("Expected the element error under the key's items, got %v", tree.Properties["[1]"])
+	}
+	if pointers := result.Errors.ByPath("[1][1]").Pointers(); len(pointers) != 1 || pointers[0] != "/[1]/1" {
+		t.Errorf("Expected the key to stay one pointer segment, got %v", pointers)
+	}
+
+	duplicate := ParseJSON(context.Background(), Any(), []byte(`{"[200000000]": 1, "[200000000]": 2}`), WithStrictJSON())
+	if tree := duplicate.Errors.Tree(); duplicate.Ok || len(tree.Items) != 0 || tree.Properties["[200000000]"] == nil {
+		t.Errorf("Expected the duplicate key error under the key, got %v", duplicate.Errors)
+	}
+
+	hand := ValidationErrors{{Path: "[200000000]", Message: "Too big"}}.Tree()
+	if len(hand.Items) != 1 || hand.Items[200000000] == nil {
+		t.Errorf("Expected a sparse item for a hand-built index path, got %v", hand.Items)
+	}
+}
+
+// Test Fields keeps the first error per field for form templates
+func TestValidationErrorsFields(t *testing.T) {
+	errors := ValidationErrors{
+		{Path: "", Message: "Passwords do not match"},
+		{Path: "email", Message: "Invalid <email>"},
+		{Path: "email", Message: "Too short"},
+		{Path: "address.city", Message: "Required"},
+	}
+	fields := errors.Fields()
+	if len(fields) != 3 || fields.Get("email") != "Invalid <email>" || fields.Get("") != "Passwords do not match" {
+		t.Errorf("Unexpected fields: %v", fields)
+	}
+	if !fields.Has("address.city") || fields.Has("name") || fields.Get("name") != "" {
+		t.Error("Unexpected Has/Get results")
+	}
+
+	var none FieldErrors
+	if none.Has("email") || none.Get("email") != "" {
+		t.Error("Expected nil FieldErrors to have no errors")
+	}
+
+	tmpl := template.Must(template.New("form").Parse(
+		`<input name="email"{{if .Has "email"}} aria-invalid="true"{{end}}><small>{{.Get "email"}}</small>` +
+			`<input name="name"{{if .Has "name"}} aria-invalid="true"{{end}}>`))
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, fields); err != nil {
+		t.Fatal(err)
+	}
+	want := `<input name="email" aria-invalid="true"><small>Invalid &lt;email&gt;</small><input name="name">`
+	if sb.String() != want {
+		t.Errorf("Expected %s, got %s", want, sb.String())
+	}
+}
+
+// multiError mimics hashicorp/go-multierror's Error type
+type multiError struct {
+	Errors []error
+}
+
+func (m *multiError) Error() string { return fmt.Sprintf("%d errors occurred", len(m.Errors)) }
+
+func (m *multiError) WrappedErrors() []error { return m.Errors }
+
+// Test ValidationErrors unwrap to their individual errors and are collected
+// back out of joined errors
+func TestValidationErrorsJoin(t *testing.T) {
+	user := Object(Schema{"email": String().Email()}).Parse(map[string]any{"email": "x"}).Errors
+	order := Object(Schema{"qty": Number().Min(1)}).Parse(map[string]any{"qty": 0}).Errors
+	canceled := ValidationErrors{{Message: "Validation canceled", Code: CodeCanceled, Cause: context.Canceled}}
+
+	joined := errors.Join(user, fmt.Errorf("order: %w", order), errors.New("upstream timeout"))
+	var single ValidationError
+	if !errors.As(joined, &single) || single.Path != "email" {
+		t.Errorf("Expected errors.As to find the first validation error, got %+v", single)
+	}
+	if !errors.Is(errors.Join(order, canceled), context.Canceled) {
+		t.Error("Expected errors.Is to reach the cause of a validation error")
+	}
+
+	collected := CollectErrors(joined)
+	if len(collected) != 2 || collected[0].Path != "email" || collected[1].Path != "qty" {
+		t.Errorf("Unexpected collected errors: %v", collected)
+	}
+
+	multi := &multiError{Errors: []error{user, &multiError{Errors: []error{order[0]}}}}
+	if collected := CollectErrors(multi); len(collected) != 2 || collected[1].Code != CodeTooSmall {
+		t.Errorf("Unexpected errors collected from a multierror: %v", collected)
+	}
+	if len(user.WrappedErrors()) != 1 || CollectErrors(errors.New("x")) != nil || CollectErrors(nil) != nil {
+		t.Error("Unexpected WrappedErrors or CollectErrors results")
+	}
+
+	var none ValidationErrors
+	if none.ErrorOrNil() != nil || user.ErrorOrNil() == nil {
+		t.Error("Expected ErrorOrNil to be nil only for an empty collection")
+	}
+}
+
+// Test Flatten and Fields group record keys holding dots or brackets
+// under the right field
+func TestValidationErrorsDottedRecordKeys(t *testing.T) {
+	schema := Record(String(), Number().Max(1))
+	result := schema.Parse(map[string]interface{}{"a.b": 5, "x[0]": 5})
+
+	flat := result.Errors.Flatten()
+	if len(flat.FieldErrors) != 2 || len(flat.FieldErrors["a.b"]) != 1 || len(flat.FieldErrors["x[0]"]) != 1 {
+		t.Errorf("Expected errors under the dotted keys, got %v", flat.FieldErrors)
+	}
+
+	nested := Object(Schema{"limits": schema}).Parse(map[string]interface{}{
+		"limits": map[string]interface{}{"a.b": 5},
+	})
+	fields := nested.Errors.Fields()
+	if !fields.Has(`limits["a.b"]`) || fields.Has("limits.a.b") {
+		t.Errorf("Expected the dotted key to be quoted, got %v", fields)
+	}
+	if tree := nested.Errors.Tree(); tree.Get(`limits["a.b"]`) == nil || tree.Get("limits.a.b") != nil {
+		t.Errorf("Expected Get to find the quoted key, got %+v", tree.Get("limits"))
+	}
+}
@@ -0,0 +1,80 @@
+package zogo
+
+// TB is the subset of testing.TB used by RunExamples and the snapshot
+// assertions
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
+
+// schemaExamples holds the values a schema declares it accepts and rejects
+type schemaExamples struct {
+	valid   []any
+	invalid []any
+}
+
+// RunExamples checks that a schema accepts each valid value and rejects each
+// invalid value declared with Examples, so the examples next to a schema
+// definition stay true as it changes:
+//
+//	var Username = zogo.String().Min(3).Regex(`^[a-z0-9_]+$`).Examples(
+//		[]any{"ada", "grace_h"},
+//		[]any{"", "Ada Lovelace", 42},
+//	)
+//
+//	func TestUsername(t *testing.T) { zogo.RunExamples(t, Username) }
+//
+// It fails the test if the schema declares no examples.
+func RunExamples(t TB, validator Validator) {
+	t.Helper()
+
+	examples := examplesOf(validator)
+	if len(examples.valid) == 0 && len(examples.invalid) == 0 {
+		t.Fatalf("zogo: schema %T declares no examples", validator)
+		return
+	}
+	for i, value := range examples.valid {
+		if result := validator.Parse(value); !result.Ok {
+			t.Errorf("zogo: valid example %d (%v) was rejected: %v", i, value, result.Errors)
+		}
+	}
+	for i, value := range examples.invalid {
+		if result := validator.Parse(value); result.Ok {
+			t.Errorf("zogo: invalid example %d (%v) was accepted as %v", i, value, result.Value)
+		}
+	}
+}
+
+// examplesOf returns the examples declared on a validator
+func examplesOf(validator Validator) schemaExamples {
+	switch validator := validator.(type) {
+	case *StringValidator:
+		return validator.examples
+	case *NumberValidator:
+		return validator.examples
+	case *BooleanValidator:
+		return validator.examples
+	case *BytesValidator:
+		return validator.examples
+	case *DateValidator:
+		return validator.examples
+	case *ObjectValidator:
+		return validator.examples
+	case *ArrayValidator:
+		return validator.examples
+	case *TupleValidator:
+		return validator.examples
+	case *RecordValidator:
+		return validator.examples
+	case *EnumValidator:
+		return validator.examples
+	case *UnionValidator:
+		return validator.examples
+	case *DiscriminatedUnionValidator:
+		return validator.examples
+	case *SensitiveValidator:
+		return examplesOf(validator.validator)
+	}
+	return schemaExamples{}
+}
@@ -0,0 +1,62 @@
+//go:build js && wasm
+
+// Command wasm exposes a zogo schema to JavaScript so the same validation
+// rules can run in the browser.
+//
+// Build with:
+//
+//	GOOS=js GOARCH=wasm go build -o main.wasm ./wasm
+//
+// or, for a smaller binary:
+//
+//	tinygo build -o main.wasm -target wasm -tags zogo_minimal ./wasm
+package main
+
+import (
+	"encoding/json"
+	"syscall/js"
+
+	"github.com/hkurdi/zogo"
+)
+
+var signupSchema = zogo.Object(zogo.Schema{
+	"username": zogo.String().Min(3).Max(20),
+	"email":    zogo.String().Email(),
+	"age":      zogo.Number().Int().Min(13).Optional(),
+})
+
+// validateSignup accepts a JSON string and an optional language tag and returns {ok, issues}
+func validateSignup(this js.Value, args []js.Value) any {
+	if len(args) == 0 {
+		return js.ValueOf(map[string]any{"ok": false, "error": "expected a JSON string argument"})
+	}
+
+	var input any
+	if err := json.Unmarshal([]byte(args[0].String()), &input); err != nil {
+		return js.ValueOf(map[string]any{"ok": false, "error": err.Error()})
+	}
+
+	lang := "en"
+	if len(args) > 1 {
+		lang = args[1].String()
+	}
+
+	result := zogo.Localized(signupSchema, lang).Parse(input)
+	issues := make([]any, len(result.Errors))
+	for i, err := range result.Errors {
+		issues[i] = map[string]any{
+			"path":    err.Path,
+			"message": err.Message,
+			"code":    err.Code,
+		}
+	}
+
+	return js.ValueOf(map[string]any{"ok": result.Ok, "issues": issues})
+}
+
+func main() {
+	js.Global().Set("validateSignup", js.FuncOf(validateSignup))
+
+	// Keep the Go runtime alive so JavaScript can keep calling in
+	select {}
+}
@@ -0,0 +1,59 @@
+package zogo
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// recordingTB captures the failures of RunExamples and snapshot assertions
+type recordingTB struct {
+	failures []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Fatalf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+// Test RunExamples passes when the declared examples hold
+func TestRunExamples(t *testing.T) {
+	username := String().Min(3).Regex(`^[a-z0-9_]+$`).Examples(
+		[]any{"ada", "grace_h"},
+		[]any{"", "Ada Lovelace", 42},
+	)
+	RunExamples(t, username)
+
+	point := Object(Schema{"x": Number(), "y": Number()}).Examples(
+		[]any{map[string]any{"x": 1, "y": 2}},
+		[]any{map[string]any{"x": 1}, []any{1, 2}},
+	)
+	RunExamples(t, point)
+	RunExamples(t, Sensitive(Enum([]any{"a", "b"}).Examples([]any{"a"}, []any{"c"})))
+}
+
+// Test RunExamples reports examples that no longer hold
+func TestRunExamplesFailures(t *testing.T) {
+	rec := &recordingTB{}
+	RunExamples(rec, Number().Int().Examples([]any{1, 2.5}, []any{"1", 3}))
+	if len(rec.failures) != 2 {
+		t.Fatalf("Expected 2 failures, got %v", rec.failures)
+	}
+	if !strings.Contains(rec.failures[0], "valid example 1 (2.5) was rejected") {
+		t.Errorf("Unexpected failure for a rejected valid example: %s", rec.failures[0])
+	}
+	if !strings.Contains(rec.failures[1], "invalid example 1 (3) was accepted") {
+		t.Errorf("Unexpected failure for an accepted invalid example: %s", rec.failures[1])
+	}
+
+	rec = &recordingTB{}
+	RunExamples(rec, String())
+	if len(rec.failures) != 1 || !strings.Contains(rec.failures[0], "declares no examples") {
+		t.Errorf("Expected a schema without examples to fail, got %v", rec.failures)
+	}
+}
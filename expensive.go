@@ -0,0 +1,164 @@
+package zogo
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// CheckFunc is a validation step that may block on I/O, such as a DNS or
+// database lookup. It returns a ValidationError or ValidationErrors when the
+// value is invalid, and any other error when the check itself could not
+// complete (e.g. the dependency is down).
+type CheckFunc func(ctx context.Context, value any) error
+
+// Reasons an expensive check was skipped
+const (
+	SkipSampled     = "sampled"      // The parse was not selected by the sample rate
+	SkipCircuitOpen = "circuit_open" // The circuit breaker is open after repeated failures
+	SkipCheckError  = "check_error"  // The check returned a non-validation error
+)
+
+// SkippedCheck records an expensive check that did not run or could not complete
+type SkippedCheck struct {
+	Name   string // Name given to Expensive
+	Path   string // Path of the checked value
+	Reason string // SkipSampled, SkipCircuitOpen or SkipCheckError
+	Err    error  // The check's error, for SkipCheckError
+}
+
+// ExpensiveValidator runs a costly check after its validator succeeds,
+// degrading gracefully when the check is sampled out or its dependency fails
+type ExpensiveValidator struct {
+	validator  Validator
+	name       string
+	check      CheckFunc
+	sampleRate float64
+	breaker    *CircuitBreaker
+	random     func() float64 // Source for sampling, replaced in tests
+}
+
+// Expensive wraps a validator with a costly check that only runs once the
+// validator passes. The check can be sampled and guarded by a circuit
+// breaker; checks that don't run, or fail with a non-validation error, pass
+// the value and are recorded in ParseResult.Meta.Skipped.
+func Expensive(name string, validator Validator, check CheckFunc) *ExpensiveValidator {
+	return &ExpensiveValidator{
+		validator:  validator,
+		name:       name,
+		check:      check,
+		sampleRate: 1,
+		random:     rand.Float64,
+	}
+}
+
+// Sample runs the check for the given fraction of parses (0 to 1)
+func (v *ExpensiveValidator) Sample(rate float64) *ExpensiveValidator {
+	v.sampleRate = rate
+	return v
+}
+
+// CircuitBreaker skips the check while the breaker is open. Breakers can be
+// shared by all checks that depend on the same service.
+func (v *ExpensiveValidator) CircuitBreaker(breaker *CircuitBreaker) *ExpensiveValidator {
+	v.breaker = breaker
+	return v
+}
+
+// Parse validates the input value
+func (v *ExpensiveValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *ExpensiveValidator) parseWithState(value any, st *parseState) ParseResult {
+	result := st.parse(v.validator, value)
+	if !result.Ok || result.Value == nil {
+		return result
+	}
+
+	skip := func(reason string, err error) ParseResult {
+		st.meta.Skipped = append(st.meta.Skipped, SkippedCheck{Name: v.name, Reason: reason, Err: err})
+		return result
+	}
+
+	if v.sampleRate < 1 && v.random() >= v.sampleRate {
+		return skip(SkipSampled, nil)
+	}
+	if v.breaker != nil && !v.breaker.allow() {
+		return skip(SkipCircuitOpen, nil)
+	}
+
+	err := v.check(st.ctx, result.Value)
+	if _, invalid := validationErrors(err); invalid {
+		v.breaker.record(true)
+		return Failure(checkErrors(err, result.Value)...)
+	}
+	if err != nil {
+		v.breaker.record(false)
+		return skip(SkipCheckError, err)
+	}
+
+	v.breaker.record(true)
+	return result
+}
+
+// validationErrors extracts validation errors returned by a check
+func validationErrors(err error) (ValidationErrors, bool) {
+	list := CollectErrors(err)
+	return list, len(list) > 0
+}
+
+// CircuitBreaker stops calling a failing dependency. After threshold
+// consecutive failures it opens for the cooldown period; the next call after
+// the cooldown is a trial that closes the breaker on success or reopens it
+// on failure.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	now       func() time.Time // Clock, replaced in tests
+}
+
+// NewCircuitBreaker creates a breaker that opens after threshold consecutive failures
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Open reports whether the breaker is currently rejecting calls
+func (b *CircuitBreaker) Open() bool {
+	return !b.allow()
+}
+
+// allow reports whether a call may be made
+func (b *CircuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures < b.threshold || !b.now().Before(b.openUntil)
+}
+
+// record updates the breaker with the outcome of a call
+func (b *CircuitBreaker) record(success bool) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+	}
+}
@@ -0,0 +1,106 @@
+package zogo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Test the check runs after the wrapped validator passes
+func TestExpensiveCheck(t *testing.T) {
+	taken := map[string]bool{"alice": true}
+	schema := Expensive("username_available", String().Min(3), func(ctx context.Context, value any) error {
+		if taken[value.(string)] {
+			return ValidationError{Message: "Username is taken"}
+		}
+		return nil
+	})
+
+	if !schema.Parse("bob").Ok {
+		t.Error("Expected available username to pass")
+	}
+
+	result := schema.Parse("alice")
+	if result.Ok || result.Errors[0].Message != "Username is taken" || result.Errors[0].Code != CodeCustom {
+		t.Errorf("Expected coded check error, got %v", result.Errors)
+	}
+
+	calls := 0
+	counted := Expensive("count", String().Min(3), func(ctx context.Context, value any) error {
+		calls++
+		return nil
+	})
+	counted.Parse("ab")
+	if calls != 0 {
+		t.Error("Expected check to be skipped when the validator fails")
+	}
+}
+
+// Test sampled-out checks are recorded in result metadata with their path
+func TestExpensiveSampling(t *testing.T) {
+	calls := 0
+	check := Expensive("mx", String(), func(ctx context.Context, value any) error {
+		calls++
+		return nil
+	}).Sample(0.25)
+
+	rolls := []float64{0.1, 0.5, 0.9}
+	check.random = func() float64 {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	}
+
+	schema := Object(Schema{"emails": Array(check)})
+	result := schema.Parse(map[string]interface{}{
+		"emails": []interface{}{"a@example.com", "b@example.com", "c@example.com"},
+	})
+
+	if !result.Ok || calls != 1 {
+		t.Fatalf("Expected 1 sampled call and success, got %d calls (%v)", calls, result.Errors)
+	}
+	skipped := result.Meta.Skipped
+	if len(skipped) != 2 || skipped[0].Path != "emails[1]" || skipped[1].Path != "emails[2]" {
+		t.Fatalf("Unexpected skipped checks: %+v", skipped)
+	}
+	if skipped[0].Name != "mx" || skipped[0].Reason != SkipSampled {
+		t.Errorf("Unexpected skipped check: %+v", skipped[0])
+	}
+}
+
+// Test the circuit breaker opens after repeated dependency failures
+func TestExpensiveCircuitBreaker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	down := true
+	calls := 0
+	schema := Expensive("lookup", String(), func(ctx context.Context, value any) error {
+		calls++
+		if down {
+			return errors.New("connection refused")
+		}
+		return nil
+	}).CircuitBreaker(breaker)
+
+	for i := 0; i < 2; i++ {
+		result := schema.Parse("x")
+		if !result.Ok || result.Meta.Skipped[0].Reason != SkipCheckError || result.Meta.Skipped[0].Err == nil {
+			t.Fatalf("Expected dependency failure to pass and be recorded, got %+v", result)
+		}
+	}
+
+	result := schema.Parse("x")
+	if !breaker.Open() || calls != 2 || result.Meta.Skipped[0].Reason != SkipCircuitOpen {
+		t.Fatalf("Expected open breaker to skip the check, got %d calls and %+v", calls, result.Meta)
+	}
+
+	now = now.Add(2 * time.Minute)
+	down = false
+	result = schema.Parse("x")
+	if calls != 3 || len(result.Meta.Skipped) != 0 || breaker.Open() {
+		t.Errorf("Expected trial call to close the breaker, got %d calls and %+v", calls, result.Meta)
+	}
+}
@@ -0,0 +1,433 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// gatewayMethods are the OpenAPI operation keys of a path item
+var gatewayMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Gateway validates incoming requests against the operations of an OpenAPI
+// document (path parameters, query parameters, headers and JSON bodies)
+// before passing them on, typically to a reverse proxy:
+//
+//	doc, _ := zogo.LoadOpenAPI(spec)
+//	gateway, err := zogo.NewGateway(doc)
+//	proxy := httputil.NewSingleHostReverseProxy(backend)
+//	http.ListenAndServe(":8080", gateway.Middleware(proxy))
+//
+// Rejected requests get a response listing the errors, with paths prefixed
+// by where the value came from ("path.id", "query.limit",
+// "header.X-Request-Id", "body.name"), and a status chosen by the
+// StatusPolicy (400 by default, 413 for oversized bodies).
+type Gateway struct {
+	routes       []*gatewayRoute
+	allowUnknown bool
+	allowDupKeys bool
+	maxBodyBytes int64
+	policy       *StatusPolicy
+}
+
+// gatewayRoute is a compiled path template
+type gatewayRoute struct {
+	template   string
+	segments   []string // Path segments; "{name}" segments are parameters
+	literals   int      // Number of literal segments, used to prefer specific routes
+	operations map[string]*gatewayOperation
+}
+
+// gatewayOperation holds the validators of a single operation
+type gatewayOperation struct {
+	params       []gatewayParam
+	body         Validator
+	bodyRequired bool
+}
+
+// gatewayParam is a compiled path, query or header parameter
+type gatewayParam struct {
+	name      string
+	in        string
+	validator Validator
+	array     bool // Collect every query value instead of the first one
+}
+
+// NewGateway compiles the operations of an OpenAPI document
+func NewGateway(doc *OpenAPIDocument) (*Gateway, error) {
+	doc.mu.Lock()
+	defer doc.mu.Unlock()
+
+	paths, _ := doc.root["paths"].(map[string]any)
+
+	g := &Gateway{maxBodyBytes: 1 << 20}
+	for template, raw := range paths {
+		item, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("zogo: path %q is not a path item object", template)
+		}
+
+		route := &gatewayRoute{
+			template:   template,
+			segments:   splitPath(template),
+			operations: map[string]*gatewayOperation{},
+		}
+		for _, segment := range route.segments {
+			if !isPathParam(segment) {
+				route.literals++
+			}
+		}
+
+		at := "#/paths/" + pointerEscaper.Replace(template)
+		for _, method := range gatewayMethods {
+			operation, ok := item[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			compiled, err := doc.compileOperation(item, operation, at, method)
+			if err != nil {
+				return nil, err
+			}
+			route.operations[strings.ToUpper(method)] = compiled
+		}
+		g.routes = append(g.routes, route)
+	}
+
+	// Prefer routes with more literal segments ("/pets/mine" over "/pets/{id}")
+	sort.SliceStable(g.routes, func(i, j int) bool {
+		if g.routes[i].literals != g.routes[j].literals {
+			return g.routes[i].literals > g.routes[j].literals
+		}
+		return g.routes[i].template < g.routes[j].template
+	})
+	return g, nil
+}
+
+// AllowUnknownRoutes passes requests that match no path in the document
+// through unvalidated, instead of rejecting them with 404
+func (g *Gateway) AllowUnknownRoutes() *Gateway {
+	g.allowUnknown = true
+	return g
+}
+
+// AllowDuplicateKeys accepts JSON bodies with duplicate object keys, which
+// are rejected by default because the backend may resolve them differently
+// than the gateway did
+func (g *Gateway) AllowDuplicateKeys() *Gateway {
+	g.allowDupKeys = true
+	return g
+}
+
+// MaxBodyBytes limits the size of request bodies read for validation (default 1 MiB)
+func (g *Gateway) MaxBodyBytes(n int64) *Gateway {
+	g.maxBodyBytes = n
+	return g
+}
+
+// StatusPolicy sets the policy choosing the status of rejected requests,
+// instead of the one set with SetStatusPolicy
+func (g *Gateway) StatusPolicy(policy *StatusPolicy) *Gateway {
+	g.policy = policy
+	return g
+}
+
+// Middleware returns a handler that validates requests and passes valid ones to next
+func (g *Gateway) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, params := g.match(r.URL.Path)
+		if route == nil {
+			if g.allowUnknown {
+				next.ServeHTTP(w, r)
+				return
+			}
+			writeErrorResponse(w, http.StatusNotFound, "No operation matches "+r.URL.Path, nil)
+			return
+		}
+
+		operation, ok := route.operations[r.Method]
+		if !ok {
+			writeErrorResponse(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s is not allowed for %s", r.Method, route.template), nil)
+			return
+		}
+
+		if errs := g.validate(r, operation, params); len(errs) > 0 {
+			policy := g.policy
+			if policy == nil {
+				policy = statusPolicy()
+			}
+			writeErrorResponse(w, policy.Status(errs), "Request validation failed", errs)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// match finds the route for a request path and extracts its path parameters
+func (g *Gateway) match(path string) (*gatewayRoute, map[string]string) {
+	segments := splitPath(path)
+
+	for _, route := range g.routes {
+		if len(route.segments) != len(segments) {
+			continue
+		}
+
+		params := map[string]string{}
+		matched := true
+		for i, segment := range route.segments {
+			if isPathParam(segment) {
+				if segments[i] == "" {
+					matched = false
+					break
+				}
+				params[segment[1:len(segment)-1]] = segments[i]
+				continue
+			}
+			if segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return route, params
+		}
+	}
+	return nil, nil
+}
+
+// validate checks a request's parameters and body, restoring the body for
+// the next handler
+func (g *Gateway) validate(r *http.Request, operation *gatewayOperation, pathParams map[string]string) ValidationErrors {
+	var errs ValidationErrors
+	query := r.URL.Query()
+
+	for _, param := range operation.params {
+		var value any
+		switch param.in {
+		case "path":
+			if raw, ok := pathParams[param.name]; ok {
+				value = raw
+			}
+		case "query":
+			if values, ok := query[param.name]; ok {
+				value = queryValue(values, param.array)
+			}
+		case "header":
+			if raw := r.Header.Get(param.name); raw != "" {
+				value = raw
+			}
+		case "cookie":
+			if cookie, err := r.Cookie(param.name); err == nil {
+				value = cookie.Value
+			}
+		}
+
+		result := ParseCtx(r.Context(), param.validator, value, WithCoercion())
+		for _, err := range result.Errors {
+			err.nest(param.in+"."+param.name, pathToken{name: param.in}, pathToken{name: param.name})
+			errs = append(errs, err)
+		}
+	}
+
+	if operation.body == nil {
+		return errs
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, g.maxBodyBytes+1))
+	if err != nil {
+		return append(errs, ValidationError{Path: "body", Message: "Could not read request body", Code: CodeUnreadableBody, Cause: err})
+	}
+	if int64(len(data)) > g.maxBodyBytes {
+		return append(errs, ValidationError{
+			Path:    "body",
+			Message: fmt.Sprintf("Request body must be at most %d bytes", g.maxBodyBytes),
+			Code:    CodePayloadTooLarge,
+			Params:  map[string]any{"maximum": g.maxBodyBytes},
+		})
+	}
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	if len(bytes.TrimSpace(data)) == 0 && !operation.bodyRequired {
+		return errs
+	}
+
+	// Only JSON bodies are compiled, so other media types cannot be validated
+	if contentType := r.Header.Get("Content-Type"); contentType != "" && len(bytes.TrimSpace(data)) > 0 {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+			return append(errs, ValidationError{
+				Path:    "body",
+				Message: "Unsupported media type " + contentType + "; expected JSON",
+				Code:    CodeUnsupportedMediaType,
+				Params:  map[string]any{"type": contentType},
+			})
+		}
+	}
+
+	var result ParseResult
+	if len(bytes.TrimSpace(data)) > 0 {
+		var opts []ParseOption
+		if !g.allowDupKeys {
+			opts = append(opts, WithStrictJSON())
+		}
+		result = ParseJSON(r.Context(), operation.body, data, opts...)
+	} else {
+		result = ParseCtx(r.Context(), operation.body, nil)
+	}
+	for _, err := range result.Errors {
+		err.nest("body", pathToken{name: "body"})
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// compileOperation compiles the parameters and JSON request body of an operation
+func (d *OpenAPIDocument) compileOperation(item, operation map[string]any, itemAt, method string) (*gatewayOperation, error) {
+	compiled := &gatewayOperation{}
+	at := itemAt + "/" + method
+
+	// Operation parameters override path item parameters with the same name and location
+	params := map[string]gatewayParam{}
+	var order []string
+	for _, source := range []struct {
+		list any
+		at   string
+	}{
+		{item["parameters"], itemAt + "/parameters"},
+		{operation["parameters"], at + "/parameters"},
+	} {
+		list, _ := source.list.([]any)
+		for i, raw := range list {
+			param, err := d.compileParam(raw, fmt.Sprintf("%s/%d", source.at, i))
+			if err != nil {
+				return nil, err
+			}
+			key := param.in + ":" + param.name
+			if _, seen := params[key]; !seen {
+				order = append(order, key)
+			}
+			params[key] = param
+		}
+	}
+	for _, key := range order {
+		compiled.params = append(compiled.params, params[key])
+	}
+
+	if raw, ok := operation["requestBody"].(map[string]any); ok {
+		body, err := d.deref(raw)
+		if err != nil {
+			return nil, err
+		}
+		content, _ := body["content"].(map[string]any)
+		for _, mediaType := range jsonMediaTypes(content) {
+			media, _ := content[mediaType].(map[string]any)
+			schema, ok := media["schema"].(map[string]any)
+			if !ok {
+				continue
+			}
+			validator, err := d.compile(schema, at+"/requestBody")
+			if err != nil {
+				return nil, err
+			}
+			compiled.body = validator
+			compiled.bodyRequired, _ = body["required"].(bool)
+			break
+		}
+	}
+	return compiled, nil
+}
+
+// jsonMediaTypes returns the JSON media types of a request body's content:
+// "application/json" first, then the "+json" types in sorted order, so the
+// schema chosen does not depend on map iteration
+func jsonMediaTypes(content map[string]any) []string {
+	var types []string
+	for mediaType := range content {
+		if strings.HasSuffix(mediaType, "+json") {
+			types = append(types, mediaType)
+		}
+	}
+	sort.Strings(types)
+	if _, ok := content["application/json"]; ok {
+		types = append([]string{"application/json"}, types...)
+	}
+	return types
+}
+
+// compileParam compiles a path, query, header or cookie parameter
+func (d *OpenAPIDocument) compileParam(raw any, at string) (gatewayParam, error) {
+	object, ok := raw.(map[string]any)
+	if !ok {
+		return gatewayParam{}, fmt.Errorf("zogo: %s is not a parameter object", at)
+	}
+	param, err := d.deref(object)
+	if err != nil {
+		return gatewayParam{}, err
+	}
+
+	name, _ := param["name"].(string)
+	in, _ := param["in"].(string)
+	if name == "" || in == "" {
+		return gatewayParam{}, fmt.Errorf("zogo: %s must have a name and location", at)
+	}
+
+	compiled := gatewayParam{name: name, in: in, validator: Any()}
+	if schema, ok := param["schema"].(map[string]any); ok {
+		if compiled.validator, err = d.compile(schema, at+"/schema"); err != nil {
+			return gatewayParam{}, err
+		}
+		compiled.array = schema["type"] == "array"
+	}
+
+	required, _ := param["required"].(bool)
+	if !required && in != "path" {
+		compiled.validator = withModifiers(compiled.validator, true, false)
+	}
+	return compiled, nil
+}
+
+// deref follows a $ref on a parameter or request body object
+func (d *OpenAPIDocument) deref(object map[string]any) (map[string]any, error) {
+	for depth := 0; depth < 32; depth++ {
+		ref, ok := object["$ref"].(string)
+		if !ok {
+			return object, nil
+		}
+		resolved, err := d.resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+		object = resolved
+	}
+	return nil, fmt.Errorf("zogo: $ref chain too deep")
+}
+
+// queryValue converts query values to the shape a parameter schema expects
+func queryValue(values []string, array bool) any {
+	if !array {
+		return values[0]
+	}
+
+	items := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// splitPath splits a URL path into its segments
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// isPathParam reports whether a path template segment is a parameter
+func isPathParam(segment string) bool {
+	return len(segment) > 2 && segment[0] == '{' && segment[len(segment)-1] == '}'
+}
@@ -0,0 +1,388 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const gatewaySpec = `{
+	"openapi": "3.1.0",
+	"paths": {
+		"/pets": {
+			"get": {
+				"parameters": [
+					{"name": "limit", "in": "query", "schema": {"type": "integer", "maximum": 100}},
+					{"name": "tags", "in": "query", "schema": {"type": "array", "items": {"type": "string", "minLength": 2}}}
+				]
+			},
+			"post": {
+				"parameters": [{"$ref": "#/components/parameters/RequestId"}],
+				"requestBody": {
+					"required": true,
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/NewPet"}}}
+				}
+			}
+		},
+		"/pets/{id}": {
+			"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "integer", "minimum": 1}}],
+			"get": {}
+		},
+		"/pets/mine": {
+			"get": {}
+		}
+	},
+	"components": {
+		"parameters": {
+			"RequestId": {"name": "X-Request-Id", "in": "header", "required": true, "schema": {"type": "string", "format": "uuid"}}
+		},
+		"schemas": {
+			"NewPet": {
+				"type": "object",
+				"required": ["name"],
+				"properties": {
+					"name": {"type": "string", "minLength": 1},
+					"age": {"type": "integer", "minimum": 0}
+				}
+			}
+		}
+	}
+}`
+
+// gatewayResponse is the JSON body of a rejected request
+type gatewayResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Path string `json:"path"`
+		Code string `json:"code"`
+	} `json:"errors"`
+}
+
+func newTestGateway(t *testing.T) (*Gateway, *string) {
+	t.Helper()
+	doc, err := LoadOpenAPI([]byte(gatewaySpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gateway, err := NewGateway(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var forwarded string
+	return gateway, &forwarded
+}
+
+func serveGateway(handler http.Handler, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func echoHandler(forwarded *string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		*forwarded = r.Method + " " + r.URL.Path + " " + string(data)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func decodeGatewayResponse(t *testing.T, rec *httptest.ResponseRecorder) gatewayResponse {
+	t.Helper()
+	var response gatewayResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Expected JSON error response, got %q", rec.Body.String())
+	}
+	return response
+}
+
+func hasGatewayError(response gatewayResponse, path string) bool {
+	for _, err := range response.Errors {
+		if err.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// Test valid requests are forwarded with their body intact
+func TestGatewayForwardsValidRequests(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	handler := gateway.Middleware(echoHandler(forwarded))
+
+	header := http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}}
+	rec := serveGateway(handler, http.MethodPost, "/pets", `{"name":"Rex","age":3}`, header)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected valid POST to be forwarded, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if *forwarded != `POST /pets {"name":"Rex","age":3}` {
+		t.Errorf("Expected body to reach the handler, got %q", *forwarded)
+	}
+
+	for _, target := range []string{"/pets", "/pets?limit=10&tags=ab,cd&tags=ef", "/pets/42", "/pets/mine"} {
+		if rec := serveGateway(handler, http.MethodGet, target, "", nil); rec.Code != http.StatusNoContent {
+			t.Errorf("Expected GET %s to be forwarded, got %d: %s", target, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+// Test invalid parameters and bodies are rejected with prefixed paths
+func TestGatewayRejectsInvalidRequests(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	handler := gateway.Middleware(echoHandler(forwarded))
+
+	tests := []struct {
+		method string
+		target string
+		body   string
+		header http.Header
+		paths  []string
+	}{
+		{http.MethodGet, "/pets/abc", "", nil, []string{"path.id"}},
+		{http.MethodGet, "/pets/0", "", nil, []string{"path.id"}},
+		{http.MethodGet, "/pets?limit=500&tags=a", "", nil, []string{"query.limit", "query.tags[0]"}},
+		{http.MethodPost, "/pets", `{"age":-1}`, nil, []string{"header.X-Request-Id", "body.name", "body.age"}},
+		{http.MethodPost, "/pets", "", http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}}, []string{"body"}},
+		{http.MethodPost, "/pets", `{"name":`, http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}}, []string{"body"}},
+	}
+
+	for _, tt := range tests {
+		*forwarded = ""
+		rec := serveGateway(handler, tt.method, tt.target, tt.body, tt.header)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s %s: expected 400, got %d", tt.method, tt.target, rec.Code)
+			continue
+		}
+		if *forwarded != "" {
+			t.Errorf("%s %s: expected request not to be forwarded", tt.method, tt.target)
+		}
+		response := decodeGatewayResponse(t, rec)
+		for _, path := range tt.paths {
+			if !hasGatewayError(response, path) {
+				t.Errorf("%s %s: expected error at %s, got %+v", tt.method, tt.target, path, response.Errors)
+			}
+		}
+	}
+}
+
+// Test literal path segments take precedence over parameters
+func TestGatewayRoutePrecedence(t *testing.T) {
+	gateway, _ := newTestGateway(t)
+
+	route, params := gateway.match("/pets/mine")
+	if route == nil || route.template != "/pets/mine" || len(params) != 0 {
+		t.Errorf("Expected /pets/mine to match its literal route, got %v %v", route, params)
+	}
+
+	route, params = gateway.match("/pets/7")
+	if route == nil || route.template != "/pets/{id}" || params["id"] != "7" {
+		t.Errorf("Expected /pets/7 to match /pets/{id}, got %v %v", route, params)
+	}
+}
+
+// Test unknown routes and methods
+func TestGatewayUnknownRoutes(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	handler := gateway.Middleware(echoHandler(forwarded))
+
+	if rec := serveGateway(handler, http.MethodGet, "/owners", "", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown route, got %d", rec.Code)
+	}
+	if rec := serveGateway(handler, http.MethodDelete, "/pets/1", "", nil); rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 for unknown method, got %d", rec.Code)
+	}
+
+	handler = gateway.AllowUnknownRoutes().Middleware(echoHandler(forwarded))
+	if rec := serveGateway(handler, http.MethodGet, "/owners", "", nil); rec.Code != http.StatusNoContent {
+		t.Errorf("Expected unknown route to be forwarded with AllowUnknownRoutes, got %d", rec.Code)
+	}
+}
+
+// Test bodies that are not JSON are rejected with 415
+func TestGatewayUnsupportedMediaType(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	handler := gateway.Middleware(echoHandler(forwarded))
+
+	for _, contentType := range []string{"application/x-www-form-urlencoded", "text/plain; charset=utf-8", "not a media type"} {
+		header := http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}, "Content-Type": {contentType}}
+		rec := serveGateway(handler, http.MethodPost, "/pets", "name=Rex", header)
+		if rec.Code != http.StatusUnsupportedMediaType {
+			t.Fatalf("Expected 415 for %s, got %d", contentType, rec.Code)
+		}
+		response := decodeGatewayResponse(t, rec)
+		if len(response.Errors) != 1 || response.Errors[0].Code != CodeUnsupportedMediaType {
+			t.Errorf("Expected a single %s error, got %+v", CodeUnsupportedMediaType, response.Errors)
+		}
+	}
+
+	for _, contentType := range []string{"application/json; charset=utf-8", "application/merge-patch+json"} {
+		header := http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}, "Content-Type": {contentType}}
+		if rec := serveGateway(handler, http.MethodPost, "/pets", `{"name":"Rex"}`, header); rec.Code != http.StatusNoContent {
+			t.Errorf("Expected a JSON body sent as %s to be forwarded, got %d", contentType, rec.Code)
+		}
+	}
+}
+
+// Test oversized bodies are rejected
+func TestGatewayMaxBodyBytes(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	handler := gateway.MaxBodyBytes(16).Middleware(echoHandler(forwarded))
+
+	header := http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}}
+	rec := serveGateway(handler, http.MethodPost, "/pets", `{"name":"A very long pet name"}`, header)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("Expected 413, got %d", rec.Code)
+	}
+	if response := decodeGatewayResponse(t, rec); !hasGatewayError(response, "body") {
+		t.Errorf("Expected body error, got %+v", response.Errors)
+	}
+}
+
+// Test the status policy chooses the status of rejected requests
+func TestGatewayStatusPolicy(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	policy := NewStatusPolicy(http.StatusUnprocessableEntity).Map(http.StatusBadRequest, CodeInvalidJSON)
+	handler := gateway.StatusPolicy(policy).Middleware(echoHandler(forwarded))
+
+	header := http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}}
+	if rec := serveGateway(handler, http.MethodPost, "/pets", `{"age":-1}`, header); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected 422 for invalid body, got %d", rec.Code)
+	}
+	if rec := serveGateway(handler, http.MethodPost, "/pets", `{"name":`, header); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for malformed JSON, got %d", rec.Code)
+	}
+}
+
+// Test JSON bodies with duplicate keys are rejected unless allowed
+func TestGatewayDuplicateKeys(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	handler := gateway.Middleware(echoHandler(forwarded))
+
+	header := http.Header{"X-Request-Id": {"9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71"}}
+	body := `{"name":"Rex","name":""}`
+	rec := serveGateway(handler, http.MethodPost, "/pets", body, header)
+	if rec.Code != http.StatusBadRequest || !hasGatewayError(decodeGatewayResponse(t, rec), "body.name") {
+		t.Errorf("Expected duplicate key error at body.name, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	handler = gateway.AllowDuplicateKeys().Middleware(echoHandler(forwarded))
+	if rec := serveGateway(handler, http.MethodPost, "/pets", body, header); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected the last (empty) name to fail validation, got %d", rec.Code)
+	}
+	if rec := serveGateway(handler, http.MethodPost, "/pets", `{"name":"","name":"Rex"}`, header); rec.Code != http.StatusNoContent {
+		t.Errorf("Expected duplicates to be allowed, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+// Test cookie parameters are read from the request's cookies
+func TestGatewayCookieParams(t *testing.T) {
+	doc, err := LoadOpenAPI([]byte(`{"paths": {"/cart": {"get": {"parameters": [
+		{"name": "session", "in": "cookie", "required": true, "schema": {"type": "string", "minLength": 8}},
+		{"name": "theme", "in": "cookie", "schema": {"enum": ["light", "dark"]}}
+	]}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gateway, err := NewGateway(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var forwarded string
+	handler := gateway.Middleware(echoHandler(&forwarded))
+
+	tests := []struct {
+		cookie string
+		paths  []string
+	}{
+		{"session=abcdefgh; theme=dark", nil},
+		{"session=abcdefgh", nil},
+		{"", []string{"cookie.session"}},
+		{"session=abc; theme=blue", []string{"cookie.session", "cookie.theme"}},
+	}
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.cookie != "" {
+			header.Set("Cookie", tt.cookie)
+		}
+		rec := serveGateway(handler, http.MethodGet, "/cart", "", header)
+		if len(tt.paths) == 0 {
+			if rec.Code != http.StatusNoContent {
+				t.Errorf("Cookie %q: expected the request to be forwarded, got %d: %s", tt.cookie, rec.Code, rec.Body.String())
+			}
+			continue
+		}
+		response := decodeGatewayResponse(t, rec)
+		for _, path := range tt.paths {
+			if !hasGatewayError(response, path) {
+				t.Errorf("Cookie %q: expected error at %s, got %+v", tt.cookie, path, response.Errors)
+			}
+		}
+	}
+}
+
+// Test application/json is preferred over other JSON media types
+func TestGatewayJSONMediaType(t *testing.T) {
+	spec := `{"paths": {"/pets": {"post": {"requestBody": {"content": {
+		"application/vnd.pet+json": {"schema": {"type": "object", "required": ["legacy"]}},
+		"application/json": {"schema": {"type": "object", "required": ["name"]}},
+		"application/merge-patch+json": {"schema": {"type": "object", "required": ["patch"]}}
+	}}}}}}`
+	for i := 0; i < 10; i++ {
+		doc, err := LoadOpenAPI([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+		gateway, err := NewGateway(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var forwarded string
+		rec := serveGateway(gateway.Middleware(echoHandler(&forwarded)), http.MethodPost, "/pets", `{"name":"Rex"}`, nil)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected the application/json schema to be used, got %d: %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	if types := jsonMediaTypes(map[string]any{"b+json": nil, "a+json": nil, "text/plain": nil}); strings.Join(types, ",") != "a+json,b+json" {
+		t.Errorf("Expected +json types in sorted order, got %v", types)
+	}
+}
+
+// failingReader fails every read
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+// Test a body that cannot be read is reported with its own code
+func TestGatewayUnreadableBody(t *testing.T) {
+	gateway, forwarded := newTestGateway(t)
+	handler := gateway.Middleware(echoHandler(forwarded))
+
+	req := httptest.NewRequest(http.MethodPost, "/pets", failingReader{})
+	req.Header.Set("X-Request-Id", "9b2f1c3e-7a4d-4e8b-9c1a-2f6d8e0b5a71")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected 400, got %d", rec.Code)
+	}
+	response := decodeGatewayResponse(t, rec)
+	if len(response.Errors) != 1 || response.Errors[0].Code != CodeUnreadableBody {
+		t.Errorf("Expected a single %s error, got %+v", CodeUnreadableBody, response.Errors)
+	}
+}
@@ -1,3 +1,8 @@
 module github.com/hkurdi/zogo
 
 go 1.22.5
+
+require (
+	golang.org/x/net v0.34.0
+	golang.org/x/text v0.22.0
+)
@@ -0,0 +1,124 @@
+package zogo
+
+import "unicode"
+
+// graphemeClass is the grapheme cluster break property of a code point
+// (Unicode Standard Annex #29)
+type graphemeClass int
+
+const (
+	gcOther graphemeClass = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcRegionalIndicator
+	gcSpacingMark
+	gcL   // Hangul leading consonant
+	gcV   // Hangul vowel
+	gcT   // Hangul trailing consonant
+	gcLV  // Hangul syllable without a trailing consonant
+	gcLVT // Hangul syllable with a trailing consonant
+	gcPictographic
+)
+
+// graphemeClassOf returns the break property of r, using the general
+// categories and the emoji ranges in place of the full property tables
+func graphemeClassOf(r rune) graphemeClass {
+	switch {
+	case r == '\r':
+		return gcCR
+	case r == '\n':
+		return gcLF
+	case r == emojiZWJ:
+		return gcZWJ
+	case r == 0x200C, r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+		// Zero width non-joiner, skin tone modifiers and tags
+		return gcExtend
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gcRegionalIndicator
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return gcL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return gcV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return gcT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gcLV
+		}
+		return gcLVT
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gcControl
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gcExtend
+	case unicode.Is(unicode.Mc, r):
+		return gcSpacingMark
+	case inRanges(r, emojiRanges), inRanges(r, textEmojiRanges):
+		return gcPictographic
+	}
+	return gcOther
+}
+
+// graphemeCount counts the extended grapheme clusters of s, the characters
+// a reader sees, so "é", "👍🏽" and "👨‍👩‍👧" each count as one. It follows
+// the break rules of UAX #29 except those for prepended marks and Indic
+// conjuncts.
+func graphemeCount(s string) int {
+	count, regionalRun := 0, 0
+	var prev graphemeClass
+	// 1 after a pictograph and any extending marks, 2 when a joiner follows
+	pictState := 0
+
+	for i, r := range s {
+		cur := graphemeClassOf(r)
+		if i == 0 || graphemeBreak(prev, cur, pictState, regionalRun) {
+			count++
+		}
+
+		switch {
+		case cur == gcPictographic:
+			pictState = 1
+		case cur == gcExtend && pictState == 1:
+		case cur == gcZWJ && pictState == 1:
+			pictState = 2
+		default:
+			pictState = 0
+		}
+		if cur == gcRegionalIndicator {
+			regionalRun++
+		} else {
+			regionalRun = 0
+		}
+		prev = cur
+	}
+	return count
+}
+
+// graphemeBreak reports whether a cluster boundary falls between code
+// points of classes prev and cur
+func graphemeBreak(prev, cur graphemeClass, pictState, regionalRun int) bool {
+	switch {
+	case prev == gcCR && cur == gcLF:
+		return false
+	case prev == gcCR || prev == gcLF || prev == gcControl:
+		return true
+	case cur == gcCR || cur == gcLF || cur == gcControl:
+		return true
+	case prev == gcL && (cur == gcL || cur == gcV || cur == gcLV || cur == gcLVT):
+		return false
+	case (prev == gcLV || prev == gcV) && (cur == gcV || cur == gcT):
+		return false
+	case (prev == gcLVT || prev == gcT) && cur == gcT:
+		return false
+	case cur == gcExtend || cur == gcZWJ || cur == gcSpacingMark:
+		return false
+	case prev == gcZWJ && cur == gcPictographic:
+		return pictState != 2
+	case prev == gcRegionalIndicator && cur == gcRegionalIndicator:
+		// Flags pair up regional indicators from the start of the run
+		return regionalRun%2 == 0
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package zogo
+
+import "testing"
+
+// Test graphemeCount counts user-perceived characters
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"héllo", 5},
+		{"he\u0301llo", 5}, // Combining accent
+		{"\r\n", 1},
+		{"a\nb", 3},                 // Control characters stand alone
+		{"\U0001F44D\U0001F3FD", 1}, // Skin tone
+		{"\U0001F468\u200d\U0001F469\u200d\U0001F467", 1}, // Family
+		{"\U0001F469\u200d\U0001F4BB\U0001F469\u200d\U0001F4BB", 2},
+		{"a\u200d\U0001F467", 2},                        // A joiner after a letter does not join
+		{"\U0001F1EB\U0001F1F7\U0001F1E9\U0001F1EA", 2}, // Flags pair regional indicators
+		{"\U0001F1EB\U0001F1F7\U0001F1E9", 2},
+		{"1\ufe0f\u20e3", 1},                                                          // Keycap
+		{"\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", 1}, // Scotland
+		{"\u1100\u1161\u11a8", 1},                                                     // Hangul jamo
+		{"한국어", 3},
+		{"\u0915\u093f", 1}, // Spacing mark
+	}
+	for _, tt := range tests {
+		if got := graphemeCount(tt.input); got != tt.want {
+			t.Errorf("graphemeCount(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
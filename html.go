@@ -0,0 +1,126 @@
+package zogo
+
+import "strings"
+
+// isTagStart reports whether s, which starts with '<', opens markup: a
+// tag, an end tag, a comment, a doctype or a processing instruction.
+// Other uses of '<', as in "a < b" or "<3", are text.
+func isTagStart(s string) bool {
+	if len(s) < 2 {
+		return false
+	}
+	c := s[1]
+	if c == '/' && len(s) > 2 {
+		c = s[2]
+	}
+	return c|0x20 >= 'a' && c|0x20 <= 'z' || c == '!' || c == '?'
+}
+
+// nextTag returns the index of the first markup in s, or -1 if none
+func nextTag(s string) int {
+	offset := 0
+	for {
+		i := strings.IndexByte(s[offset:], '<')
+		if i < 0 {
+			return -1
+		}
+		if isTagStart(s[offset+i:]) {
+			return offset + i
+		}
+		offset += i + 1
+	}
+}
+
+// containsHTML reports whether s contains markup
+func containsHTML(s string) bool {
+	return nextTag(s) >= 0
+}
+
+// stripHTML removes the markup from s, along with the content of script
+// and style elements. Text, including character references such as
+// "&amp;", is kept as it is, so escaped markup is not turned into markup.
+// An unterminated tag is removed up to the end of s.
+func stripHTML(s string) string {
+	var b strings.Builder
+	for {
+		i := nextTag(s)
+		if i < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		b.WriteString(s[:i])
+		s = s[i:]
+
+		if strings.HasPrefix(s, "<!--") {
+			end := strings.Index(s[4:], "-->")
+			if end < 0 {
+				return b.String()
+			}
+			s = s[4+end+3:]
+			continue
+		}
+
+		end := tagEnd(s)
+		if end < 0 {
+			return b.String()
+		}
+		tag := s[:end]
+		s = s[end:]
+
+		// Drop what script and style elements contain, up to their end tag
+		for _, name := range []string{"script", "style"} {
+			if isOpenTag(tag, name) {
+				stop := indexEndTag(s, name)
+				if stop < 0 {
+					return b.String()
+				}
+				s = s[stop:]
+			}
+		}
+	}
+}
+
+// tagEnd returns the index just past the '>' closing the tag s starts
+// with, skipping quoted attribute values, or -1 if the tag is unterminated
+func tagEnd(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// indexEndTag returns the index of the first end tag of the named element
+// in s, or -1 if none
+func indexEndTag(s, name string) int {
+	offset := 0
+	for {
+		i := strings.Index(s[offset:], "</")
+		if i < 0 {
+			return -1
+		}
+		start := offset + i
+		if end := start + 2 + len(name); end <= len(s) && strings.EqualFold(s[start+2:end], name) {
+			return start
+		}
+		offset = start + 2
+	}
+}
+
+// isOpenTag reports whether tag is a start tag of the named element
+func isOpenTag(tag, name string) bool {
+	if len(tag) < len(name)+2 || !strings.EqualFold(tag[1:len(name)+1], name) {
+		return false
+	}
+	c := tag[len(name)+1]
+	return c == '>' || c == '/' || c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,51 @@
+package zogo
+
+import "testing"
+
+// Test NoHTML rejects markup but not other uses of "<"
+func TestStringNoHTML(t *testing.T) {
+	schema := String().NoHTML()
+
+	for _, s := range []string{"", "plain text", "a < b", "I <3 Go", "x<", "&lt;b&gt;", "5 <= 6"} {
+		if result := schema.Parse(s); !result.Ok {
+			t.Errorf("Expected %q to pass NoHTML(), got %v", s, result.Errors)
+		}
+	}
+	for _, s := range []string{"<b>bold</b>", "hi</p>", "<!-- note -->", "<!DOCTYPE html>", "<?xml?>", "<img src=x onerror=alert(1)"} {
+		if schema.Parse(s).Ok {
+			t.Errorf("Expected %q to fail NoHTML()", s)
+		}
+	}
+}
+
+// Test StripHTML removes markup before the other rules
+func TestStringStripHTML(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"plain", "plain"},
+		{"<p>Hello <b>world</b></p>", "Hello world"},
+		{`<a href="/x?a=1&b=>2">link</a>`, "link"},
+		{"a < b and <i>c</i>", "a < b and c"},
+		{"before<script>alert('<b>')</script>after", "beforeafter"},
+		{"<STYLE type=text/css>p{}</Style>text", "text"},
+		{"keep<!-- <b>comment</b> -->this", "keepthis"},
+		{"&lt;script&gt; stays escaped", "&lt;script&gt; stays escaped"},
+		{"cut <b unterminated", "cut "},
+		{"<script>never closed", ""},
+	}
+	for _, tt := range tests {
+		if got := stripHTML(tt.input); got != tt.want {
+			t.Errorf("stripHTML(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	schema := String().StripHTML().Trim().Min(1).NoHTML()
+	if result := schema.Parse("  <p> hi </p> "); !result.Ok || result.Value != "hi" {
+		t.Errorf("Expected stripped and trimmed %q, got %v %v", "hi", result.Value, result.Errors)
+	}
+	if schema.Parse("<br/>").Ok {
+		t.Error("Expected markup-only input to fail Min(1) once stripped")
+	}
+}
@@ -0,0 +1,343 @@
+package zogo
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Messages maps error codes to message templates for a single locale.
+//
+// Templates reference error parameters with {name} placeholders, e.g.
+// "String must be at least {minimum} characters". The {field} placeholder
+// expands to the error path. A key may be suffixed with the "type" parameter
+// ("too_small.string") to specialize a message; lookup falls back to the bare
+// code when no specialized template exists. Exclusive bounds, reported with
+// an "inclusive" parameter of false, first try a further ".exclusive" suffix
+// ("too_small.number.exclusive").
+type Messages map[string]string
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Messages{
+		"en": englishMessages,
+	}
+)
+
+// englishMessages are the built-in English templates
+var englishMessages = Messages{
+	"invalid_type":                "Expected {expected}, received {received}",
+	"too_small":                   "Value must be at least {minimum}",
+	"too_small.string":            "String must be at least {minimum} characters",
+	"too_small.number":            "Number must be at least {minimum}",
+	"too_small.number.exclusive":  "Number must be greater than {minimum}",
+	"too_small.array":             "Array must contain at least {minimum} element(s)",
+	"too_small.date":              "Date must be at or after {minimum}",
+	"too_small.date.exclusive":    "Date must be after {minimum}",
+	"too_small.tuple":             "Expected tuple of at least length {minimum}",
+	"too_small.bytes":             "Value must be at least {minimum} bytes",
+	"too_small.age":               "Age must be at least {minimum} years",
+	"too_small.password":          "Password must be at least {minimum} characters",
+	"too_small.username":          "Username must be at least {minimum} characters",
+	"too_big":                     "Value must be at most {maximum}",
+	"too_big.string":              "String must be at most {maximum} characters",
+	"too_big.number":              "Number must be at most {maximum}",
+	"too_big.number.exclusive":    "Number must be less than {maximum}",
+	"too_big.array":               "Array must contain at most {maximum} element(s)",
+	"too_big.date":                "Date must be at or before {maximum}",
+	"too_big.date.exclusive":      "Date must be before {maximum}",
+	"too_big.bytes":               "Value must be at most {maximum} bytes",
+	"too_big.age":                 "Age must be at most {maximum} years",
+	"too_big.username":            "Username must be at most {maximum} characters",
+	"invalid_length":              "Expected length {length}, received length {received}",
+	"invalid_length.string":       "String must be exactly {length} characters",
+	"invalid_length.bytes":        "Value must be exactly {length} bytes",
+	"empty":                       "String must not be empty",
+	"invalid_string":              "Invalid string",
+	"invalid_string.email":        "Invalid email format",
+	"invalid_string.email.plus":   "Email must not use plus addressing",
+	"invalid_string.email.local":  "Email local part must be at most {maximum} characters",
+	"invalid_string.email.mx":     "Email domain does not accept mail",
+	"invalid_string.url":          "Invalid URL format",
+	"invalid_string.uuid":         "Invalid UUID format",
+	"invalid_string.domain":       "Invalid domain name",
+	"invalid_string.ip":           "Invalid IP address",
+	"invalid_string.ipv4":         "Invalid IPv4 address",
+	"invalid_string.ipv6":         "Invalid IPv6 address",
+	"invalid_string.cidr":         "Invalid CIDR block",
+	"invalid_string.cidrv4":       "Invalid IPv4 CIDR block",
+	"invalid_string.cidrv6":       "Invalid IPv6 CIDR block",
+	"invalid_string.in_cidr":      "IP address must be within {networks}",
+	"invalid_string.base64":       "Invalid base64 string",
+	"invalid_string.base64url":    "Invalid base64url string",
+	"invalid_string.base32":       "Invalid base32 string",
+	"invalid_string.data_uri":     "Invalid data URI",
+	"invalid_string.hex":          "Invalid hexadecimal string",
+	"invalid_string.utf8":         "Invalid UTF-8 text",
+	"invalid_string.cuid":         "Invalid CUID format",
+	"invalid_string.cuid2":        "Invalid CUID2 format",
+	"invalid_string.ulid":         "Invalid ULID format",
+	"invalid_string.nanoid":       "Invalid Nanoid format",
+	"invalid_string.objectid":     "Invalid ObjectID format",
+	"invalid_string.git_sha":      "Invalid Git SHA",
+	"invalid_string.cron":         "Invalid cron expression",
+	"invalid_string.iban":         "Invalid IBAN",
+	"invalid_string.bic":          "Invalid BIC",
+	"invalid_string.isbn":         "Invalid ISBN",
+	"invalid_string.ean":          "Invalid EAN barcode",
+	"invalid_string.language_tag": "Invalid language tag",
+	"invalid_string.css_color":    "Invalid CSS color",
+	"invalid_string.regex":        "String does not match required pattern",
+	"invalid_string.starts_with":  "String must start with '{prefix}'",
+	"invalid_string.ends_with":    "String must end with '{suffix}'",
+	"invalid_string.includes":     "String must contain '{substring}'",
+	"invalid_string.not_regex":    "String matches a forbidden pattern",
+	"invalid_string.not_prefix":   "String must not start with '{prefix}'",
+	"invalid_string.not_includes": "String must not contain '{substring}'",
+	"invalid_string.no_html":      "String must not contain HTML",
+	"invalid_string.ascii":        "String must contain only ASCII characters",
+	"invalid_string.printable":    "String must contain only printable characters",
+	"invalid_string.alpha":        "String must contain only letters",
+	"invalid_string.alphanumeric": "String must contain only letters and digits",
+	"invalid_string.emoji":        "String must contain only emoji",
+	"invalid_string.no_emoji":     "String must not contain emoji",
+	"invalid_string.lowercase":    "String must be lowercase",
+	"invalid_string.uppercase":    "String must be uppercase",
+	"not_integer":                 "Number must be an integer",
+	"not_finite":                  "Number must be finite",
+	"not_positive":                "Number must be positive",
+	"not_negative":                "Number must be negative",
+	"not_nonnegative":             "Number must be non-negative",
+	"not_nonpositive":             "Number must be non-positive",
+	"unsafe_integer":              "Number must be within safe integer range",
+	"not_multiple_of":             "Number must be a multiple of {multipleOf}",
+	"invalid_date":                "Invalid date",
+	"invalid_date.date_only":      "Date must be a calendar date (YYYY-MM-DD) without a time",
+	"invalid_date.utc":            "Date must be in UTC",
+	"not_future":                  "Date must be in the future",
+	"not_past":                    "Date must be in the past",
+	"invalid_enum_value":          "Invalid enum value. Expected one of: {options}, received: {received}",
+	"invalid_literal":             "Invalid literal value. Expected {expected}, received {received}",
+	"unrecognized_keys":           "Unknown field",
+	"unrecognized_keys.typo":      "Unknown field '{key}', did you mean '{suggestion}'?",
+	"invalid_key":                 "Invalid record key",
+	"invalid_union":               "Value did not match any union type",
+	"invalid_phone":               "Invalid phone number for country {country}",
+	"invalid_string.country":      "Invalid country code",
+	"invalid_string.currency":     "Invalid currency code",
+	"weak_password":               "Password is too weak",
+	"weak_password.uppercase":     "Password must contain an uppercase letter",
+	"weak_password.lowercase":     "Password must contain a lowercase letter",
+	"weak_password.digit":         "Password must contain a digit",
+	"weak_password.symbol":        "Password must contain a symbol",
+	"weak_password.repeats":       "Password must not repeat a character more than {maximum} times in a row",
+	"weak_password.banned":        "Password is too common",
+	"invalid_username":            "Invalid username",
+	"invalid_username.chars":      "Username may only contain letters, digits and {allowed}",
+	"invalid_username.alnum":      "Username may only contain letters and digits",
+	"invalid_username.digit":      "Username must not start with a digit",
+	"invalid_username.reserved":   "Username is reserved",
+	"unsupported_version":         "Unsupported API version '{version}'; supported versions: {supported}",
+	"unsupported_version.missing": "Missing API version; supported versions: {supported}",
+	"too_deep":                    "Maximum nesting depth of {maximum} exceeded",
+	"canceled":                    "Validation canceled: {reason}",
+	"check_failed":                "Validation check failed: {reason}",
+	"invalid_json":                "Request body is not valid JSON",
+	"payload_too_large":           "Request body must be at most {maximum} bytes",
+	"unreadable_body":             "Could not read request body",
+	"unsupported_media_type":      "Unsupported media type {type}; expected JSON",
+	"required_with":               "Required when {others} is present",
+	"required_without":            "Required when {others} is missing",
+	"mutually_exclusive":          "Only one of {fields} may be set",
+	"invalid_union_discriminator": "Invalid discriminator value. Expected {options}",
+	"invalid_key_order":           "Keys must appear in the order: {order}",
+	"duplicate_key":               "Duplicate key '{key}'",
+	"ambiguous_union":             "Value matched more than one union type",
+	"not_business_hours":          "Date must be within business hours",
+	"holiday":                     "Date must not be a holiday",
+	"not_weekday":                 "Date must fall on {weekdays}",
+	"invalid_intersection_types":  "Intersection results could not be merged",
+	"not_within_last":             "Date must be within the last {duration}",
+	"not_within_next":             "Date must be within the next {duration}",
+	"quota_exceeded":              "Validation exceeded its quota of {limit} {resource}",
+	"refinement_panic":            "Validation check panicked: {reason}",
+	"invalid_decimal":             "Invalid decimal number",
+	"decimal_precision":           "Decimal must have at most {precision} digits, {scale} of them after the decimal point",
+	"custom":                      "Invalid value",
+}
+
+// RegisterLocale registers translations for a language tag such as "de" or
+// "pt-BR". Registering an existing locale merges the new templates into it,
+// so a subset of messages can be overridden without redefining the rest.
+func RegisterLocale(lang string, messages Messages) {
+	lang = normalizeLang(lang)
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	existing, ok := locales[lang]
+	merged := make(Messages, len(existing)+len(messages))
+	if ok {
+		for code, tmpl := range existing {
+			merged[code] = tmpl
+		}
+	}
+	for code, tmpl := range messages {
+		merged[code] = tmpl
+	}
+	locales[lang] = merged
+}
+
+// Locales returns the language tags that have registered translations
+func Locales() []string {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	tags := make([]string, 0, len(locales))
+	for lang := range locales {
+		tags = append(tags, lang)
+	}
+	return tags
+}
+
+// Translate returns the error message in the given language.
+// If no translation exists for the error code, the original message is returned.
+func (e ValidationError) Translate(lang string) string {
+	tmpl, ok := lookupTemplate(lang, e)
+	if !ok {
+		return e.Message
+	}
+	return interpolate(tmpl, e)
+}
+
+// Localize returns a copy of the errors with messages translated to the given language
+func (e ValidationErrors) Localize(lang string) ValidationErrors {
+	if e == nil {
+		return nil
+	}
+	localized := make(ValidationErrors, len(e))
+	for i, err := range e {
+		err.Message = err.Translate(lang)
+		if len(err.Branches) > 0 {
+			branches := make([]UnionBranch, len(err.Branches))
+			for j, branch := range err.Branches {
+				branch.Errors = branch.Errors.Localize(lang)
+				branches[j] = branch
+			}
+			err.Branches = branches
+		}
+		localized[i] = err
+	}
+	return localized
+}
+
+// LocalizedValidator wraps a validator and translates any errors it produces
+type LocalizedValidator struct {
+	validator Validator
+	lang      string
+}
+
+// Localized wraps a validator so that Parse produces messages in the given
+// language, e.g. the language negotiated from a request's Accept-Language header
+func Localized(validator Validator, lang string) *LocalizedValidator {
+	return &LocalizedValidator{
+		validator: validator,
+		lang:      lang,
+	}
+}
+
+// Parse validates the input value and translates the resulting errors
+func (v *LocalizedValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *LocalizedValidator) parseWithState(value any, st *parseState) ParseResult {
+	result := st.parse(v.validator, value)
+	if !result.Ok {
+		result.Errors = result.Errors.Localize(v.lang)
+	}
+	return result
+}
+
+// lookupTemplate finds the most specific template for an error,
+// trying the full language tag first and then its base language
+func lookupTemplate(lang string, err ValidationError) (string, bool) {
+	if err.Code == "" {
+		return "", false
+	}
+
+	code := err.Code
+	keys := []string{code}
+	if typ, ok := err.Params["type"].(string); ok && typ != "" {
+		keys = []string{code + "." + typ, code}
+		if inclusive, ok := err.Params["inclusive"].(bool); ok && !inclusive {
+			keys = append([]string{code + "." + typ + ".exclusive"}, keys...)
+		}
+	}
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	for _, tag := range langCandidates(lang) {
+		messages, ok := locales[tag]
+		if !ok {
+			continue
+		}
+		for _, key := range keys {
+			if tmpl, ok := messages[key]; ok {
+				return tmpl, true
+			}
+		}
+	}
+	return "", false
+}
+
+// langCandidates returns the tags to try for a language, most specific first
+// ("pt-br" -> "pt-br", "pt")
+func langCandidates(lang string) []string {
+	lang = normalizeLang(lang)
+	if i := strings.IndexByte(lang, '-'); i > 0 {
+		return []string{lang, lang[:i]}
+	}
+	return []string{lang}
+}
+
+// normalizeLang lowercases a language tag and uses '-' as the separator
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+// interpolate replaces {name} placeholders with error parameters
+func interpolate(tmpl string, err ValidationError) string {
+	if !strings.Contains(tmpl, "{") {
+		return tmpl
+	}
+
+	var sb strings.Builder
+	for {
+		start := strings.IndexByte(tmpl, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(tmpl[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		name := tmpl[start+1 : end]
+		sb.WriteString(tmpl[:start])
+		if name == "field" {
+			sb.WriteString(err.Path)
+		} else if val, ok := err.Params[name]; ok {
+			sb.WriteString(fmt.Sprint(val))
+		} else {
+			// Leave unknown placeholders untouched
+			sb.WriteString(tmpl[start : end+1])
+		}
+		tmpl = tmpl[end+1:]
+	}
+	sb.WriteString(tmpl)
+	return sb.String()
+}
@@ -0,0 +1,571 @@
+//go:build !zogo_minimal
+
+package zogo
+
+// Bundled translations for the built-in error codes.
+// Additional locales can be added at runtime with RegisterLocale.
+func init() {
+	RegisterLocale("es", Messages{
+		"invalid_type":                "Se esperaba {expected}, se recibió {received}",
+		"too_small":                   "El valor debe ser al menos {minimum}",
+		"too_small.string":            "El texto debe tener al menos {minimum} caracteres",
+		"too_small.number":            "El número debe ser al menos {minimum}",
+		"too_small.number.exclusive":  "El número debe ser mayor que {minimum}",
+		"too_small.array":             "La lista debe contener al menos {minimum} elemento(s)",
+		"too_small.date":              "La fecha debe ser igual o posterior a {minimum}",
+		"too_small.date.exclusive":    "La fecha debe ser posterior a {minimum}",
+		"too_small.tuple":             "Se esperaba una tupla de longitud mínima {minimum}",
+		"too_small.bytes":             "El valor debe tener al menos {minimum} bytes",
+		"too_small.age":               "La edad debe ser de al menos {minimum} años",
+		"too_small.password":          "La contraseña debe tener al menos {minimum} caracteres",
+		"too_small.username":          "El nombre de usuario debe tener al menos {minimum} caracteres",
+		"too_big":                     "El valor debe ser como máximo {maximum}",
+		"too_big.string":              "El texto debe tener como máximo {maximum} caracteres",
+		"too_big.number":              "El número debe ser como máximo {maximum}",
+		"too_big.number.exclusive":    "El número debe ser menor que {maximum}",
+		"too_big.array":               "La lista debe contener como máximo {maximum} elemento(s)",
+		"too_big.date":                "La fecha debe ser igual o anterior a {maximum}",
+		"too_big.date.exclusive":      "La fecha debe ser anterior a {maximum}",
+		"too_big.bytes":               "El valor debe tener como máximo {maximum} bytes",
+		"too_big.age":                 "La edad debe ser como máximo de {maximum} años",
+		"too_big.username":            "El nombre de usuario debe tener como máximo {maximum} caracteres",
+		"invalid_length":              "Se esperaba longitud {length}, se recibió {received}",
+		"invalid_length.string":       "El texto debe tener exactamente {length} caracteres",
+		"invalid_length.bytes":        "El valor debe tener exactamente {length} bytes",
+		"empty":                       "El texto no debe estar vacío",
+		"invalid_string":              "Texto no válido",
+		"invalid_string.email":        "Formato de correo electrónico no válido",
+		"invalid_string.email.plus":   "El correo electrónico no debe usar direcciones con +",
+		"invalid_string.email.local":  "La parte local del correo electrónico debe tener como máximo {maximum} caracteres",
+		"invalid_string.email.mx":     "El dominio del correo electrónico no acepta correo",
+		"invalid_string.url":          "Formato de URL no válido",
+		"invalid_string.uuid":         "Formato de UUID no válido",
+		"invalid_string.domain":       "Nombre de dominio no válido",
+		"invalid_string.ip":           "Dirección IP no válida",
+		"invalid_string.ipv4":         "Dirección IPv4 no válida",
+		"invalid_string.ipv6":         "Dirección IPv6 no válida",
+		"invalid_string.cidr":         "Bloque CIDR no válido",
+		"invalid_string.cidrv4":       "Bloque CIDR IPv4 no válido",
+		"invalid_string.cidrv6":       "Bloque CIDR IPv6 no válido",
+		"invalid_string.in_cidr":      "La dirección IP debe estar dentro de {networks}",
+		"invalid_string.base64":       "Texto base64 no válido",
+		"invalid_string.base64url":    "Texto base64url no válido",
+		"invalid_string.base32":       "Texto base32 no válido",
+		"invalid_string.data_uri":     "URI de datos no válida",
+		"invalid_string.hex":          "Texto hexadecimal no válido",
+		"invalid_string.utf8":         "Texto UTF-8 no válido",
+		"invalid_string.cuid":         "Formato de CUID no válido",
+		"invalid_string.cuid2":        "Formato de CUID2 no válido",
+		"invalid_string.ulid":         "Formato de ULID no válido",
+		"invalid_string.nanoid":       "Formato de Nanoid no válido",
+		"invalid_string.objectid":     "Formato de ObjectID no válido",
+		"invalid_string.git_sha":      "SHA de Git no válido",
+		"invalid_string.cron":         "Expresión cron no válida",
+		"invalid_string.iban":         "IBAN no válido",
+		"invalid_string.bic":          "BIC no válido",
+		"invalid_string.isbn":         "ISBN no válido",
+		"invalid_string.ean":          "Código de barras EAN no válido",
+		"invalid_string.language_tag": "Etiqueta de idioma no válida",
+		"invalid_string.css_color":    "Color CSS no válido",
+		"invalid_string.regex":        "El texto no coincide con el patrón requerido",
+		"invalid_string.starts_with":  "El texto debe comenzar con '{prefix}'",
+		"invalid_string.ends_with":    "El texto debe terminar con '{suffix}'",
+		"invalid_string.includes":     "El texto debe contener '{substring}'",
+		"invalid_string.not_regex":    "El texto coincide con un patrón prohibido",
+		"invalid_string.not_prefix":   "El texto no debe comenzar con '{prefix}'",
+		"invalid_string.not_includes": "El texto no debe contener '{substring}'",
+		"invalid_string.no_html":      "El texto no debe contener HTML",
+		"invalid_string.ascii":        "El texto solo debe contener caracteres ASCII",
+		"invalid_string.printable":    "El texto solo debe contener caracteres imprimibles",
+		"invalid_string.alpha":        "El texto solo debe contener letras",
+		"invalid_string.alphanumeric": "El texto solo debe contener letras y dígitos",
+		"invalid_string.emoji":        "El texto solo debe contener emojis",
+		"invalid_string.no_emoji":     "El texto no debe contener emojis",
+		"invalid_string.lowercase":    "El texto debe estar en minúsculas",
+		"invalid_string.uppercase":    "El texto debe estar en mayúsculas",
+		"not_integer":                 "El número debe ser entero",
+		"not_finite":                  "El número debe ser finito",
+		"not_positive":                "El número debe ser positivo",
+		"not_negative":                "El número debe ser negativo",
+		"not_nonnegative":             "El número no debe ser negativo",
+		"not_nonpositive":             "El número no debe ser positivo",
+		"unsafe_integer":              "El número debe estar dentro del rango de enteros seguros",
+		"not_multiple_of":             "El número debe ser múltiplo de {multipleOf}",
+		"invalid_date":                "Fecha no válida",
+		"invalid_date.date_only":      "La fecha debe ser una fecha de calendario (AAAA-MM-DD) sin hora",
+		"invalid_date.utc":            "La fecha debe estar en UTC",
+		"not_future":                  "La fecha debe estar en el futuro",
+		"not_past":                    "La fecha debe estar en el pasado",
+		"invalid_enum_value":          "Valor no válido. Se esperaba uno de: {options}, se recibió: {received}",
+		"invalid_literal":             "Valor literal no válido. Se esperaba {expected}, se recibió {received}",
+		"unrecognized_keys":           "Campo desconocido",
+		"unrecognized_keys.typo":      "Campo desconocido '{key}', ¿quisiste decir '{suggestion}'?",
+		"invalid_key":                 "Clave de registro no válida",
+		"invalid_union":               "El valor no coincide con ningún tipo de la unión",
+		"invalid_phone":               "Número de teléfono no válido para el país {country}",
+		"invalid_string.country":      "Código de país no válido",
+		"invalid_string.currency":     "Código de moneda no válido",
+		"weak_password":               "La contraseña es demasiado débil",
+		"weak_password.uppercase":     "La contraseña debe contener una letra mayúscula",
+		"weak_password.lowercase":     "La contraseña debe contener una letra minúscula",
+		"weak_password.digit":         "La contraseña debe contener un dígito",
+		"weak_password.symbol":        "La contraseña debe contener un símbolo",
+		"weak_password.repeats":       "La contraseña no debe repetir un carácter más de {maximum} veces seguidas",
+		"weak_password.banned":        "La contraseña es demasiado común",
+		"invalid_username":            "Nombre de usuario no válido",
+		"invalid_username.chars":      "El nombre de usuario solo puede contener letras, dígitos y {allowed}",
+		"invalid_username.alnum":      "El nombre de usuario solo puede contener letras y dígitos",
+		"invalid_username.digit":      "El nombre de usuario no debe comenzar con un dígito",
+		"invalid_username.reserved":   "El nombre de usuario está reservado",
+		"unsupported_version":         "Versión de API no compatible '{version}'; versiones compatibles: {supported}",
+		"unsupported_version.missing": "Falta la versión de API; versiones compatibles: {supported}",
+		"too_deep":                    "Se superó la profundidad máxima de anidamiento de {maximum}",
+		"canceled":                    "Validación cancelada: {reason}",
+		"check_failed":                "La comprobación de validación falló: {reason}",
+		"invalid_json":                "El cuerpo de la solicitud no es JSON válido",
+		"payload_too_large":           "El cuerpo de la solicitud debe tener como máximo {maximum} bytes",
+		"unreadable_body":             "No se pudo leer el cuerpo de la solicitud",
+		"unsupported_media_type":      "Tipo de contenido no admitido {type}; se esperaba JSON",
+		"required_with":               "Obligatorio cuando {others} está presente",
+		"required_without":            "Obligatorio cuando falta {others}",
+		"mutually_exclusive":          "Solo se puede indicar uno de {fields}",
+		"invalid_union_discriminator": "Valor discriminador no válido. Se esperaba {options}",
+		"invalid_key_order":           "Las claves deben aparecer en el orden: {order}",
+		"duplicate_key":               "Clave duplicada '{key}'",
+		"ambiguous_union":             "El valor coincide con más de un tipo de la unión",
+		"not_business_hours":          "La fecha debe estar dentro del horario laboral",
+		"holiday":                     "La fecha no debe ser un día festivo",
+		"not_weekday":                 "La fecha debe caer en {weekdays}",
+		"invalid_intersection_types":  "Los resultados de la intersección no se pudieron combinar",
+		"not_within_last":             "La fecha debe estar dentro de los últimos {duration}",
+		"not_within_next":             "La fecha debe estar dentro de los próximos {duration}",
+		"quota_exceeded":              "La validación superó su cuota de {limit} {resource}",
+		"refinement_panic":            "La comprobación de validación entró en pánico: {reason}",
+		"invalid_decimal":             "Número decimal no válido",
+		"decimal_precision":           "El decimal debe tener como máximo {precision} dígitos, {scale} de ellos después del punto decimal",
+		"custom":                      "Valor no válido",
+	})
+
+	RegisterLocale("fr", Messages{
+		"invalid_type":                "{expected} attendu, {received} reçu",
+		"too_small":                   "La valeur doit être au moins {minimum}",
+		"too_small.string":            "La chaîne doit contenir au moins {minimum} caractères",
+		"too_small.number":            "Le nombre doit être au moins {minimum}",
+		"too_small.number.exclusive":  "Le nombre doit être supérieur à {minimum}",
+		"too_small.array":             "La liste doit contenir au moins {minimum} élément(s)",
+		"too_small.date":              "La date doit être égale ou postérieure à {minimum}",
+		"too_small.date.exclusive":    "La date doit être postérieure à {minimum}",
+		"too_small.tuple":             "Tuple d'au moins {minimum} éléments attendu",
+		"too_small.bytes":             "La valeur doit contenir au moins {minimum} octets",
+		"too_small.age":               "L'âge doit être d'au moins {minimum} ans",
+		"too_small.password":          "Le mot de passe doit contenir au moins {minimum} caractères",
+		"too_small.username":          "Le nom d'utilisateur doit contenir au moins {minimum} caractères",
+		"too_big":                     "La valeur doit être au plus {maximum}",
+		"too_big.string":              "La chaîne doit contenir au plus {maximum} caractères",
+		"too_big.number":              "Le nombre doit être au plus {maximum}",
+		"too_big.number.exclusive":    "Le nombre doit être inférieur à {maximum}",
+		"too_big.array":               "La liste doit contenir au plus {maximum} élément(s)",
+		"too_big.date":                "La date doit être égale ou antérieure à {maximum}",
+		"too_big.date.exclusive":      "La date doit être antérieure à {maximum}",
+		"too_big.bytes":               "La valeur doit contenir au plus {maximum} octets",
+		"too_big.age":                 "L'âge doit être d'au plus {maximum} ans",
+		"too_big.username":            "Le nom d'utilisateur doit contenir au plus {maximum} caractères",
+		"invalid_length":              "Longueur {length} attendue, longueur {received} reçue",
+		"invalid_length.string":       "La chaîne doit contenir exactement {length} caractères",
+		"invalid_length.bytes":        "La valeur doit contenir exactement {length} octets",
+		"empty":                       "La chaîne ne doit pas être vide",
+		"invalid_string":              "Chaîne invalide",
+		"invalid_string.email":        "Format d'adresse e-mail invalide",
+		"invalid_string.email.plus":   "L'adresse e-mail ne doit pas utiliser d'alias avec +",
+		"invalid_string.email.local":  "La partie locale de l'adresse e-mail doit contenir au plus {maximum} caractères",
+		"invalid_string.email.mx":     "Le domaine de l'adresse e-mail n'accepte pas de courrier",
+		"invalid_string.url":          "Format d'URL invalide",
+		"invalid_string.uuid":         "Format d'UUID invalide",
+		"invalid_string.domain":       "Nom de domaine invalide",
+		"invalid_string.ip":           "Adresse IP invalide",
+		"invalid_string.ipv4":         "Adresse IPv4 invalide",
+		"invalid_string.ipv6":         "Adresse IPv6 invalide",
+		"invalid_string.cidr":         "Bloc CIDR invalide",
+		"invalid_string.cidrv4":       "Bloc CIDR IPv4 invalide",
+		"invalid_string.cidrv6":       "Bloc CIDR IPv6 invalide",
+		"invalid_string.in_cidr":      "L'adresse IP doit être dans {networks}",
+		"invalid_string.base64":       "Chaîne base64 invalide",
+		"invalid_string.base64url":    "Chaîne base64url invalide",
+		"invalid_string.base32":       "Chaîne base32 invalide",
+		"invalid_string.data_uri":     "URI de données invalide",
+		"invalid_string.hex":          "Chaîne hexadécimale invalide",
+		"invalid_string.utf8":         "Texte UTF-8 invalide",
+		"invalid_string.cuid":         "Format de CUID invalide",
+		"invalid_string.cuid2":        "Format de CUID2 invalide",
+		"invalid_string.ulid":         "Format de ULID invalide",
+		"invalid_string.nanoid":       "Format de Nanoid invalide",
+		"invalid_string.objectid":     "Format d'ObjectID invalide",
+		"invalid_string.git_sha":      "SHA Git invalide",
+		"invalid_string.cron":         "Expression cron invalide",
+		"invalid_string.iban":         "IBAN invalide",
+		"invalid_string.bic":          "BIC invalide",
+		"invalid_string.isbn":         "ISBN invalide",
+		"invalid_string.ean":          "Code-barres EAN invalide",
+		"invalid_string.language_tag": "Étiquette de langue invalide",
+		"invalid_string.css_color":    "Couleur CSS invalide",
+		"invalid_string.regex":        "La chaîne ne correspond pas au motif requis",
+		"invalid_string.starts_with":  "La chaîne doit commencer par '{prefix}'",
+		"invalid_string.ends_with":    "La chaîne doit se terminer par '{suffix}'",
+		"invalid_string.includes":     "La chaîne doit contenir '{substring}'",
+		"invalid_string.not_regex":    "La chaîne correspond à un motif interdit",
+		"invalid_string.not_prefix":   "La chaîne ne doit pas commencer par '{prefix}'",
+		"invalid_string.not_includes": "La chaîne ne doit pas contenir '{substring}'",
+		"invalid_string.no_html":      "La chaîne ne doit pas contenir de HTML",
+		"invalid_string.ascii":        "La chaîne ne doit contenir que des caractères ASCII",
+		"invalid_string.printable":    "La chaîne ne doit contenir que des caractères imprimables",
+		"invalid_string.alpha":        "La chaîne ne doit contenir que des lettres",
+		"invalid_string.alphanumeric": "La chaîne ne doit contenir que des lettres et des chiffres",
+		"invalid_string.emoji":        "La chaîne ne doit contenir que des emojis",
+		"invalid_string.no_emoji":     "La chaîne ne doit pas contenir d'emojis",
+		"invalid_string.lowercase":    "La chaîne doit être en minuscules",
+		"invalid_string.uppercase":    "La chaîne doit être en majuscules",
+		"not_integer":                 "Le nombre doit être un entier",
+		"not_finite":                  "Le nombre doit être fini",
+		"not_positive":                "Le nombre doit être positif",
+		"not_negative":                "Le nombre doit être négatif",
+		"not_nonnegative":             "Le nombre ne doit pas être négatif",
+		"not_nonpositive":             "Le nombre ne doit pas être positif",
+		"unsafe_integer":              "Le nombre doit être dans la plage des entiers sûrs",
+		"not_multiple_of":             "Le nombre doit être un multiple de {multipleOf}",
+		"invalid_date":                "Date invalide",
+		"invalid_date.date_only":      "La date doit être une date calendaire (AAAA-MM-JJ) sans heure",
+		"invalid_date.utc":            "La date doit être en UTC",
+		"not_future":                  "La date doit être dans le futur",
+		"not_past":                    "La date doit être dans le passé",
+		"invalid_enum_value":          "Valeur invalide. Valeurs attendues : {options}, reçu : {received}",
+		"invalid_literal":             "Valeur littérale invalide. {expected} attendu, {received} reçu",
+		"unrecognized_keys":           "Champ inconnu",
+		"unrecognized_keys.typo":      "Champ inconnu '{key}', vouliez-vous dire '{suggestion}' ?",
+		"invalid_key":                 "Clé d'enregistrement invalide",
+		"invalid_union":               "La valeur ne correspond à aucun type de l'union",
+		"invalid_phone":               "Numéro de téléphone invalide pour le pays {country}",
+		"invalid_string.country":      "Code pays invalide",
+		"invalid_string.currency":     "Code de devise invalide",
+		"weak_password":               "Le mot de passe est trop faible",
+		"weak_password.uppercase":     "Le mot de passe doit contenir une lettre majuscule",
+		"weak_password.lowercase":     "Le mot de passe doit contenir une lettre minuscule",
+		"weak_password.digit":         "Le mot de passe doit contenir un chiffre",
+		"weak_password.symbol":        "Le mot de passe doit contenir un symbole",
+		"weak_password.repeats":       "Le mot de passe ne doit pas répéter un caractère plus de {maximum} fois de suite",
+		"weak_password.banned":        "Le mot de passe est trop courant",
+		"invalid_username":            "Nom d'utilisateur invalide",
+		"invalid_username.chars":      "Le nom d'utilisateur ne peut contenir que des lettres, des chiffres et {allowed}",
+		"invalid_username.alnum":      "Le nom d'utilisateur ne peut contenir que des lettres et des chiffres",
+		"invalid_username.digit":      "Le nom d'utilisateur ne doit pas commencer par un chiffre",
+		"invalid_username.reserved":   "Le nom d'utilisateur est réservé",
+		"unsupported_version":         "Version d'API non prise en charge '{version}' ; versions prises en charge : {supported}",
+		"unsupported_version.missing": "Version d'API manquante ; versions prises en charge : {supported}",
+		"too_deep":                    "Profondeur d'imbrication maximale de {maximum} dépassée",
+		"canceled":                    "Validation annulée : {reason}",
+		"check_failed":                "La vérification de validation a échoué : {reason}",
+		"invalid_json":                "Le corps de la requête n'est pas un JSON valide",
+		"payload_too_large":           "Le corps de la requête doit faire au plus {maximum} octets",
+		"unreadable_body":             "Impossible de lire le corps de la requête",
+		"unsupported_media_type":      "Type de média non pris en charge {type} ; JSON attendu",
+		"required_with":               "Obligatoire lorsque {others} est présent",
+		"required_without":            "Obligatoire lorsque {others} est absent",
+		"mutually_exclusive":          "Un seul champ parmi {fields} peut être défini",
+		"invalid_union_discriminator": "Valeur discriminante invalide. {options} attendu",
+		"invalid_key_order":           "Les clés doivent apparaître dans l'ordre : {order}",
+		"duplicate_key":               "Clé dupliquée '{key}'",
+		"ambiguous_union":             "La valeur correspond à plusieurs types de l'union",
+		"not_business_hours":          "La date doit être pendant les heures ouvrables",
+		"holiday":                     "La date ne doit pas être un jour férié",
+		"not_weekday":                 "La date doit tomber un {weekdays}",
+		"invalid_intersection_types":  "Les résultats de l'intersection n'ont pas pu être fusionnés",
+		"not_within_last":             "La date doit être dans les dernières {duration}",
+		"not_within_next":             "La date doit être dans les prochaines {duration}",
+		"quota_exceeded":              "La validation a dépassé son quota de {limit} {resource}",
+		"refinement_panic":            "La vérification a paniqué : {reason}",
+		"invalid_decimal":             "Nombre décimal invalide",
+		"decimal_precision":           "Le décimal doit avoir au plus {precision} chiffres, dont {scale} après la virgule",
+		"custom":                      "Valeur invalide",
+	})
+
+	RegisterLocale("de", Messages{
+		"invalid_type":                "{expected} erwartet, {received} erhalten",
+		"too_small":                   "Der Wert muss mindestens {minimum} sein",
+		"too_small.string":            "Der Text muss mindestens {minimum} Zeichen lang sein",
+		"too_small.number":            "Die Zahl muss mindestens {minimum} sein",
+		"too_small.number.exclusive":  "Die Zahl muss größer als {minimum} sein",
+		"too_small.array":             "Die Liste muss mindestens {minimum} Element(e) enthalten",
+		"too_small.date":              "Das Datum muss am oder nach dem {minimum} liegen",
+		"too_small.date.exclusive":    "Das Datum muss nach dem {minimum} liegen",
+		"too_small.tuple":             "Tupel mit mindestens {minimum} Elementen erwartet",
+		"too_small.bytes":             "Der Wert muss mindestens {minimum} Bytes lang sein",
+		"too_small.age":               "Das Alter muss mindestens {minimum} Jahre betragen",
+		"too_small.password":          "Das Passwort muss mindestens {minimum} Zeichen lang sein",
+		"too_small.username":          "Der Benutzername muss mindestens {minimum} Zeichen lang sein",
+		"too_big":                     "Der Wert darf höchstens {maximum} sein",
+		"too_big.string":              "Der Text darf höchstens {maximum} Zeichen lang sein",
+		"too_big.number":              "Die Zahl darf höchstens {maximum} sein",
+		"too_big.number.exclusive":    "Die Zahl muss kleiner als {maximum} sein",
+		"too_big.array":               "Die Liste darf höchstens {maximum} Element(e) enthalten",
+		"too_big.date":                "Das Datum muss am oder vor dem {maximum} liegen",
+		"too_big.date.exclusive":      "Das Datum muss vor dem {maximum} liegen",
+		"too_big.bytes":               "Der Wert darf höchstens {maximum} Bytes lang sein",
+		"too_big.age":                 "Das Alter darf höchstens {maximum} Jahre betragen",
+		"too_big.username":            "Der Benutzername darf höchstens {maximum} Zeichen lang sein",
+		"invalid_length":              "Länge {length} erwartet, Länge {received} erhalten",
+		"invalid_length.string":       "Der Text muss genau {length} Zeichen lang sein",
+		"invalid_length.bytes":        "Der Wert muss genau {length} Bytes lang sein",
+		"empty":                       "Der Text darf nicht leer sein",
+		"invalid_string":              "Ungültiger Text",
+		"invalid_string.email":        "Ungültiges E-Mail-Format",
+		"invalid_string.email.plus":   "Die E-Mail-Adresse darf keine Plus-Adressierung verwenden",
+		"invalid_string.email.local":  "Der lokale Teil der E-Mail-Adresse darf höchstens {maximum} Zeichen lang sein",
+		"invalid_string.email.mx":     "Die Domain der E-Mail-Adresse nimmt keine E-Mails an",
+		"invalid_string.url":          "Ungültiges URL-Format",
+		"invalid_string.uuid":         "Ungültiges UUID-Format",
+		"invalid_string.domain":       "Ungültiger Domainname",
+		"invalid_string.ip":           "Ungültige IP-Adresse",
+		"invalid_string.ipv4":         "Ungültige IPv4-Adresse",
+		"invalid_string.ipv6":         "Ungültige IPv6-Adresse",
+		"invalid_string.cidr":         "Ungültiger CIDR-Block",
+		"invalid_string.cidrv4":       "Ungültiger IPv4-CIDR-Block",
+		"invalid_string.cidrv6":       "Ungültiger IPv6-CIDR-Block",
+		"invalid_string.in_cidr":      "Die IP-Adresse muss in {networks} liegen",
+		"invalid_string.base64":       "Ungültiger Base64-Text",
+		"invalid_string.base64url":    "Ungültiger Base64url-Text",
+		"invalid_string.base32":       "Ungültiger Base32-Text",
+		"invalid_string.data_uri":     "Ungültige Data-URI",
+		"invalid_string.hex":          "Ungültiger Hexadezimaltext",
+		"invalid_string.utf8":         "Ungültiger UTF-8-Text",
+		"invalid_string.cuid":         "Ungültiges CUID-Format",
+		"invalid_string.cuid2":        "Ungültiges CUID2-Format",
+		"invalid_string.ulid":         "Ungültiges ULID-Format",
+		"invalid_string.nanoid":       "Ungültiges Nanoid-Format",
+		"invalid_string.objectid":     "Ungültiges ObjectID-Format",
+		"invalid_string.git_sha":      "Ungültiger Git-SHA",
+		"invalid_string.cron":         "Ungültiger Cron-Ausdruck",
+		"invalid_string.iban":         "Ungültige IBAN",
+		"invalid_string.bic":          "Ungültiger BIC",
+		"invalid_string.isbn":         "Ungültige ISBN",
+		"invalid_string.ean":          "Ungültiger EAN-Barcode",
+		"invalid_string.language_tag": "Ungültiges Sprach-Tag",
+		"invalid_string.css_color":    "Ungültige CSS-Farbe",
+		"invalid_string.regex":        "Der Text entspricht nicht dem erforderlichen Muster",
+		"invalid_string.starts_with":  "Der Text muss mit '{prefix}' beginnen",
+		"invalid_string.ends_with":    "Der Text muss mit '{suffix}' enden",
+		"invalid_string.includes":     "Der Text muss '{substring}' enthalten",
+		"invalid_string.not_regex":    "Der Text entspricht einem verbotenen Muster",
+		"invalid_string.not_prefix":   "Der Text darf nicht mit '{prefix}' beginnen",
+		"invalid_string.not_includes": "Der Text darf '{substring}' nicht enthalten",
+		"invalid_string.no_html":      "Der Text darf kein HTML enthalten",
+		"invalid_string.ascii":        "Der Text darf nur ASCII-Zeichen enthalten",
+		"invalid_string.printable":    "Der Text darf nur druckbare Zeichen enthalten",
+		"invalid_string.alpha":        "Der Text darf nur Buchstaben enthalten",
+		"invalid_string.alphanumeric": "Der Text darf nur Buchstaben und Ziffern enthalten",
+		"invalid_string.emoji":        "Der Text darf nur Emojis enthalten",
+		"invalid_string.no_emoji":     "Der Text darf keine Emojis enthalten",
+		"invalid_string.lowercase":    "Der Text muss kleingeschrieben sein",
+		"invalid_string.uppercase":    "Der Text muss großgeschrieben sein",
+		"not_integer":                 "Die Zahl muss eine ganze Zahl sein",
+		"not_finite":                  "Die Zahl muss endlich sein",
+		"not_positive":                "Die Zahl muss positiv sein",
+		"not_negative":                "Die Zahl muss negativ sein",
+		"not_nonnegative":             "Die Zahl darf nicht negativ sein",
+		"not_nonpositive":             "Die Zahl darf nicht positiv sein",
+		"unsafe_integer":              "Die Zahl muss im sicheren Ganzzahlbereich liegen",
+		"not_multiple_of":             "Die Zahl muss ein Vielfaches von {multipleOf} sein",
+		"invalid_date":                "Ungültiges Datum",
+		"invalid_date.date_only":      "Das Datum muss ein Kalenderdatum (JJJJ-MM-TT) ohne Uhrzeit sein",
+		"invalid_date.utc":            "Das Datum muss in UTC angegeben sein",
+		"not_future":                  "Das Datum muss in der Zukunft liegen",
+		"not_past":                    "Das Datum muss in der Vergangenheit liegen",
+		"invalid_enum_value":          "Ungültiger Wert. Erwartet wird einer von: {options}, erhalten: {received}",
+		"invalid_literal":             "Ungültiger Literalwert. {expected} erwartet, {received} erhalten",
+		"unrecognized_keys":           "Unbekanntes Feld",
+		"unrecognized_keys.typo":      "Unbekanntes Feld '{key}', meinten Sie '{suggestion}'?",
+		"invalid_key":                 "Ungültiger Schlüssel",
+		"invalid_union":               "Der Wert entspricht keinem Typ der Union",
+		"invalid_phone":               "Ungültige Telefonnummer für Land {country}",
+		"invalid_string.country":      "Ungültiger Ländercode",
+		"invalid_string.currency":     "Ungültiger Währungscode",
+		"weak_password":               "Das Passwort ist zu schwach",
+		"weak_password.uppercase":     "Das Passwort muss einen Großbuchstaben enthalten",
+		"weak_password.lowercase":     "Das Passwort muss einen Kleinbuchstaben enthalten",
+		"weak_password.digit":         "Das Passwort muss eine Ziffer enthalten",
+		"weak_password.symbol":        "Das Passwort muss ein Sonderzeichen enthalten",
+		"weak_password.repeats":       "Das Passwort darf ein Zeichen nicht öfter als {maximum} Mal hintereinander wiederholen",
+		"weak_password.banned":        "Das Passwort ist zu häufig",
+		"invalid_username":            "Ungültiger Benutzername",
+		"invalid_username.chars":      "Der Benutzername darf nur Buchstaben, Ziffern und {allowed} enthalten",
+		"invalid_username.alnum":      "Der Benutzername darf nur Buchstaben und Ziffern enthalten",
+		"invalid_username.digit":      "Der Benutzername darf nicht mit einer Ziffer beginnen",
+		"invalid_username.reserved":   "Der Benutzername ist reserviert",
+		"unsupported_version":         "Nicht unterstützte API-Version '{version}'; unterstützte Versionen: {supported}",
+		"unsupported_version.missing": "Fehlende API-Version; unterstützte Versionen: {supported}",
+		"too_deep":                    "Maximale Verschachtelungstiefe von {maximum} überschritten",
+		"canceled":                    "Validierung abgebrochen: {reason}",
+		"check_failed":                "Validierungsprüfung fehlgeschlagen: {reason}",
+		"invalid_json":                "Der Anfragetext ist kein gültiges JSON",
+		"payload_too_large":           "Der Anfragetext darf höchstens {maximum} Bytes groß sein",
+		"unreadable_body":             "Der Anfragetext konnte nicht gelesen werden",
+		"unsupported_media_type":      "Nicht unterstützter Medientyp {type}; JSON erwartet",
+		"required_with":               "Erforderlich, wenn {others} vorhanden ist",
+		"required_without":            "Erforderlich, wenn {others} fehlt",
+		"mutually_exclusive":          "Nur eines von {fields} darf gesetzt sein",
+		"invalid_union_discriminator": "Ungültiger Diskriminatorwert. Erwartet wurde {options}",
+		"invalid_key_order":           "Schlüssel müssen in dieser Reihenfolge stehen: {order}",
+		"duplicate_key":               "Doppelter Schlüssel '{key}'",
+		"ambiguous_union":             "Wert entspricht mehr als einem Typ der Union",
+		"not_business_hours":          "Das Datum muss innerhalb der Geschäftszeiten liegen",
+		"holiday":                     "Das Datum darf kein Feiertag sein",
+		"not_weekday":                 "Das Datum muss auf {weekdays} fallen",
+		"invalid_intersection_types":  "Die Ergebnisse der Schnittmenge konnten nicht zusammengeführt werden",
+		"not_within_last":             "Das Datum muss innerhalb der letzten {duration} liegen",
+		"not_within_next":             "Das Datum muss innerhalb der nächsten {duration} liegen",
+		"quota_exceeded":              "Die Validierung hat ihr Kontingent von {limit} {resource} überschritten",
+		"refinement_panic":            "Die Validierungsprüfung ist abgestürzt: {reason}",
+		"invalid_decimal":             "Ungültige Dezimalzahl",
+		"decimal_precision":           "Die Dezimalzahl darf höchstens {precision} Stellen haben, davon {scale} nach dem Komma",
+		"custom":                      "Ungültiger Wert",
+	})
+
+	RegisterLocale("pt", Messages{
+		"invalid_type":                "Esperado {expected}, recebido {received}",
+		"too_small":                   "O valor deve ser no mínimo {minimum}",
+		"too_small.string":            "O texto deve ter pelo menos {minimum} caracteres",
+		"too_small.number":            "O número deve ser no mínimo {minimum}",
+		"too_small.number.exclusive":  "O número deve ser maior que {minimum}",
+		"too_small.array":             "A lista deve conter pelo menos {minimum} elemento(s)",
+		"too_small.date":              "A data deve ser igual ou posterior a {minimum}",
+		"too_small.date.exclusive":    "A data deve ser posterior a {minimum}",
+		"too_small.tuple":             "Esperada uma tupla de comprimento mínimo {minimum}",
+		"too_small.bytes":             "O valor deve ter pelo menos {minimum} bytes",
+		"too_small.age":               "A idade deve ser de pelo menos {minimum} anos",
+		"too_small.password":          "A senha deve ter pelo menos {minimum} caracteres",
+		"too_small.username":          "O nome de usuário deve ter pelo menos {minimum} caracteres",
+		"too_big":                     "O valor deve ser no máximo {maximum}",
+		"too_big.string":              "O texto deve ter no máximo {maximum} caracteres",
+		"too_big.number":              "O número deve ser no máximo {maximum}",
+		"too_big.number.exclusive":    "O número deve ser menor que {maximum}",
+		"too_big.array":               "A lista deve conter no máximo {maximum} elemento(s)",
+		"too_big.date":                "A data deve ser igual ou anterior a {maximum}",
+		"too_big.date.exclusive":      "A data deve ser anterior a {maximum}",
+		"too_big.bytes":               "O valor deve ter no máximo {maximum} bytes",
+		"too_big.age":                 "A idade deve ser de no máximo {maximum} anos",
+		"too_big.username":            "O nome de usuário deve ter no máximo {maximum} caracteres",
+		"invalid_length":              "Comprimento esperado {length}, recebido {received}",
+		"invalid_length.string":       "O texto deve ter exatamente {length} caracteres",
+		"invalid_length.bytes":        "O valor deve ter exatamente {length} bytes",
+		"empty":                       "O texto não deve estar vazio",
+		"invalid_string":              "Texto inválido",
+		"invalid_string.email":        "Formato de e-mail inválido",
+		"invalid_string.email.plus":   "O e-mail não deve usar endereçamento com +",
+		"invalid_string.email.local":  "A parte local do e-mail deve ter no máximo {maximum} caracteres",
+		"invalid_string.email.mx":     "O domínio do e-mail não aceita mensagens",
+		"invalid_string.url":          "Formato de URL inválido",
+		"invalid_string.uuid":         "Formato de UUID inválido",
+		"invalid_string.domain":       "Nome de domínio inválido",
+		"invalid_string.ip":           "Endereço IP inválido",
+		"invalid_string.ipv4":         "Endereço IPv4 inválido",
+		"invalid_string.ipv6":         "Endereço IPv6 inválido",
+		"invalid_string.cidr":         "Bloco CIDR inválido",
+		"invalid_string.cidrv4":       "Bloco CIDR IPv4 inválido",
+		"invalid_string.cidrv6":       "Bloco CIDR IPv6 inválido",
+		"invalid_string.in_cidr":      "O endereço IP deve estar dentro de {networks}",
+		"invalid_string.base64":       "Texto base64 inválido",
+		"invalid_string.base64url":    "Texto base64url inválido",
+		"invalid_string.base32":       "Texto base32 inválido",
+		"invalid_string.data_uri":     "URI de dados inválida",
+		"invalid_string.hex":          "Texto hexadecimal inválido",
+		"invalid_string.utf8":         "Texto UTF-8 inválido",
+		"invalid_string.cuid":         "Formato de CUID inválido",
+		"invalid_string.cuid2":        "Formato de CUID2 inválido",
+		"invalid_string.ulid":         "Formato de ULID inválido",
+		"invalid_string.nanoid":       "Formato de Nanoid inválido",
+		"invalid_string.objectid":     "Formato de ObjectID inválido",
+		"invalid_string.git_sha":      "SHA do Git inválido",
+		"invalid_string.cron":         "Expressão cron inválida",
+		"invalid_string.iban":         "IBAN inválido",
+		"invalid_string.bic":          "BIC inválido",
+		"invalid_string.isbn":         "ISBN inválido",
+		"invalid_string.ean":          "Código de barras EAN inválido",
+		"invalid_string.language_tag": "Etiqueta de idioma inválida",
+		"invalid_string.css_color":    "Cor CSS inválida",
+		"invalid_string.regex":        "O texto não corresponde ao padrão exigido",
+		"invalid_string.starts_with":  "O texto deve começar com '{prefix}'",
+		"invalid_string.ends_with":    "O texto deve terminar com '{suffix}'",
+		"invalid_string.includes":     "O texto deve conter '{substring}'",
+		"invalid_string.not_regex":    "O texto corresponde a um padrão proibido",
+		"invalid_string.not_prefix":   "O texto não deve começar com '{prefix}'",
+		"invalid_string.not_includes": "O texto não deve conter '{substring}'",
+		"invalid_string.no_html":      "O texto não deve conter HTML",
+		"invalid_string.ascii":        "O texto deve conter apenas caracteres ASCII",
+		"invalid_string.printable":    "O texto deve conter apenas caracteres imprimíveis",
+		"invalid_string.alpha":        "O texto deve conter apenas letras",
+		"invalid_string.alphanumeric": "O texto deve conter apenas letras e dígitos",
+		"invalid_string.emoji":        "O texto deve conter apenas emojis",
+		"invalid_string.no_emoji":     "O texto não deve conter emojis",
+		"invalid_string.lowercase":    "O texto deve estar em minúsculas",
+		"invalid_string.uppercase":    "O texto deve estar em maiúsculas",
+		"not_integer":                 "O número deve ser um inteiro",
+		"not_finite":                  "O número deve ser finito",
+		"not_positive":                "O número deve ser positivo",
+		"not_negative":                "O número deve ser negativo",
+		"not_nonnegative":             "O número não deve ser negativo",
+		"not_nonpositive":             "O número não deve ser positivo",
+		"unsafe_integer":              "O número deve estar dentro do intervalo de inteiros seguros",
+		"not_multiple_of":             "O número deve ser múltiplo de {multipleOf}",
+		"invalid_date":                "Data inválida",
+		"invalid_date.date_only":      "A data deve ser uma data de calendário (AAAA-MM-DD) sem hora",
+		"invalid_date.utc":            "A data deve estar em UTC",
+		"not_future":                  "A data deve estar no futuro",
+		"not_past":                    "A data deve estar no passado",
+		"invalid_enum_value":          "Valor inválido. Esperado um de: {options}, recebido: {received}",
+		"invalid_literal":             "Valor literal inválido. Esperado {expected}, recebido {received}",
+		"unrecognized_keys":           "Campo desconhecido",
+		"unrecognized_keys.typo":      "Campo desconhecido '{key}', você quis dizer '{suggestion}'?",
+		"invalid_key":                 "Chave de registro inválida",
+		"invalid_union":               "O valor não corresponde a nenhum tipo da união",
+		"invalid_phone":               "Número de telefone inválido para o país {country}",
+		"invalid_string.country":      "Código de país inválido",
+		"invalid_string.currency":     "Código de moeda inválido",
+		"weak_password":               "A senha é muito fraca",
+		"weak_password.uppercase":     "A senha deve conter uma letra maiúscula",
+		"weak_password.lowercase":     "A senha deve conter uma letra minúscula",
+		"weak_password.digit":         "A senha deve conter um dígito",
+		"weak_password.symbol":        "A senha deve conter um símbolo",
+		"weak_password.repeats":       "A senha não deve repetir um caractere mais de {maximum} vezes seguidas",
+		"weak_password.banned":        "A senha é muito comum",
+		"invalid_username":            "Nome de usuário inválido",
+		"invalid_username.chars":      "O nome de usuário só pode conter letras, dígitos e {allowed}",
+		"invalid_username.alnum":      "O nome de usuário só pode conter letras e dígitos",
+		"invalid_username.digit":      "O nome de usuário não deve começar com um dígito",
+		"invalid_username.reserved":   "O nome de usuário está reservado",
+		"unsupported_version":         "Versão de API não suportada '{version}'; versões suportadas: {supported}",
+		"unsupported_version.missing": "Versão de API ausente; versões suportadas: {supported}",
+		"too_deep":                    "Profundidade máxima de aninhamento de {maximum} excedida",
+		"canceled":                    "Validação cancelada: {reason}",
+		"check_failed":                "A verificação de validação falhou: {reason}",
+		"invalid_json":                "O corpo da requisição não é um JSON válido",
+		"payload_too_large":           "O corpo da requisição deve ter no máximo {maximum} bytes",
+		"unreadable_body":             "Não foi possível ler o corpo da requisição",
+		"unsupported_media_type":      "Tipo de mídia não suportado {type}; esperado JSON",
+		"required_with":               "Obrigatório quando {others} está presente",
+		"required_without":            "Obrigatório quando {others} está ausente",
+		"mutually_exclusive":          "Apenas um de {fields} pode ser definido",
+		"invalid_union_discriminator": "Valor discriminador inválido. Esperado {options}",
+		"invalid_key_order":           "As chaves devem aparecer na ordem: {order}",
+		"duplicate_key":               "Chave duplicada '{key}'",
+		"ambiguous_union":             "O valor corresponde a mais de um tipo da união",
+		"not_business_hours":          "A data deve estar dentro do horário comercial",
+		"holiday":                     "A data não deve ser um feriado",
+		"not_weekday":                 "A data deve cair em {weekdays}",
+		"invalid_intersection_types":  "Os resultados da interseção não puderam ser combinados",
+		"not_within_last":             "A data deve estar dentro dos últimos {duration}",
+		"not_within_next":             "A data deve estar dentro dos próximos {duration}",
+		"quota_exceeded":              "A validação excedeu sua cota de {limit} {resource}",
+		"refinement_panic":            "A verificação de validação entrou em pânico: {reason}",
+		"invalid_decimal":             "Número decimal inválido",
+		"decimal_precision":           "O decimal deve ter no máximo {precision} dígitos, {scale} deles após a vírgula decimal",
+		"custom":                      "Valor inválido",
+	})
+}
@@ -0,0 +1,128 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"testing"
+)
+
+// Test translating a coded error into a bundled locale
+func TestTranslateBundledLocale(t *testing.T) {
+	err := ValidationError{
+		Path:    "name",
+		Message: "String must be at least 3 characters",
+		Code:    "too_small",
+		Params:  map[string]any{"type": "string", "minimum": 3},
+	}
+
+	if got := err.Translate("es"); got != "El texto debe tener al menos 3 caracteres" {
+		t.Errorf("Unexpected Spanish message: %s", got)
+	}
+
+	if got := err.Translate("de"); got != "Der Text muss mindestens 3 Zeichen lang sein" {
+		t.Errorf("Unexpected German message: %s", got)
+	}
+}
+
+// Test region tags fall back to the base language
+func TestTranslateRegionFallback(t *testing.T) {
+	err := FailureTypeMismatch("string", 42).Errors[0]
+
+	if got := err.Translate("pt-BR"); got != "Esperado string, recebido number" {
+		t.Errorf("Expected pt-BR to fall back to pt, got %s", got)
+	}
+
+	if got := err.Translate("fr_CA"); got != "string attendu, number reçu" {
+		t.Errorf("Expected fr_CA to fall back to fr, got %s", got)
+	}
+}
+
+// Test ValidationErrors.Localize translates every error
+func TestValidationErrorsLocalize(t *testing.T) {
+	errors := ValidationErrors{
+		{Path: "email", Message: "Invalid email format", Code: "invalid_string.email"},
+		{Path: "age", Message: "Number must be positive", Code: "not_positive"},
+	}
+
+	localized := errors.Localize("fr")
+	if localized[0].Message != "Format d'adresse e-mail invalide" {
+		t.Errorf("Unexpected message: %s", localized[0].Message)
+	}
+	if localized[1].Message != "Le nombre doit être positif" {
+		t.Errorf("Unexpected message: %s", localized[1].Message)
+	}
+
+	// Original errors are not modified
+	if errors[0].Message != "Invalid email format" {
+		t.Error("Expected Localize to return a copy")
+	}
+}
+
+// Test Localized wrapper translates errors from Parse, including nested paths
+func TestLocalizedValidator(t *testing.T) {
+	nested := Localized(testTypeMismatchValidator{}, "es").Parse(1)
+	if nested.Errors[0].Message != "Se esperaba string, se recibió number" {
+		t.Errorf("Unexpected message: %s", nested.Errors[0].Message)
+	}
+
+	inObject := Localized(Object(Schema{"name": testTypeMismatchValidator{}}), "es").Parse(map[string]interface{}{"name": 1})
+	if inObject.Errors[0].Path != "name" || inObject.Errors[0].Message != "Se esperaba string, se recibió number" {
+		t.Errorf("Unexpected nested error: %+v", inObject.Errors[0])
+	}
+}
+
+// Test the messages of real rule failures are translated, not only
+// hand-built errors
+func TestLocalizeRuleErrors(t *testing.T) {
+	schema := Localized(Object(Schema{
+		"name":  String().Min(3),
+		"email": String().Email(),
+		"age":   Number().Int(),
+		"tags":  Array(String()).Max(1),
+		"born":  Date().Past(),
+	}), "es")
+
+	result := schema.Parse(map[string]interface{}{
+		"name":  "a",
+		"email": "x",
+		"age":   1.5,
+		"tags":  []interface{}{"a", "b"},
+		"born":  "2999-01-01T00:00:00Z",
+	})
+
+	expected := map[string]string{
+		"name":  "El texto debe tener al menos 3 caracteres",
+		"email": "Formato de correo electrónico no válido",
+		"age":   "El número debe ser entero",
+		"tags":  "La lista debe contener como máximo 1 elemento(s)",
+		"born":  "La fecha debe estar en el pasado",
+	}
+	if len(result.Errors) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), result.Errors)
+	}
+	for _, err := range result.Errors {
+		if err.Message != expected[err.Path] {
+			t.Errorf("Expected %s to be translated to %q, got %q", err.Path, expected[err.Path], err.Message)
+		}
+	}
+}
+
+// Test every exported code is translated in the bundled locales
+func TestBundledLocalesCoverAllCodes(t *testing.T) {
+	for _, lang := range []string{"es", "fr", "de", "pt"} {
+		for _, code := range allCodes {
+			if _, ok := locales[lang][code]; !ok {
+				t.Errorf("Missing %s template for code %q", lang, code)
+			}
+		}
+	}
+}
+
+// Test exclusive bounds use their own templates
+func TestTranslateExclusiveBound(t *testing.T) {
+	err := Number().Gt(0).Parse(0).Errors[0]
+
+	if got := err.Translate("es"); got != "El número debe ser mayor que 0" {
+		t.Errorf("Unexpected Spanish message: %s", got)
+	}
+}
@@ -0,0 +1,68 @@
+package zogo
+
+import (
+	"testing"
+)
+
+// Test errors without a code or translation keep their message
+func TestTranslateFallbackToMessage(t *testing.T) {
+	uncoded := ValidationError{Message: "Something went wrong"}
+	if got := uncoded.Translate("es"); got != "Something went wrong" {
+		t.Errorf("Expected original message, got %s", got)
+	}
+
+	unknownLocale := ValidationError{Message: "Invalid email format", Code: "invalid_string.email"}
+	if got := unknownLocale.Translate("xx"); got != "Invalid email format" {
+		t.Errorf("Expected original message for unknown locale, got %s", got)
+	}
+}
+
+// Test registering a custom locale and overriding a bundled message
+func TestRegisterLocale(t *testing.T) {
+	RegisterLocale("it", Messages{
+		"too_small.string": "{field} deve avere almeno {minimum} caratteri",
+	})
+
+	err := ValidationError{
+		Path:   "username",
+		Code:   "too_small",
+		Params: map[string]any{"type": "string", "minimum": 4},
+	}
+
+	if got := err.Translate("it"); got != "username deve avere almeno 4 caratteri" {
+		t.Errorf("Unexpected Italian message: %s", got)
+	}
+
+	// Overriding merges with the existing templates
+	RegisterLocale("it", Messages{"custom": "Valore non valido"})
+	if got := err.Translate("it"); got != "username deve avere almeno 4 caratteri" {
+		t.Errorf("Expected earlier template to survive merge, got %s", got)
+	}
+
+	found := false
+	for _, lang := range Locales() {
+		if lang == "it" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected 'it' to be listed in Locales()")
+	}
+}
+
+// Test unknown placeholders are left untouched
+func TestTranslateUnknownPlaceholder(t *testing.T) {
+	RegisterLocale("x-test", Messages{"custom": "{missing} and {field}"})
+
+	err := ValidationError{Path: "a.b", Code: "custom"}
+	if got := err.Translate("x-test"); got != "{missing} and a.b" {
+		t.Errorf("Unexpected interpolation: %s", got)
+	}
+}
+
+// testTypeMismatchValidator always fails with a coded type mismatch
+type testTypeMismatchValidator struct{}
+
+func (testTypeMismatchValidator) Parse(value any) ParseResult {
+	return FailureTypeMismatch("string", value)
+}
@@ -0,0 +1,132 @@
+package zogo
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// toASCIIDomain converts an internationalized domain name to its ASCII
+// (punycode) form, e.g. "Bücher.de" -> "xn--bcher-kva.de", the way it is
+// looked up: UTS 46 mapping folds case and width, so "Ünicode.example"
+// and fullwidth labels are accepted, then the bidi, contextual joiner and
+// hyphen rules of IDNA 2008 apply.
+func toASCIIDomain(domain string) (string, bool) {
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", false
+	}
+	return ascii, true
+}
+
+// isASCII reports whether s contains only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidDomain checks if string is a valid (ASCII) domain name
+// Labels are 1-63 letters, digits or hyphens, may not start or end with a hyphen,
+// and the top-level label is alphabetic or punycode ("xn--...")
+func isValidDomain(domain string) bool {
+	if len(domain) == 0 || len(domain) > 253 {
+		return false
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 {
+			return false
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			ch := label[i]
+			if !((ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9') || ch == '-') {
+				return false
+			}
+		}
+	}
+
+	tld := strings.ToLower(labels[len(labels)-1])
+	if strings.HasPrefix(tld, "xn--") {
+		return len(tld) > 4
+	}
+	if len(tld) < 2 {
+		return false
+	}
+	for i := 0; i < len(tld); i++ {
+		if tld[i] < 'a' || tld[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidIDNDomain checks a domain that may contain Unicode labels
+func isValidIDNDomain(domain string) bool {
+	ascii, ok := toASCIIDomain(domain)
+	return ok && isValidDomain(ascii)
+}
+
+// isValidIDNEmail checks an email address whose local part and domain may
+// contain Unicode characters (RFC 6531 / EAI)
+func isValidIDNEmail(email string) bool {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 || at == len(email)-1 {
+		return false
+	}
+
+	local, domain := email[:at], email[at+1:]
+	if utf8.RuneCountInString(local) > 64 {
+		return false
+	}
+	for _, r := range local {
+		if r < utf8.RuneSelf {
+			if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+				r == '.' || r == '_' || r == '%' || r == '+' || r == '-') {
+				return false
+			}
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) {
+			return false
+		}
+	}
+
+	return isValidIDNDomain(domain)
+}
+
+// isValidIDNURL checks a URL whose host may contain Unicode labels. The URL
+// is parsed with net/url, so userinfo such as "user@" and IPv6 literals
+// such as "[::1]" are not mistaken for the host name.
+func isValidIDNURL(str string) bool {
+	u, err := url.Parse(str)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	if host := u.Hostname(); !strings.HasPrefix(u.Host, "[") {
+		ascii, ok := toASCIIDomain(host)
+		if !ok {
+			return false
+		}
+		u.Host = ascii
+		if port := u.Port(); port != "" {
+			u.Host += ":" + port
+		}
+	}
+	return isValidURL(u.String())
+}
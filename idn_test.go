@@ -0,0 +1,125 @@
+package zogo
+
+import "testing"
+
+// Test domain conversion to ASCII
+func TestToASCIIDomain(t *testing.T) {
+	tests := map[string]string{
+		"Bücher.DE":       "xn--bcher-kva.de",
+		"münchen.de":      "xn--mnchen-3ya.de",
+		"例え.jp":           "xn--r8jz45g.jp",
+		"παράδειγμα.gr":   "xn--hxajbheg2az3al.gr",
+		"Ünicode.example": "xn--nicode-2ya.example",
+		"ｅｘａｍｐｌｅ．ｃｏｍ":     "example.com",
+	}
+	for input, expected := range tests {
+		if got, ok := toASCIIDomain(input); !ok || got != expected {
+			t.Errorf("toASCIIDomain(%q) = %q, want %q", input, got, expected)
+		}
+	}
+
+	invalid := []string{
+		"-bücher.de",
+		"bücher-.de",
+		"\u05d0a.example",  // Bidi rule: a right-to-left label with a Latin letter
+		"a\u200db.example", // Zero width joiner outside its context
+	}
+	for _, domain := range invalid {
+		if got, ok := toASCIIDomain(domain); ok {
+			t.Errorf("Expected %q to be rejected, got %q", domain, got)
+		}
+	}
+}
+
+// Test Domain validation
+func TestStringDomain(t *testing.T) {
+	schema := String().Domain()
+
+	valid := []string{"example.com", "sub.example.co.uk", "xn--bcher-kva.de", "a-b.io"}
+	for _, domain := range valid {
+		if !schema.Parse(domain).Ok {
+			t.Errorf("Expected %q to be a valid domain", domain)
+		}
+	}
+
+	invalid := []string{"localhost", "-example.com", "example-.com", "exa mple.com", "example.c", "bücher.de", "example..com"}
+	for _, domain := range invalid {
+		if schema.Parse(domain).Ok {
+			t.Errorf("Expected %q to be an invalid domain", domain)
+		}
+	}
+}
+
+// Test AllowIDN on Email
+func TestStringEmailAllowIDN(t *testing.T) {
+	// ASCII-only by default
+	if String().Email().Parse("user@bücher.de").Ok {
+		t.Error("Expected IDN email to fail without AllowIDN()")
+	}
+
+	schema := String().Email().AllowIDN()
+	valid := []string{"user@bücher.de", "用户@例子.广告", "josé@example.com", "user@example.com"}
+	for _, email := range valid {
+		result := schema.Parse(email)
+		if !result.Ok {
+			t.Errorf("Expected %q to pass with AllowIDN(): %v", email, result.Errors)
+		}
+		if result.Value != email {
+			t.Errorf("Expected original value to be preserved, got %v", result.Value)
+		}
+	}
+
+	invalid := []string{"user@bücher", "@bücher.de", "us er@bücher.de", "user@-bücher.de"}
+	for _, email := range invalid {
+		if schema.Parse(email).Ok {
+			t.Errorf("Expected %q to fail", email)
+		}
+	}
+}
+
+// Test AllowIDN on URL and Domain
+func TestStringURLAndDomainAllowIDN(t *testing.T) {
+	if String().URL().Parse("https://bücher.de/path").Ok {
+		t.Error("Expected IDN URL to fail without AllowIDN()")
+	}
+
+	if !String().URL().AllowIDN().Parse("https://bücher.de:8080/path?q=1").Ok {
+		t.Error("Expected IDN URL to pass with AllowIDN()")
+	}
+
+	if !String().Domain().AllowIDN().Parse("münchen.de").Ok {
+		t.Error("Expected IDN domain to pass with AllowIDN()")
+	}
+
+	url := String().URL().AllowIDN()
+	valid := []string{
+		"https://user:pass@bücher.de/path",
+		"https://user@bücher.de:8443",
+		"http://[::1]:8080/bücher",
+		"https://[2001:db8::1]/straße",
+		"https://Ünicode.example/",
+		"https://ｅｘａｍｐｌｅ.com/ü",
+	}
+	for _, str := range valid {
+		if result := url.Parse(str); !result.Ok || result.Value != str {
+			t.Errorf("Expected %q to pass unchanged with AllowIDN(), got %v", str, result)
+		}
+	}
+
+	invalid := []string{
+		"https://user@-bücher.de/",
+		"https://bücher.de:port/",
+		"https://[::1/ü",
+		"bücher.de/path",
+		"ftp://bücher.de/",
+	}
+	for _, str := range invalid {
+		if url.Parse(str).Ok {
+			t.Errorf("Expected %q to fail with AllowIDN()", str)
+		}
+	}
+
+	if !String().Domain().AllowIDN().Parse("ｅｘａｍｐｌｅ．ｃｏｍ").Ok {
+		t.Error("Expected a fullwidth domain to be mapped with AllowIDN()")
+	}
+}
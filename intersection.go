@@ -12,9 +12,16 @@ type IntersectionValidator struct {
 	isRequired bool
 	isOptional bool
 	isNullable bool
+
+	// Custom validators
+	refinements []refinement
 }
 
-// Intersection creates a new intersection validator with the given validators
+// Intersection creates a new intersection validator with the given validators.
+// Objects are validated by every member and the members' results are deep
+// merged, so Intersection(base, extension) keeps the fields of both, like
+// Zod's and(). Other values pass through the members in order, each member
+// validating the result of the one before.
 func Intersection(validators ...Validator) *IntersectionValidator {
 	return &IntersectionValidator{
 		validators: validators,
@@ -41,35 +48,60 @@ func (v *IntersectionValidator) Nullable() *IntersectionValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *IntersectionValidator) Nullish() *IntersectionValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Refine adds custom validation logic on the merged value. It runs after
+// all members pass.
+func (v *IntersectionValidator) Refine(check func(any) bool, message string) *IntersectionValidator {
+	v.refinements = append(v.refinements, refinement{check: check, message: message})
+	return v
+}
+
 // Parse validates the input value against all intersection members
 func (v *IntersectionValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *IntersectionValidator) parseWithState(value any, st *parseState) ParseResult {
 	// Handle nil values based on modifiers
 	if value == nil {
 		if v.isOptional || v.isNullable {
 			return Success(nil)
 		}
 		if v.isRequired {
-			return FailureMessage("Expected value, received null")
+			return FailureTypeMismatch("value", nil)
 		}
 	}
 
+	if _, ok := value.(map[string]interface{}); ok {
+		return v.parseObject(value, st)
+	}
+
 	var allErrors ValidationErrors
 
 	// Start with the original value
 	currentValue := value
 
 	for i, validator := range v.validators {
+		if st.stopped() {
+			break
+		}
+
 		// Validate against the current value (which may have been transformed by previous steps)
-		result := validator.Parse(currentValue)
+		result := st.parse(validator, currentValue)
 
 		if !result.Ok {
 			// If validation fails, collect errors
 			for _, err := range result.Errors {
-				allErrors = append(allErrors, ValidationError{
-					Path:    err.Path,
-					Message: fmt.Sprintf("Intersection validator %d: %s", i+1, err.Message),
-					Value:   err.Value,
-				})
+				err.Message = fmt.Sprintf("Intersection validator %d: %s", i+1, err.Message)
+				allErrors = append(allErrors, err)
 			}
 		} else {
 			// If validation succeeds, update currentValue to the transformed result
@@ -84,5 +116,103 @@ func (v *IntersectionValidator) Parse(value any) ParseResult {
 	}
 
 	// Return the final transformed value
-	return Success(currentValue)
+	return refine(v.refinements, Success(currentValue))
+}
+
+// parseObject validates an object against every member and merges the results
+func (v *IntersectionValidator) parseObject(value any, st *parseState) ParseResult {
+	var allErrors ValidationErrors
+	var merged any
+	first := true
+
+	for i, validator := range v.validators {
+		if st.stopped() {
+			break
+		}
+
+		// Each member sees the original object, so fields stripped by one
+		// member are still validated by the others
+		result := st.parse(validator, value)
+		if !result.Ok {
+			for _, err := range result.Errors {
+				err.Message = fmt.Sprintf("Intersection validator %d: %s", i+1, err.Message)
+				allErrors = append(allErrors, err)
+			}
+			continue
+		}
+
+		if first || len(allErrors) > 0 {
+			merged, first = result.Value, false
+			continue
+		}
+		var conflict string
+		var ok bool
+		if merged, conflict, ok = mergeValues(merged, result.Value, ""); !ok {
+			allErrors = append(allErrors, ValidationError{
+				Path:    conflict,
+				Message: "Intersection results could not be merged",
+				Code:    CodeInvalidIntersection,
+			})
+			st.errors++
+		}
+	}
+
+	if len(allErrors) > 0 {
+		return Failure(allErrors...)
+	}
+	return refine(v.refinements, Success(merged))
+}
+
+// mergeValues deep merges two results for the same input: objects get the
+// fields of both, arrays of the same length are merged item by item, and
+// other values must be equal. It returns the path of the first conflict if
+// the results cannot be merged.
+func mergeValues(a, b any, path string) (any, string, bool) {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		merged := make(map[string]interface{}, len(a)+len(b))
+		for key, value := range a {
+			merged[key] = value
+		}
+		for key, value := range b {
+			existing, ok := merged[key]
+			if !ok {
+				merged[key] = value
+				continue
+			}
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			field, conflict, ok := mergeValues(existing, value, fieldPath)
+			if !ok {
+				return nil, conflict, false
+			}
+			merged[key] = field
+		}
+		return merged, "", true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			break
+		}
+		merged := make([]interface{}, len(a))
+		for i := range a {
+			item, conflict, ok := mergeValues(a[i], b[i], fmt.Sprintf("%s[%d]", path, i))
+			if !ok {
+				return nil, conflict, false
+			}
+			merged[i] = item
+		}
+		return merged, "", true
+	}
+
+	if deepEqual(a, b) {
+		return a, "", true
+	}
+	return nil, path, false
 }
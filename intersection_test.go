@@ -453,3 +453,60 @@ func TestIntersectionMergeObjects(t *testing.T) {
 		t.Error("Expected object without name to fail")
 	}
 }
+
+// Test object results are deep merged without Passthrough
+func TestIntersectionDeepMerge(t *testing.T) {
+	base := Object(Schema{
+		"name":    String().Trim(),
+		"address": Object(Schema{"city": String()}),
+		"tags":    Array(Object(Schema{"id": Number()})),
+	})
+	extension := Object(Schema{
+		"email":   String().Email(),
+		"address": Object(Schema{"zip": String().Length(5)}),
+		"tags":    Array(Object(Schema{"label": String().Optional()})),
+	})
+	schema := Intersection(base, extension)
+
+	result := schema.Parse(map[string]interface{}{
+		"name":    "Ada",
+		"email":   "ada@example.com",
+		"address": map[string]interface{}{"city": "London", "zip": "12345"},
+		"tags":    []interface{}{map[string]interface{}{"id": 1, "label": "vip"}},
+		"extra":   true,
+	})
+	if !result.Ok {
+		t.Fatalf("Expected intersection to pass, got %v", result.Errors)
+	}
+	value := result.Value.(map[string]interface{})
+	address := value["address"].(map[string]interface{})
+	tag := value["tags"].([]interface{})[0].(map[string]interface{})
+	if value["name"] != "Ada" || value["email"] != "ada@example.com" || address["city"] != "London" || address["zip"] != "12345" ||
+		tag["id"] != float64(1) || tag["label"] != "vip" {
+		t.Errorf("Expected merged fields of both schemas, got %v", value)
+	}
+	if _, ok := value["extra"]; ok {
+		t.Error("Expected unknown fields to be stripped")
+	}
+
+	result = schema.Parse(map[string]interface{}{
+		"name":    "Ada",
+		"address": map[string]interface{}{"city": "London"},
+		"tags":    []interface{}{},
+	})
+	if result.Ok || !result.Errors.HasPath("email") || !result.Errors.HasPath("address.zip") {
+		t.Errorf("Expected errors from the extension, got %v", result.Errors)
+	}
+}
+
+// Test conflicting object results fail to merge
+func TestIntersectionMergeConflict(t *testing.T) {
+	schema := Intersection(
+		Object(Schema{"name": String().Trim()}),
+		Object(Schema{"name": String()}),
+	)
+	result := schema.Parse(map[string]interface{}{"name": " Ada "})
+	if result.Ok || len(result.Errors) != 1 || result.Errors[0].Path != "name" || result.Errors[0].Code != CodeInvalidIntersection {
+		t.Errorf("Expected a merge conflict at name, got %v", result.Errors)
+	}
+}
@@ -0,0 +1,55 @@
+package zogo
+
+import "strings"
+
+// isValidISBN checks an ISBN-10 or ISBN-13 with its check digit. Hyphens
+// and spaces between groups, as in "978-3-16-148410-0", are allowed.
+func isValidISBN(s string) bool {
+	isbn := strings.NewReplacer("-", "", " ", "").Replace(s)
+	switch len(isbn) {
+	case 10:
+		return isValidISBN10(isbn)
+	case 13:
+		return (strings.HasPrefix(isbn, "978") || strings.HasPrefix(isbn, "979")) && isValidEAN(isbn)
+	}
+	return false
+}
+
+// isValidISBN10 checks ten characters whose weighted sum (10 down to 1) is
+// a multiple of 11, where a final "X" stands for 10
+func isValidISBN10(isbn string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := isbn[i]
+		var digit int
+		switch {
+		case c >= '0' && c <= '9':
+			digit = int(c - '0')
+		case c == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+		sum += digit * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+// isValidEAN checks an EAN-8 or EAN-13 barcode number with its check digit
+func isValidEAN(s string) bool {
+	if (len(s) != 8 && len(s) != 13) || !isDigits(s) {
+		return false
+	}
+
+	// Weigh digits 3 and 1 alternately from the right, including the check
+	// digit with weight 1, and require a multiple of 10
+	sum := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		digit := int(s[i] - '0')
+		if (len(s)-1-i)%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,56 @@
+package zogo
+
+import "testing"
+
+// Test ISBN validation
+func TestStringISBN(t *testing.T) {
+	schema := String().ISBN()
+
+	valid := []string{
+		"0306406152",
+		"0-306-40615-2",
+		"080442957X",
+		"9780306406157",
+		"978-3-16-148410-0",
+		"979 10 90636 07 1",
+	}
+	for _, isbn := range valid {
+		if result := schema.Parse(isbn); !result.Ok {
+			t.Errorf("Expected valid ISBN %q to pass, got %v", isbn, result.Errors)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"0306406153",    // Wrong check digit
+		"9780306406158", // Wrong check digit
+		"X306406152",    // X before the check digit
+		"080442957x",    // Lower case X
+		"4006381333931", // Valid EAN, not an ISBN
+		"030640615",
+		"978-3-16-148410-0-1",
+	}
+	for _, isbn := range invalid {
+		result := schema.Parse(isbn)
+		if result.Ok || result.Errors[0].Code != CodeInvalidISBN {
+			t.Errorf("Expected invalid ISBN %q to fail with invalid_string.isbn", isbn)
+		}
+	}
+}
+
+// Test EAN validation
+func TestStringEAN(t *testing.T) {
+	schema := String().EAN()
+
+	for _, ean := range []string{"4006381333931", "9780306406157", "73513537", "96385074"} {
+		if !schema.Parse(ean).Ok {
+			t.Errorf("Expected valid EAN %q to pass", ean)
+		}
+	}
+
+	for _, ean := range []string{"", "4006381333932", "73513536", "400638133393", "4006-381333931", "400638133393a"} {
+		if schema.Parse(ean).Ok {
+			t.Errorf("Expected invalid EAN %q to fail", ean)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package zogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// ParseJSON decodes a JSON document and validates it. Unlike decoding with
+// encoding/json first, the decoder keeps track of where each key appears,
+// which enables checks on the raw document such as RequireKeyOrder.
+// Invalid JSON fails with a CodeInvalidJSON error, and with WithStrictJSON
+// objects with duplicate keys fail with CodeDuplicateKey errors.
+//
+//	result := zogo.ParseJSON(r.Context(), schema, body)
+func ParseJSON(ctx context.Context, validator Validator, data []byte, opts ...ParseOption) ParseResult {
+	var options ParseOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	value, keyOrder, duplicates, err := decodeJSON(data)
+	if err != nil {
+		return invalidJSON(err)
+	}
+	if options.StrictJSON && len(duplicates) > 0 {
+		return Failure(duplicates...)
+	}
+
+	st := newParseState(options)
+	st.ctx = ctx
+	st.keyOrder = keyOrder
+	return st.root(validator, value)
+}
+
+// invalidJSON converts a decoding error into a CodeInvalidJSON failure
+func invalidJSON(err error) ParseResult {
+	params := map[string]any{"reason": err.Error()}
+	var syntax *json.SyntaxError
+	if errors.As(err, &syntax) {
+		params["offset"] = syntax.Offset
+	}
+	return Failure(ValidationError{
+		Message: "Invalid JSON: " + err.Error(),
+		Code:    CodeInvalidJSON,
+		Params:  params,
+		Cause:   err,
+	})
+}
+
+// Tokens replacing the numbers JSON cannot represent
+const (
+	NaNToken         = "NaN"
+	InfinityToken    = "Infinity"
+	NegInfinityToken = "-Infinity"
+)
+
+// SanitizeJSON returns value in a form encoding/json can marshal, with NaN and
+// infinite numbers replaced by NaNToken, InfinityToken and NegInfinityToken,
+// and the paths of the replaced numbers. Maps and slices holding such numbers
+// are copied; the input is never modified. Values of other types are returned
+// as they are.
+func SanitizeJSON(value any) (any, []string) {
+	var paths []string
+	return sanitizeJSON(value, "", &paths), paths
+}
+
+// MarshalSanitized marshals value to JSON after SanitizeJSON, so that results
+// accepting NaN or infinite numbers can still be written to a response
+func MarshalSanitized(value any) ([]byte, []string, error) {
+	sanitized, paths := SanitizeJSON(value)
+	data, err := json.Marshal(sanitized)
+	return data, paths, err
+}
+
+// sanitizeJSON replaces the non-finite numbers in value, recording their paths
+func sanitizeJSON(value any, path string, paths *[]string) any {
+	switch value := value.(type) {
+	case float64:
+		if token, ok := nonFiniteToken(value); ok {
+			*paths = append(*paths, path)
+			return token
+		}
+	case float32:
+		if token, ok := nonFiniteToken(float64(value)); ok {
+			*paths = append(*paths, path)
+			return token
+		}
+	case map[string]interface{}:
+		var sanitized map[string]interface{}
+		for key, field := range value {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			before := len(*paths)
+			clean := sanitizeJSON(field, fieldPath, paths)
+			if len(*paths) == before {
+				continue
+			}
+			if sanitized == nil {
+				sanitized = make(map[string]interface{}, len(value))
+				for k, v := range value {
+					sanitized[k] = v
+				}
+			}
+			sanitized[key] = clean
+		}
+		if sanitized != nil {
+			return sanitized
+		}
+	case []interface{}:
+		var sanitized []interface{}
+		for i, item := range value {
+			before := len(*paths)
+			clean := sanitizeJSON(item, fmt.Sprintf("%s[%d]", path, i), paths)
+			if len(*paths) == before {
+				continue
+			}
+			if sanitized == nil {
+				sanitized = append([]interface{}(nil), value...)
+			}
+			sanitized[i] = clean
+		}
+		if sanitized != nil {
+			return sanitized
+		}
+	}
+	return value
+}
+
+// nonFiniteToken returns the token for a NaN or infinite number
+func nonFiniteToken(num float64) (string, bool) {
+	switch {
+	case math.IsNaN(num):
+		return NaNToken, true
+	case math.IsInf(num, 1):
+		return InfinityToken, true
+	case math.IsInf(num, -1):
+		return NegInfinityToken, true
+	}
+	return "", false
+}
+
+// nonFinitePaths returns the paths that hold a non-finite number in value,
+// without duplicates. Numbers accepted by a union member that did not match
+// are recorded too, so each path is checked against the final value.
+func nonFinitePaths(value any, recorded []string) []string {
+	var paths []string
+	seen := map[string]bool{}
+	for _, path := range recorded {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		if num, ok := valueAt(value, pathTokens(path)).(float64); ok {
+			if _, ok := nonFiniteToken(num); ok {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// jsonDecoder decodes JSON into the same values as encoding/json, recording
+// the order of each object's keys and any duplicate keys
+type jsonDecoder struct {
+	dec        *json.Decoder
+	keyOrder   keyOrders
+	duplicates ValidationErrors
+	tokens     []pathToken // Segments of the path being decoded
+}
+
+// decodeJSON decodes a single JSON value
+func decodeJSON(data []byte) (any, keyOrders, ValidationErrors, error) {
+	d := &jsonDecoder{
+		dec:      json.NewDecoder(bytes.NewReader(data)),
+		keyOrder: keyOrders{},
+	}
+
+	value, err := d.value("")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if _, err := d.dec.Token(); err != io.EOF {
+		return nil, nil, nil, fmt.Errorf("unexpected data after top-level value at offset %d", d.dec.InputOffset())
+	}
+	return value, d.keyOrder, d.duplicates, nil
+}
+
+// value decodes the value at path
+func (d *jsonDecoder) value(path string) (any, error) {
+	token, err := d.dec.Token()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		return d.object(path)
+	case json.Delim('['):
+		return d.array(path)
+	}
+	return token, nil
+}
+
+// object decodes the rest of an object after its opening brace
+func (d *jsonDecoder) object(path string) (any, error) {
+	object := map[string]interface{}{}
+	var keys []string
+
+	for d.dec.More() {
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := token.(string)
+
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		d.tokens = append(d.tokens, pathToken{name: key})
+		if _, seen := object[key]; seen {
+			d.duplicates = append(d.duplicates, ValidationError{
+				Path:         fieldPath,
+				Message:      "Duplicate key '" + key + "'",
+				Code:         CodeDuplicateKey,
+				Params:       map[string]any{"key": key, "offset": d.dec.InputOffset()},
+				segments:     append([]pathToken(nil), d.tokens...),
+				segmentsPath: fieldPath,
+			})
+		}
+		value, err := d.value(fieldPath)
+		d.tokens = d.tokens[:len(d.tokens)-1]
+		if err != nil {
+			return nil, err
+		}
+		object[key] = value
+		keys = append(keys, key)
+	}
+
+	if _, err := d.dec.Token(); err != nil {
+		return nil, err
+	}
+	d.keyOrder[path] = keys
+	return object, nil
+}
+
+// array decodes the rest of an array after its opening bracket
+func (d *jsonDecoder) array(path string) (any, error) {
+	array := []interface{}{}
+	for i := 0; d.dec.More(); i++ {
+		d.tokens = append(d.tokens, indexToken(i))
+		value, err := d.value(fmt.Sprintf("%s[%d]", path, i))
+		d.tokens = d.tokens[:len(d.tokens)-1]
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, value)
+	}
+
+	if _, err := d.dec.Token(); err != nil {
+		return nil, err
+	}
+	return array, nil
+}
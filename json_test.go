@@ -0,0 +1,158 @@
+package zogo
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+// Test ParseJSON decodes the same values as encoding/json
+func TestParseJSON(t *testing.T) {
+	schema := Object(Schema{
+		"name":  String(),
+		"age":   Number().Int(),
+		"tags":  Array(String()),
+		"admin": Boolean(),
+		"bio":   String().Nullable(),
+	})
+
+	result := ParseJSON(context.Background(), schema, []byte(`{"name":"Ada","age":36,"tags":["math"],"admin":true,"bio":null}`))
+	if !result.Ok {
+		t.Fatalf("Expected valid JSON to pass: %v", result.Errors)
+	}
+	value := result.Value.(map[string]interface{})
+	if value["age"] != float64(36) || value["tags"].([]interface{})[0] != "math" || value["admin"] != true {
+		t.Errorf("Unexpected decoded value: %v", value)
+	}
+
+	result = ParseJSON(context.Background(), schema, []byte(`{"name":"Ada","age":"36"}`))
+	if !result.Errors.HasPath("age") || !result.Errors.HasPath("tags") {
+		t.Errorf("Expected field errors, got %v", result.Errors)
+	}
+}
+
+// Test invalid JSON fails with the invalid_json code
+func TestParseJSONInvalid(t *testing.T) {
+	for _, input := range []string{``, `{"name":`, `{"name" "Ada"}`, `{} {}`, `[1,]`} {
+		result := ParseJSON(context.Background(), Any(), []byte(input))
+		if result.Ok || result.Errors[0].Code != CodeInvalidJSON || result.Errors[0].Cause == nil {
+			t.Errorf("Expected invalid_json for %q, got %v", input, result.Errors)
+		}
+	}
+}
+
+// Test RequireKeyOrder checks the key order of the raw JSON
+func TestRequireKeyOrder(t *testing.T) {
+	callback := Object(Schema{
+		"amount":    String(),
+		"currency":  String(),
+		"reference": String(),
+		"note":      String().Optional(),
+	}).RequireKeyOrder([]string{"amount", "currency", "reference"}).Passthrough()
+	schema := Object(Schema{"payments": Array(callback)})
+
+	valid := `{"payments":[{"amount":"10.00","note":"x","currency":"EUR","reference":"R1"}]}`
+	if result := ParseJSON(context.Background(), schema, []byte(valid)); !result.Ok {
+		t.Errorf("Expected ordered keys to pass: %v", result.Errors)
+	}
+
+	invalid := `{"payments":[{"amount":"10.00","currency":"EUR","reference":"R1"},{"currency":"EUR","amount":"10.00","reference":"R2"}]}`
+	result := ParseJSON(context.Background(), schema, []byte(invalid))
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected one key order error, got %v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Path != "payments[1].currency" || err.Code != CodeInvalidKeyOrder || err.Params["order"] != "amount, currency, reference" {
+		t.Errorf("Unexpected key order error: %+v", err)
+	}
+
+	// Maps have no key order, so Parse doesn't check it
+	if result := callback.Parse(map[string]interface{}{"currency": "EUR", "amount": "1", "reference": "R"}); !result.Ok {
+		t.Errorf("Expected Parse to skip the key order check: %v", result.Errors)
+	}
+}
+
+// Test strict mode rejects duplicate keys with their paths
+func TestParseJSONDuplicateKeys(t *testing.T) {
+	schema := Object(Schema{"role": String(), "items": Array(Any())})
+	input := []byte(`{"role":"user","items":[{"id":1,"id":2}],"role":"admin"}`)
+
+	// encoding/json semantics: the last value wins
+	result := ParseJSON(context.Background(), schema, input)
+	if !result.Ok || result.Value.(map[string]interface{})["role"] != "admin" {
+		t.Errorf("Expected last value to win without strict mode, got %v %v", result.Value, result.Errors)
+	}
+
+	result = ParseJSON(context.Background(), schema, input, WithStrictJSON())
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 duplicate key errors, got %v", result.Errors)
+	}
+	if err := result.Errors[0]; err.Path != "items[0].id" || err.Code != CodeDuplicateKey || err.Message != "Duplicate key 'id'" {
+		t.Errorf("Unexpected nested duplicate error: %+v", err)
+	}
+	if err := result.Errors[1]; err.Path != "role" || err.Params["key"] != "role" {
+		t.Errorf("Unexpected duplicate error: %+v", err)
+	}
+}
+
+// Test non-finite numbers are flagged in Meta and replaced by tokens for JSON
+func TestSanitizeJSON(t *testing.T) {
+	schema := Object(Schema{
+		"score":   Number(),
+		"history": Array(Number()),
+		"label":   Union(Number().Finite(), Number()),
+	})
+	result := schema.Parse(map[string]interface{}{
+		"score":   math.NaN(),
+		"history": []interface{}{1.5, math.Inf(-1)},
+		"label":   math.Inf(1),
+	})
+	if !result.Ok {
+		t.Fatalf("Expected non-finite numbers to be accepted, got %v", result.Errors)
+	}
+	flagged := map[string]bool{}
+	for _, path := range result.Meta.NonFinite {
+		flagged[path] = true
+	}
+	if len(result.Meta.NonFinite) != 3 || !flagged["score"] || !flagged["history[1]"] || !flagged["label"] {
+		t.Errorf("Unexpected non-finite paths: %v", result.Meta.NonFinite)
+	}
+
+	if _, err := json.Marshal(result.Value); err == nil {
+		t.Fatal("Expected encoding/json to reject NaN")
+	}
+	data, paths, err := MarshalSanitized(result.Value)
+	if err != nil || len(paths) != 3 {
+		t.Fatalf("Expected sanitized marshalling to succeed, got %v %v", paths, err)
+	}
+	var decoded map[string]interface{}
+	json.Unmarshal(data, &decoded)
+	if decoded["score"] != NaNToken || decoded["label"] != InfinityToken || decoded["history"].([]interface{})[1] != NegInfinityToken {
+		t.Errorf("Unexpected sanitized JSON: %s", data)
+	}
+	if !math.IsNaN(result.Value.(map[string]interface{})["score"].(float64)) {
+		t.Error("Expected the result to be left unchanged")
+	}
+
+	if clean := Number().Parse(2.5); clean.Meta.NonFinite != nil {
+		t.Errorf("Expected no flags for finite numbers, got %v", clean.Meta.NonFinite)
+	}
+}
+
+// Test issues with non-finite received values can be marshalled
+func TestIssuesNonFinite(t *testing.T) {
+	errs := ValidationErrors{
+		{Path: "min", Message: "Too small", Value: math.Inf(-1), Code: CodeTooSmall},
+		{Path: "items", Message: "Invalid", Value: []interface{}{math.NaN()}, Code: CodeCustom},
+	}
+	data, err := json.Marshal(errs.Issues())
+	if err != nil {
+		t.Fatalf("Expected issues to marshal, got %v", err)
+	}
+	var issues []map[string]interface{}
+	json.Unmarshal(data, &issues)
+	if issues[0]["received"] != NegInfinityToken || issues[1]["received"].([]interface{})[0] != NaNToken {
+		t.Errorf("Expected non-finite tokens, got %s", data)
+	}
+}
@@ -0,0 +1,726 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JSONSchemaDialect is the JSON Schema draft produced by ToJSONSchema
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchemaProvider is implemented by custom validators that describe
+// themselves in JSON Schema. Validators the exporter does not know and that
+// do not implement it are exported as {} (any value).
+type JSONSchemaProvider interface {
+	JSONSchema() map[string]any
+}
+
+// ToJSONSchema exports a schema as a JSON Schema (draft 2020-12) document.
+// Refinements and transformations cannot be expressed and are left out.
+// Recursive Lazy schemas are exported as "$defs" entries referenced by "$ref".
+func ToJSONSchema(validator Validator) map[string]any {
+	e := newSchemaExporter("#/$defs/", "")
+	schema := e.export(validator)
+
+	doc := map[string]any{"$schema": JSONSchemaDialect}
+	for key, val := range schema {
+		doc[key] = val
+	}
+	if len(e.defs) > 0 {
+		doc["$defs"] = e.defs
+	}
+	return doc
+}
+
+// OpenAPIComponents exports every registered schema as an OpenAPI 3.1
+// "components" object ({"schemas": {...}}), keyed by registered name
+func OpenAPIComponents() map[string]any {
+	schemas := map[string]any{}
+	for _, name := range Registered() {
+		validator, _ := Lookup(name)
+		e := newSchemaExporter("#/components/schemas/", name+"_")
+		schemas[name] = e.export(validator)
+		for defName, def := range e.defs {
+			schemas[defName] = def
+		}
+	}
+	return map[string]any{"schemas": schemas}
+}
+
+// schemaExporter converts validators to JSON Schema, tracking Lazy
+// validators so recursive schemas become references
+type schemaExporter struct {
+	refPrefix string
+	defPrefix string
+	defs      map[string]any
+	lazies    map[*LazyValidator]*lazyExport
+}
+
+// lazyExport tracks the export of a single Lazy validator
+type lazyExport struct {
+	name       string
+	inProgress bool
+	recursive  bool
+}
+
+func newSchemaExporter(refPrefix, defPrefix string) *schemaExporter {
+	return &schemaExporter{
+		refPrefix: refPrefix,
+		defPrefix: defPrefix,
+		defs:      map[string]any{},
+		lazies:    map[*LazyValidator]*lazyExport{},
+	}
+}
+
+// export converts a single validator
+func (e *schemaExporter) export(validator Validator) map[string]any {
+	switch v := validator.(type) {
+	case JSONSchemaProvider:
+		return v.JSONSchema()
+	case *StringValidator:
+		return nullableSchema(e.stringSchema(v), v.isNullable)
+	case *NumberValidator:
+		return nullableSchema(e.numberSchema(v), v.isNullable)
+	case *BigIntValidator:
+		// Integers beyond float64 precision are sent as strings
+		schema := map[string]any{"type": []any{"integer", "string"}, "pattern": "^[+-]?[0-9]+$"}
+		return nullableSchema(schema, v.isNullable)
+	case *DecimalValidator:
+		schema := map[string]any{"type": "string", "pattern": `^[+-]?([0-9]+(\.[0-9]+)?|\.[0-9]+)$`}
+		return nullableSchema(schema, v.isNullable)
+	case *BooleanValidator:
+		schema := map[string]any{"type": "boolean"}
+		if v.defaultVal != nil {
+			schema["default"] = *v.defaultVal
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *BytesValidator:
+		// Bytes travel as base64 in JSON, as encoding/json marshals them
+		schema := map[string]any{"type": "string", "contentEncoding": "base64"}
+		if v.encoding == "hex" {
+			schema["contentEncoding"] = "base16"
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *NullValidator:
+		return map[string]any{"type": "null"}
+	case *NeverValidator:
+		return map[string]any{"not": map[string]any{}}
+	case *DateValidator:
+		schema := map[string]any{"type": "string", "format": "date-time"}
+		if v.defaultVal != nil {
+			schema["default"] = v.defaultVal.Format(time.RFC3339)
+		}
+		if v.dateOnly {
+			schema["format"] = "date"
+			if v.defaultVal != nil {
+				schema["default"] = v.defaultVal.Format(time.DateOnly)
+			}
+		}
+		if v.layouts != nil {
+			// Custom layouts only match a format if they all produce it
+			schema["format"] = layoutsFormat(v.layouts)
+			if schema["format"] == "" {
+				delete(schema, "format")
+			}
+		}
+		if v.unixUnit != 0 {
+			schema = map[string]any{"anyOf": []any{schema, map[string]any{"type": "number"}}}
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *ArrayValidator:
+		schema := map[string]any{"type": "array"}
+		if v.elementValidator != nil {
+			schema["items"] = e.export(v.elementValidator)
+		}
+		if v.minLen != nil {
+			schema["minItems"] = *v.minLen
+		}
+		if v.isNonEmpty && (v.minLen == nil || *v.minLen < 1) {
+			schema["minItems"] = 1
+		}
+		if v.maxLen != nil {
+			schema["maxItems"] = *v.maxLen
+		}
+		if v.defaultVal != nil {
+			schema["default"] = v.defaultVal
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *ObjectValidator:
+		schema := e.objectSchema(v.schema, v.unknownFields)
+		if v.unknownFields == "catchall" {
+			schema["additionalProperties"] = e.export(v.catchall)
+		}
+		if v.defaultVal != nil {
+			schema["default"] = v.defaultVal
+		}
+		if len(v.conditions) > 0 {
+			e.conditionalSchema(schema, v.conditions)
+		}
+		if len(v.dependencies) > 0 {
+			dependencySchema(schema, v.dependencies)
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *RecordValidator:
+		schema := map[string]any{"type": "object"}
+		if v.valueValidator != nil {
+			schema["additionalProperties"] = e.export(v.valueValidator)
+		}
+		if v.keyValidator != nil {
+			schema["propertyNames"] = e.export(v.keyValidator)
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *TupleValidator:
+		items := make([]any, len(v.validators))
+		for i, item := range v.validators {
+			items[i] = e.export(item)
+		}
+		schema := map[string]any{
+			"type":        "array",
+			"prefixItems": items,
+			"minItems":    len(v.validators),
+		}
+		if v.rest != nil {
+			schema["items"] = e.export(v.rest)
+		} else {
+			schema["items"] = false
+		}
+		if v.defaultVal != nil {
+			schema["default"] = v.defaultVal
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *EnumValidator:
+		schema := map[string]any{"enum": v.allowedValues}
+		if v.defaultVal != nil {
+			schema["default"] = *v.defaultVal
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *LiteralValidator:
+		return nullableSchema(map[string]any{"const": v.expectedValue}, v.isNullable)
+	case *UnionValidator:
+		keyword := "anyOf"
+		if v.exclusive {
+			keyword = "oneOf"
+		}
+		return nullableSchema(map[string]any{keyword: e.exportAll(v.validators)}, v.isNullable)
+	case *DiscriminatedUnionValidator:
+		members := make([]Validator, len(v.options))
+		for i, option := range v.options {
+			members[i] = option.schema
+		}
+		return nullableSchema(map[string]any{"oneOf": e.exportAll(members)}, v.isNullable)
+	case *IntersectionValidator:
+		return nullableSchema(map[string]any{"allOf": e.exportAll(v.validators)}, v.isNullable)
+	case *LazyValidator:
+		return nullableSchema(e.lazySchema(v), v.isNullable)
+	case *LocalizedValidator:
+		return e.export(v.validator)
+	case *ExpensiveValidator:
+		return e.export(v.validator)
+	case *SensitiveValidator:
+		return e.export(v.validator)
+	case *ShadowValidator:
+		return e.export(v.primary)
+	case *requiredValidator:
+		return e.export(v.validator)
+	case *PhoneNumberValidator:
+		schema := e.objectSchema(Schema{
+			v.countryField: String(),
+			v.phoneField:   String(),
+		}, "passthrough")
+		if v.defaultCountry != nil {
+			schema["required"] = []string{v.phoneField}
+		}
+		return nullableSchema(schema, v.isNullable)
+	case *VersionedValidator:
+		versions := make([]Validator, len(v.order))
+		for i, version := range v.order {
+			versions[i] = v.versions[version]
+		}
+		return map[string]any{"anyOf": e.exportAll(versions)}
+	default:
+		// Any, Unknown and validators the exporter does not know accept any value
+		return map[string]any{}
+	}
+}
+
+// layoutsFormat returns the JSON Schema format matching every layout, or ""
+func layoutsFormat(layouts []string) string {
+	format := ""
+	for _, layout := range layouts {
+		var f string
+		switch layout {
+		case time.RFC3339, time.RFC3339Nano:
+			f = "date-time"
+		case time.DateOnly:
+			f = "date"
+		}
+		if f == "" || (format != "" && f != format) {
+			return ""
+		}
+		format = f
+	}
+	return format
+}
+
+// exportAll converts a list of validators
+func (e *schemaExporter) exportAll(validators []Validator) []any {
+	schemas := make([]any, len(validators))
+	for i, validator := range validators {
+		schemas[i] = e.export(validator)
+	}
+	return schemas
+}
+
+// stringSchema converts string rules and formats
+func (e *schemaExporter) stringSchema(v *StringValidator) map[string]any {
+	schema := map[string]any{"type": "string"}
+
+	if v.minLen != nil {
+		schema["minLength"] = *v.minLen
+	}
+	if v.maxLen != nil {
+		schema["maxLength"] = *v.maxLen
+	}
+	if v.exactLen != nil {
+		schema["minLength"] = *v.exactLen
+		schema["maxLength"] = *v.exactLen
+	}
+	if v.isNonEmpty && (v.minLen == nil || *v.minLen < 1) && v.exactLen == nil {
+		schema["minLength"] = 1
+	}
+
+	switch {
+	case v.isEmail && v.allowIDN:
+		schema["format"] = "idn-email"
+	case v.isEmail:
+		schema["format"] = "email"
+	case v.isURL && v.allowIDN:
+		schema["format"] = "iri"
+	case v.isURL:
+		schema["format"] = "uri"
+	case v.isDomain && v.allowIDN:
+		schema["format"] = "idn-hostname"
+	case v.isDomain:
+		schema["format"] = "hostname"
+	case v.isUUID:
+		schema["format"] = "uuid"
+	case v.isIPv4:
+		schema["format"] = "ipv4"
+	case v.isIPv6:
+		schema["format"] = "ipv6"
+	case v.cidr == "v4":
+		schema["format"] = "cidrv4"
+	case v.cidr == "v6":
+		schema["format"] = "cidrv6"
+	case v.cidr == "any":
+		schema["format"] = "cidr"
+	case v.isIP:
+		schema["anyOf"] = []any{
+			map[string]any{"format": "ipv4"},
+			map[string]any{"format": "ipv6"},
+		}
+	case v.isCUID:
+		schema["format"] = "cuid"
+	case v.isCUID2:
+		schema["format"] = "cuid2"
+	case v.isULID:
+		schema["format"] = "ulid"
+	case v.isNanoid:
+		schema["format"] = "nanoid"
+	case v.isObjectID:
+		schema["format"] = "objectid"
+	case v.isCron:
+		schema["format"] = "cron"
+	case v.isIBAN:
+		schema["format"] = "iban"
+	case v.isBIC:
+		schema["format"] = "bic"
+	case v.isISBN:
+		schema["format"] = "isbn"
+	case v.isEAN:
+		schema["format"] = "ean"
+	case v.password != nil:
+		schema["format"] = "password"
+	}
+	if v.isBase64 {
+		schema["contentEncoding"] = "base64"
+	}
+	if v.isBase32 {
+		schema["contentEncoding"] = "base32"
+	}
+	if v.country != "" {
+		schema["enum"] = countryCodes(v.country)
+	}
+	if v.isCurrency {
+		schema["enum"] = sortedCurrencyCodes()
+	}
+	if v.oneOf != nil && !v.oneOfFold {
+		schema["enum"] = v.oneOf
+	}
+
+	var patterns []string
+	if v.pattern != nil {
+		patterns = append(patterns, v.pattern.String())
+	}
+	if v.isHex {
+		patterns = append(patterns, "^[0-9a-fA-F]+$")
+	}
+	if v.isDataURI {
+		patterns = append(patterns, "^[dD][aA][tT][aA]:[^,]*,")
+	}
+	if v.isBase64URL {
+		patterns = append(patterns, "^[A-Za-z0-9_-]+={0,2}$")
+	}
+	if v.isGitSHA && v.isFull {
+		patterns = append(patterns, "^([0-9a-fA-F]{40}|[0-9a-fA-F]{64})$")
+	} else if v.isGitSHA {
+		patterns = append(patterns, "^([0-9a-fA-F]{7,40}|[0-9a-fA-F]{64})$")
+	}
+	if v.startsWith != nil {
+		patterns = append(patterns, "^"+regexp.QuoteMeta(*v.startsWith))
+	}
+	if v.endsWith != nil {
+		patterns = append(patterns, regexp.QuoteMeta(*v.endsWith)+"$")
+	}
+	if v.contains != nil {
+		patterns = append(patterns, regexp.QuoteMeta(*v.contains))
+	}
+	if v.asciiOnly {
+		patterns = append(patterns, `^[\x00-\x7F]*$`)
+	}
+	if v.letterCase == "lower" {
+		patterns = append(patterns, `^\P{Lu}*$`)
+	}
+	if v.letterCase == "upper" {
+		patterns = append(patterns, `^\P{Ll}*$`)
+	}
+	if v.username != nil {
+		patterns = append(patterns, `^[A-Za-z0-9`+classEscape(v.username.AllowChars)+`]*$`)
+	}
+	if v.charClass == "alpha" {
+		patterns = append(patterns, `^[\p{L}`+classEscape(v.extraChars)+`]*$`)
+	}
+	if v.charClass == "alphanumeric" {
+		patterns = append(patterns, `^[\p{L}\p{Nd}`+classEscape(v.extraChars)+`]*$`)
+	}
+
+	// Forbidden content becomes "not" subschemas
+	var forbidden []string
+	if v.notPattern != nil {
+		forbidden = append(forbidden, v.notPattern.String())
+	}
+	if v.notStartsWith != nil {
+		forbidden = append(forbidden, "^"+regexp.QuoteMeta(*v.notStartsWith))
+	}
+	if v.notContains != nil {
+		forbidden = append(forbidden, regexp.QuoteMeta(*v.notContains))
+	}
+	if v.noHTML {
+		forbidden = append(forbidden, "<[A-Za-z!?]|</[A-Za-z]")
+	}
+
+	switch {
+	case len(patterns) == 1 && len(forbidden) == 0:
+		schema["pattern"] = patterns[0]
+	case len(patterns) == 0 && len(forbidden) == 1:
+		schema["not"] = map[string]any{"pattern": forbidden[0]}
+	case len(patterns)+len(forbidden) > 1:
+		var all []any
+		for _, pattern := range patterns {
+			all = append(all, map[string]any{"pattern": pattern})
+		}
+		for _, pattern := range forbidden {
+			all = append(all, map[string]any{"not": map[string]any{"pattern": pattern}})
+		}
+		schema["allOf"] = all
+	}
+
+	if v.defaultVal != nil {
+		schema["default"] = *v.defaultVal
+	}
+	return schema
+}
+
+// classEscape escapes characters for use inside a regular expression
+// character class
+func classEscape(chars string) string {
+	var b strings.Builder
+	for _, r := range chars {
+		if strings.ContainsRune(`\]^-[`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// numberSchema converts number rules
+func (e *schemaExporter) numberSchema(v *NumberValidator) map[string]any {
+	schema := map[string]any{"type": "number"}
+	if v.isInt {
+		schema["type"] = "integer"
+	}
+
+	if v.minVal != nil && v.minExclusive {
+		schema["exclusiveMinimum"] = *v.minVal
+	} else if v.minVal != nil {
+		schema["minimum"] = *v.minVal
+	}
+	if v.maxVal != nil && v.maxExclusive {
+		schema["exclusiveMaximum"] = *v.maxVal
+	} else if v.maxVal != nil {
+		schema["maximum"] = *v.maxVal
+	}
+	if v.isNonNegative && (v.minVal == nil || *v.minVal < 0) {
+		schema["minimum"] = 0
+	}
+	if v.isNonPositive && (v.maxVal == nil || *v.maxVal > 0) {
+		schema["maximum"] = 0
+	}
+	if v.isPositive && !(v.minExclusive && *v.minVal >= 0) {
+		schema["exclusiveMinimum"] = 0
+	}
+	if v.isNegative && !(v.maxExclusive && *v.maxVal <= 0) {
+		schema["exclusiveMaximum"] = 0
+	}
+	if v.isSafe {
+		const maxSafeInteger = 9007199254740991 // 2^53 - 1
+		if _, ok := schema["minimum"]; !ok {
+			schema["minimum"] = -maxSafeInteger
+		}
+		if _, ok := schema["maximum"]; !ok {
+			schema["maximum"] = maxSafeInteger
+		}
+	}
+	if v.multipleOf != nil {
+		schema["multipleOf"] = *v.multipleOf
+	}
+
+	if v.defaultVal != nil {
+		schema["default"] = *v.defaultVal
+	}
+	return schema
+}
+
+// conditionalSchema adds When conditions to an object schema as if/then/else
+// subschemas. Strict objects use unevaluatedProperties, which, unlike
+// additionalProperties, accepts properties declared in the subschemas.
+func (e *schemaExporter) conditionalSchema(schema map[string]any, conditions []objectCondition) {
+	all := make([]any, len(conditions))
+	for i, cond := range conditions {
+		sub := map[string]any{
+			"if": map[string]any{
+				"properties": map[string]any{cond.field: e.export(cond.condition)},
+				"required":   []string{cond.field},
+			},
+			"then": e.objectSchema(cond.then, ""),
+		}
+		if cond.otherwise != nil {
+			sub["else"] = e.objectSchema(cond.otherwise, "")
+		}
+		all[i] = sub
+	}
+	schema["allOf"] = all
+
+	if _, strict := schema["additionalProperties"]; strict {
+		delete(schema, "additionalProperties")
+		schema["unevaluatedProperties"] = false
+	}
+}
+
+// dependencySchema adds field presence rules to an object schema
+func dependencySchema(schema map[string]any, dependencies []objectDependency) {
+	all, _ := schema["allOf"].([]any)
+	dependent := map[string][]string{}
+	for _, dep := range dependencies {
+		switch dep.code {
+		case CodeRequiredWith:
+			for _, other := range dep.fields {
+				dependent[other] = append(dependent[other], dep.field)
+			}
+		case CodeRequiredWithout:
+			// Either the field is present or none of the others is missing
+			all = append(all, map[string]any{"anyOf": []any{
+				map[string]any{"required": []string{dep.field}},
+				map[string]any{"required": dep.fields},
+			}})
+		case CodeMutuallyExclusive:
+			for i, a := range dep.fields {
+				for _, b := range dep.fields[i+1:] {
+					all = append(all, map[string]any{"not": map[string]any{"required": []string{a, b}}})
+				}
+			}
+		}
+	}
+
+	if len(dependent) > 0 {
+		schema["dependentRequired"] = dependent
+	}
+	if len(all) > 0 {
+		schema["allOf"] = all
+	}
+}
+
+// objectSchema converts an object schema. A field is required unless its
+// validator accepts a missing value, as decided by acceptsMissing.
+func (e *schemaExporter) objectSchema(fields Schema, unknownFields string) map[string]any {
+	properties := make(map[string]any, len(fields))
+	var required []string
+	for name, field := range fields {
+		properties[name] = e.export(field)
+		if !acceptsMissing(field) {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(required)
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	if unknownFields == "strict" {
+		schema["additionalProperties"] = false
+	}
+	return schema
+}
+
+// acceptsMissing reports whether a validator accepts a missing (nil) value,
+// mirroring how each validator handles nil. It reads the optional, nullable
+// and default modifiers rather than parsing nil, so exporting a schema never
+// runs DefaultFunc or check callbacks. Validators it does not know are
+// taken to require a value.
+func acceptsMissing(validator Validator) bool {
+	switch v := validator.(type) {
+	case *StringValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *NumberValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *BooleanValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *DateValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *EnumValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *ArrayValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *TupleValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *ObjectValidator:
+		return v.isOptional || v.isNullable || v.defaultVal != nil || v.defaultFunc != nil
+	case *BigIntValidator:
+		return v.isOptional || v.isNullable
+	case *DecimalValidator:
+		return v.isOptional || v.isNullable
+	case *BytesValidator:
+		return v.isOptional || v.isNullable
+	case *LiteralValidator:
+		return v.isOptional || v.isNullable
+	case *RecordValidator:
+		return v.isOptional || v.isNullable
+	case *DiscriminatedUnionValidator:
+		return v.isOptional || v.isNullable
+	case *PhoneNumberValidator:
+		return v.isOptional || v.isNullable
+	case *NaNValidator:
+		return v.isOptional || v.isNullable
+	case *NeverValidator:
+		return v.isOptional
+	case *NullValidator:
+		return true
+	case *AnyValidator:
+		return !v.isRequired
+	case *UnknownValidator:
+		return !v.isRequired
+	case *LazyValidator:
+		if v.isOptional || v.isNullable {
+			return true
+		}
+		if v.isRequired {
+			return false
+		}
+		return acceptsMissing(v.factory())
+	case *UnionValidator:
+		if v.isOptional || v.isNullable {
+			return true
+		}
+		if v.isRequired {
+			return false
+		}
+		// nil is tried against each member
+		for _, member := range v.validators {
+			if acceptsMissing(member) {
+				return true
+			}
+		}
+		return false
+	case *IntersectionValidator:
+		if v.isOptional || v.isNullable {
+			return true
+		}
+		if v.isRequired {
+			return false
+		}
+		// nil must pass every member
+		for _, member := range v.validators {
+			if !acceptsMissing(member) {
+				return false
+			}
+		}
+		return true
+	case *ExpensiveValidator:
+		return acceptsMissing(v.validator)
+	case *LocalizedValidator:
+		return acceptsMissing(v.validator)
+	case *SensitiveValidator:
+		return acceptsMissing(v.validator)
+	case *ShadowValidator:
+		return acceptsMissing(v.primary)
+	case *VersionedValidator:
+		validator, ok := v.versions[v.defaultVersion]
+		return ok && acceptsMissing(validator)
+	}
+	return false
+}
+
+// lazySchema resolves a Lazy validator, turning self-references into "$ref"s
+func (e *schemaExporter) lazySchema(v *LazyValidator) map[string]any {
+	state, seen := e.lazies[v]
+	if seen && (state.inProgress || state.recursive) {
+		state.recursive = true
+		return map[string]any{"$ref": e.refPrefix + state.name}
+	}
+	if !seen {
+		state = &lazyExport{name: fmt.Sprintf("%sLazy%d", e.defPrefix, len(e.lazies)+1)}
+		e.lazies[v] = state
+	}
+
+	state.inProgress = true
+	schema := e.export(v.factory())
+	state.inProgress = false
+
+	if !state.recursive {
+		return schema
+	}
+	e.defs[state.name] = schema
+	return map[string]any{"$ref": e.refPrefix + state.name}
+}
+
+// nullableSchema extends a schema to also accept null
+func nullableSchema(schema map[string]any, nullable bool) map[string]any {
+	if !nullable || len(schema) == 0 {
+		return schema
+	}
+	if typ, ok := schema["type"].(string); ok {
+		schema["type"] = []any{typ, "null"}
+		return schema
+	}
+	return map[string]any{"anyOf": []any{schema, map[string]any{"type": "null"}}}
+}
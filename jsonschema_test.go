@@ -0,0 +1,231 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// exportJSON exports a schema and encodes it for comparison
+func exportJSON(t *testing.T, v Validator) string {
+	t.Helper()
+	schema := ToJSONSchema(v)
+	delete(schema, "$schema")
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// Test export of primitive validators
+func TestToJSONSchemaPrimitives(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   Validator
+		expected string
+	}{
+		{"string", String().Min(2).Max(10), `{"maxLength":10,"minLength":2,"type":"string"}`},
+		{"email", String().Email(), `{"format":"email","type":"string"}`},
+		{"idn email", String().Email().AllowIDN(), `{"format":"idn-email","type":"string"}`},
+		{"starts with", String().StartsWith("a.b"), `{"pattern":"^a\\.b","type":"string"}`},
+		{"default", String().Default("x"), `{"default":"x","type":"string"}`},
+		{"nullable", String().Nullable(), `{"type":["string","null"]}`},
+		{"int", Number().Int().Min(0).Max(120), `{"maximum":120,"minimum":0,"type":"integer"}`},
+		{"positive", Number().Positive(), `{"exclusiveMinimum":0,"type":"number"}`},
+		{"exclusive bounds", Number().Gt(1).Lt(5), `{"exclusiveMaximum":5,"exclusiveMinimum":1,"type":"number"}`},
+		{"objectid", String().ObjectID(), `{"format":"objectid","type":"string"}`},
+		{"git sha", String().GitSHA().Full(), `{"pattern":"^([0-9a-fA-F]{40}|[0-9a-fA-F]{64})$","type":"string"}`},
+		{"ascii", String().ASCII(), `{"pattern":"^[\\x00-\\x7F]*$","type":"string"}`},
+		{"alphanumeric", String().Alphanumeric().AllowChars("_-"), `{"pattern":"^[\\p{L}\\p{Nd}_\\-]*$","type":"string"}`},
+		{"lowercase", String().Lowercase(), `{"pattern":"^\\P{Lu}*$","type":"string"}`},
+		{"base64url", String().Base64URL(), `{"pattern":"^[A-Za-z0-9_-]+={0,2}$","type":"string"}`},
+		{"base32", String().Base32(), `{"contentEncoding":"base32","type":"string"}`},
+		{"data uri", String().DataURI(), `{"pattern":"^[dD][aA][tT][aA]:[^,]*,","type":"string"}`},
+		{"non-empty string", String().NonEmpty(), `{"minLength":1,"type":"string"}`},
+		{"string one of", String().OneOf("a", "b"), `{"enum":["a","b"],"type":"string"}`},
+		{"string one of fold", String().OneOfFold("a", "b"), `{"type":"string"}`},
+		{"not regex", String().NotRegex("^test_"), `{"not":{"pattern":"^test_"},"type":"string"}`},
+		{"forbidden content", String().Regex("^[a-z_]+$").NotStartsWith("a.").NotContains("x"), `{"allOf":[{"pattern":"^[a-z_]+$"},{"not":{"pattern":"^a\\."}},{"not":{"pattern":"x"}}],"type":"string"}`},
+		{"password", String().Password(PasswordPolicy{MinLength: 8}), `{"format":"password","type":"string"}`},
+		{"username", String().Username(UsernamePolicy{AllowChars: "_-"}), `{"pattern":"^[A-Za-z0-9_\\-]*$","type":"string"}`},
+		{"no html", String().NoHTML(), `{"not":{"pattern":"\u003c[A-Za-z!?]|\u003c/[A-Za-z]"},"type":"string"}`},
+		{"cron", String().Cron(), `{"format":"cron","type":"string"}`},
+		{"iban", String().IBAN(), `{"format":"iban","type":"string"}`},
+		{"cidr", String().CIDRv4(), `{"format":"cidrv4","type":"string"}`},
+		{"positive gt", Number().Gt(2).Positive(), `{"exclusiveMinimum":2,"type":"number"}`},
+		{"boolean", Boolean(), `{"type":"boolean"}`},
+		{"bytes", Bytes().Min(1), `{"contentEncoding":"base64","type":"string"}`},
+		{"bytes hex", Bytes().Hex(), `{"contentEncoding":"base16","type":"string"}`},
+		{"null", Null(), `{"type":"null"}`},
+		{"date only", Date().DateOnly(), `{"format":"date","type":"string"}`},
+		{"rfc3339 date", Date().Layouts(time.RFC3339, time.RFC3339Nano), `{"format":"date-time","type":"string"}`},
+		{"custom layout date", Date().Layout("02/01/2006"), `{"type":"string"}`},
+		{"unix date", Date().FromUnix(), `{"anyOf":[{"format":"date-time","type":"string"},{"type":"number"}]}`},
+		{"decimal", Decimal(), `{"pattern":"^[+-]?([0-9]+(\\.[0-9]+)?|\\.[0-9]+)$","type":"string"}`},
+		{"bigint", BigInt(), `{"pattern":"^[+-]?[0-9]+$","type":["integer","string"]}`},
+		{"never", Never(), `{"not":{}}`},
+		{"deprecated field", Object(Schema{"a": Never().Optional()}), `{"properties":{"a":{"not":{}}},"type":"object"}`},
+		{"nullish", Boolean().Nullish(), `{"type":["boolean","null"]}`},
+		{"date", Date(), `{"format":"date-time","type":"string"}`},
+		{"enum", Enum([]interface{}{"a", "b"}), `{"enum":["a","b"]}`},
+		{"nullable enum", Enum([]interface{}{"a"}).Nullable(), `{"anyOf":[{"enum":["a"]},{"type":"null"}]}`},
+		{"literal", Literal("ok"), `{"const":"ok"}`},
+		{"any", Any(), `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exportJSON(t, tt.schema); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+// Test country codes export the full code list
+func TestToJSONSchemaCountryCode(t *testing.T) {
+	for _, tt := range []struct {
+		schema Validator
+		first  string
+	}{
+		{String().CountryCode(), "AD"},
+		{String().CountryCodeAlpha3(), "ABW"},
+	} {
+		codes, ok := ToJSONSchema(tt.schema)["enum"].([]string)
+		if !ok || len(codes) != 249 || codes[0] != tt.first {
+			t.Errorf("Expected 249 sorted codes starting with %s, got %v", tt.first, codes)
+		}
+	}
+
+	codes, ok := ToJSONSchema(String().CurrencyCode())["enum"].([]string)
+	if !ok || len(codes) != len(currencyCodes) || codes[0] != "AED" {
+		t.Errorf("Expected sorted currency codes, got %v", codes)
+	}
+}
+
+// Test export of composite validators
+func TestToJSONSchemaComposites(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   Validator
+		expected string
+	}{
+		{
+			"object",
+			Object(Schema{"name": String(), "bio": String().Optional()}).Strict(),
+			`{"additionalProperties":false,"properties":{"bio":{"type":"string"},"name":{"type":"string"}},"required":["name"],"type":"object"}`,
+		},
+		{
+			"conditional",
+			Object(Schema{"type": String(), "vat": String().Optional()}).Strict().
+				When("type", Literal("company"), Schema{"vat": String()}, nil),
+			`{"allOf":[{"if":{"properties":{"type":{"const":"company"}},"required":["type"]},"then":{"properties":{"vat":{"type":"string"}},"required":["vat"],"type":"object"}}],"properties":{"type":{"type":"string"},"vat":{"type":"string"}},"required":["type"],"type":"object","unevaluatedProperties":false}`,
+		},
+		{
+			"dependencies",
+			Object(Schema{"card": String().Optional(), "billing": String().Optional(), "email": String().Optional(), "phone": String().Optional()}).
+				RequiredWith("billing", "card").
+				RequiredWithout("email", "phone").
+				MutuallyExclusive("card", "phone"),
+			`{"allOf":[{"anyOf":[{"required":["email"]},{"required":["phone"]}]},{"not":{"required":["card","phone"]}}],"dependentRequired":{"card":["billing"]},"properties":{"billing":{"type":"string"},"card":{"type":"string"},"email":{"type":"string"},"phone":{"type":"string"}},"type":"object"}`,
+		},
+		{"array", Array(Number()).Min(1), `{"items":{"type":"number"},"minItems":1,"type":"array"}`},
+		{"record", Record(String(), Boolean()), `{"additionalProperties":{"type":"boolean"},"propertyNames":{"type":"string"},"type":"object"}`},
+		{"tuple", Tuple(String(), Number()), `{"items":false,"minItems":2,"prefixItems":[{"type":"string"},{"type":"number"}],"type":"array"}`},
+		{"union", Union(String(), Number()), `{"anyOf":[{"type":"string"},{"type":"number"}]}`},
+		{"required all", Object(Schema{"a": String().Optional()}).RequiredAll(), `{"properties":{"a":{"type":"string"}},"required":["a"],"type":"object"}`},
+		{"catchall", Object(Schema{"a": String()}).Catchall(Number()), `{"additionalProperties":{"type":"number"},"properties":{"a":{"type":"string"}},"required":["a"],"type":"object"}`},
+		{"object default", Object(Schema{"a": Number().Default(1)}).Default(map[string]interface{}{}), `{"default":{},"properties":{"a":{"default":1,"type":"number"}},"type":"object"}`},
+		{"array default", Array(String()).Default([]interface{}{}), `{"default":[],"items":{"type":"string"},"type":"array"}`},
+		{"exclusive union", Union(String(), Number()).Exclusive(), `{"oneOf":[{"type":"string"},{"type":"number"}]}`},
+		{
+			"discriminated union",
+			DiscriminatedUnion("kind", Object(Schema{"kind": Literal("a")}), Object(Schema{"kind": Literal("b"), "n": Number()})),
+			`{"oneOf":[{"properties":{"kind":{"const":"a"}},"required":["kind"],"type":"object"},{"properties":{"kind":{"const":"b"},"n":{"type":"number"}},"required":["kind","n"],"type":"object"}]}`,
+		},
+		{"intersection", Intersection(String(), String().Min(1)), `{"allOf":[{"type":"string"},{"minLength":1,"type":"string"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exportJSON(t, tt.schema); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+// Test recursive Lazy schemas are exported as references
+func TestToJSONSchemaRecursive(t *testing.T) {
+	var category Validator
+	category = Lazy(func() Validator {
+		return Object(Schema{
+			"name":     String(),
+			"children": Array(category).Optional(),
+		})
+	})
+
+	schema := ToJSONSchema(category)
+	if schema["$schema"] != JSONSchemaDialect {
+		t.Errorf("Expected $schema %s, got %v", JSONSchemaDialect, schema["$schema"])
+	}
+	if schema["$ref"] != "#/$defs/Lazy1" {
+		t.Fatalf("Expected root reference, got %v", schema)
+	}
+
+	defs := schema["$defs"].(map[string]any)
+	data, _ := json.Marshal(defs["Lazy1"])
+	expected := `{"properties":{"children":{"items":{"$ref":"#/$defs/Lazy1"},"type":"array"},"name":{"type":"string"}},"required":["name"],"type":"object"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+type testColorValidator struct{}
+
+func (testColorValidator) Parse(value any) ParseResult {
+	if value == nil {
+		return FailureTypeMismatch("color", nil)
+	}
+	return Success(value)
+}
+
+func (testColorValidator) JSONSchema() map[string]any {
+	return map[string]any{"type": "string", "pattern": "^#[0-9a-f]{6}$"}
+}
+
+// Test custom validators can describe themselves
+func TestToJSONSchemaProvider(t *testing.T) {
+	expected := `{"properties":{"color":{"pattern":"^#[0-9a-f]{6}$","type":"string"}},"required":["color"],"type":"object"}`
+	if got := exportJSON(t, Object(Schema{"color": testColorValidator{}})); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+// Test exporting decides required fields from modifiers without running
+// defaults or checks
+func TestToJSONSchemaRunsNoCallbacks(t *testing.T) {
+	calls := 0
+	schema := Object(Schema{
+		"id":      String().RefineCtx(func(ctx context.Context, value any) error { calls++; return nil }),
+		"plan":    String().DefaultFunc(func() string { calls++; return "free" }),
+		"nick":    String().Nullable(),
+		"kind":    Union(String(), Null()),
+		"both":    Intersection(Number(), Number().Optional()),
+		"extra":   Any(),
+		"version": Sensitive(Number().Default(1)),
+	})
+
+	expected := `["both","id"]`
+	required, _ := json.Marshal(ToJSONSchema(schema)["required"])
+	if string(required) != expected {
+		t.Errorf("Expected required %s, got %s", expected, required)
+	}
+	if calls != 0 {
+		t.Errorf("Expected no callbacks to run during export, got %d calls", calls)
+	}
+}
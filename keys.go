@@ -0,0 +1,135 @@
+package zogo
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// SnakeCase converts a key such as "userName", "UserName" or "user-name" to
+// "user_name", for use with NormalizeKeys
+func SnakeCase(key string) string {
+	words := splitWords(key)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+	return strings.Join(words, "_")
+}
+
+// CamelCase converts a key such as "user_name", "UserName" or "user-name" to
+// "userName", for use with NormalizeKeys
+func CamelCase(key string) string {
+	var sb strings.Builder
+	for i, word := range splitWords(key) {
+		word = strings.ToLower(word)
+		if i > 0 {
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			word = string(runes)
+		}
+		sb.WriteString(word)
+	}
+	return sb.String()
+}
+
+// splitWords splits a key into words at underscores, hyphens, spaces and
+// case changes, keeping acronyms together ("HTTPServer" is "HTTP", "Server")
+func splitWords(key string) []string {
+	var words []string
+	runes := []rune(key)
+	start := 0
+	flush := func(end int) {
+		if end > start {
+			words = append(words, string(runes[start:end]))
+		}
+	}
+
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ' || r == '.':
+			flush(i)
+			start = i + 1
+		case i > start && unicode.IsUpper(r):
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush(i)
+				start = i
+			}
+		}
+	}
+	flush(len(runes))
+	return words
+}
+
+// renameKeys returns a copy of an object with its keys renamed, and the
+// input key of each renamed key. Keys that rename keeps win over keys it
+// renames to the same name; the others are reported as duplicates, at the
+// key the input used.
+func renameKeys(objMap map[string]interface{}, rename func(string) string) (map[string]interface{}, map[string]string, ValidationErrors) {
+	renamed := make(map[string]interface{}, len(objMap))
+	inputKeys := map[string]string{}
+	var errors ValidationErrors
+
+	// Visit keys in order, so the key reported as a duplicate is stable
+	keys := make([]string, 0, len(objMap))
+	for key := range objMap {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if rename(key) == key {
+			renamed[key] = objMap[key]
+		}
+	}
+	for _, key := range keys {
+		name := rename(key)
+		if name == key {
+			continue
+		}
+		if _, exists := renamed[name]; exists {
+			errors = append(errors, ValidationError{
+				Message: "Duplicate key '" + key + "'",
+				Value:   objMap[key],
+				Code:    CodeDuplicateKey,
+				Params:  map[string]any{"key": key, "field": name},
+			}.atKey(key))
+			continue
+		}
+		renamed[name] = objMap[key]
+		inputKeys[name] = key
+	}
+	return renamed, inputKeys, errors
+}
+
+// keyDistance returns the number of edits (insertions, deletions,
+// substitutions and transpositions of adjacent characters) turning one key
+// into the other, ignoring case
+func keyDistance(a, b string) int {
+	s, t := []rune(strings.ToLower(a)), []rune(strings.ToLower(b))
+
+	// Rows of the distance matrix for the two previous prefixes of s and the current one
+	prev2 := make([]int, len(t)+1)
+	prev := make([]int, len(t)+1)
+	curr := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(t)]
+}
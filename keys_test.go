@@ -0,0 +1,50 @@
+package zogo
+
+import "testing"
+
+// Test SnakeCase and CamelCase convert between key conventions
+func TestKeyCase(t *testing.T) {
+	tests := []struct {
+		key, snake, camel string
+	}{
+		{"userName", "user_name", "userName"},
+		{"UserName", "user_name", "userName"},
+		{"user_name", "user_name", "userName"},
+		{"user-name", "user_name", "userName"},
+		{"USER_NAME", "user_name", "userName"},
+		{"userID", "user_id", "userId"},
+		{"HTTPServer", "http_server", "httpServer"},
+		{"address2Line", "address2_line", "address2Line"},
+		{"email", "email", "email"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := SnakeCase(tt.key); got != tt.snake {
+			t.Errorf("SnakeCase(%q) = %q, expected %q", tt.key, got, tt.snake)
+		}
+		if got := CamelCase(tt.key); got != tt.camel {
+			t.Errorf("CamelCase(%q) = %q, expected %q", tt.key, got, tt.camel)
+		}
+	}
+}
+
+// Test keyDistance counts edits, with adjacent transpositions as one
+func TestKeyDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		distance int
+	}{
+		{"email", "email", 0},
+		{"Email", "email", 0},
+		{"emial", "email", 1},
+		{"adress", "address", 1},
+		{"nmae", "name", 1},
+		{"phone", "email", 5},
+		{"", "id", 2},
+	}
+	for _, tt := range tests {
+		if got := keyDistance(tt.a, tt.b); got != tt.distance {
+			t.Errorf("keyDistance(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.distance)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package zogo
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// parseLanguageTag checks that s is a well-formed BCP 47 language tag
+// (RFC 5646), such as "en", "zh-Hant-TW" or "de-CH-1996". With canonical
+// set it also accepts "_" as a separator and returns the tag canonicalized
+// by golang.org/x/text/language: case normalized, deprecated and legacy
+// tags replaced ("iw" becomes "he", "i-klingon" becomes "tlh") and
+// redundant scripts suppressed ("en-Latn-US" becomes "en-US"). Subtags
+// missing from the IANA registry are well-formed but cannot be
+// canonicalized, so they only fail in canonical mode.
+func parseLanguageTag(s string, canonical bool) (string, bool) {
+	if !canonical {
+		_, err := language.Parse(s)
+		var unknown language.ValueError
+		return s, !strings.Contains(s, "_") && (err == nil || errors.As(err, &unknown))
+	}
+	tag, err := language.All.Parse(s)
+	if err != nil {
+		return "", false
+	}
+	return tag.String(), true
+}
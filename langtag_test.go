@@ -0,0 +1,68 @@
+package zogo
+
+import "testing"
+
+// Test BCP 47 language tag validation
+func TestStringLanguageTag(t *testing.T) {
+	schema := String().LanguageTag()
+
+	valid := []string{
+		"en", "en-US", "zh-Hant-TW", "sr-Latn", "es-419", "de-CH-1996",
+		"sl-rozaj-biske", "zh-yue-HK", "en-US-u-ca-gregory", "en-a-bbb-x-a-ccc",
+		"x-whatever", "i-klingon", "EN-us", "iw", "en-Latn-US",
+		"en-Qabc", // Unregistered script
+	}
+	for _, tag := range valid {
+		if result := schema.Parse(tag); !result.Ok || result.Value != tag {
+			t.Errorf("Expected %q to pass unchanged, got %v", tag, result)
+		}
+	}
+
+	invalid := []string{
+		"", "e", "en-", "en--US", "en_US", "123", "en-US-", "toolongtag",
+		"de-419-DE", // Two regions
+		"en-a",      // Empty extension
+		"en-x",      // Empty private use
+		"en-US-ñ",
+	}
+	for _, tag := range invalid {
+		result := schema.Parse(tag)
+		if result.Ok {
+			t.Errorf("Expected %q to fail", tag)
+			continue
+		}
+		if result.Errors[0].Code != CodeInvalidLanguageTag {
+			t.Errorf("Expected invalid_string.language_tag for %q, got %s", tag, result.Errors[0].Code)
+		}
+	}
+}
+
+// Test canonical language tags normalize case, separators and deprecated subtags
+func TestStringCanonicalLanguageTag(t *testing.T) {
+	schema := String().CanonicalLanguageTag()
+
+	tests := map[string]string{
+		"EN_us":              "en-US",
+		"zh-hant-tw":         "zh-Hant-TW",
+		"SR-LATN":            "sr-Latn",
+		"en-US-U-CA-Gregory": "en-US-u-ca-gregory",
+		"DE-ch-1996":         "de-CH-1996",
+		"I-KLINGON":          "tlh",
+		"iw":                 "he",
+		"in-ID":              "id-ID",
+		"zh-cmn-Hans":        "zh-Hans",
+		"en-Latn-US":         "en-US",
+	}
+	for input, expected := range tests {
+		if result := schema.Parse(input); !result.Ok || result.Value != expected {
+			t.Errorf("Expected %q to normalize to %q, got %v", input, expected, result)
+		}
+	}
+
+	if schema.Parse("en__US").Ok {
+		t.Error("Expected an empty subtag to fail")
+	}
+	if schema.Parse("en-Abcd").Ok {
+		t.Error("Expected an unregistered subtag to fail")
+	}
+}
@@ -39,8 +39,21 @@ func (v *LazyValidator) Nullable() *LazyValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *LazyValidator) Nullish() *LazyValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
 // Parse validates the input value by constructing the actual validator at runtime
 func (v *LazyValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *LazyValidator) parseWithState(value any, st *parseState) ParseResult {
 	// Handle nil values based on modifiers
 	if value == nil {
 		// If optional, nil is OK
@@ -55,7 +68,7 @@ func (v *LazyValidator) Parse(value any) ParseResult {
 
 		// If explicitly required, reject
 		if v.isRequired {
-			return FailureMessage("Expected value, received null")
+			return FailureTypeMismatch("value", nil)
 		}
 	}
 
@@ -63,5 +76,5 @@ func (v *LazyValidator) Parse(value any) ParseResult {
 	actualValidator := v.factory()
 
 	// Delegate to the actual validator
-	return actualValidator.Parse(value)
+	return st.parse(actualValidator, value)
 }
@@ -12,6 +12,9 @@ type LiteralValidator struct {
 	isRequired bool
 	isOptional bool
 	isNullable bool
+
+	// Custom validators
+	refinements []refinement
 }
 
 // Literal creates a new literal validator with the expected value
@@ -41,8 +44,27 @@ func (v *LiteralValidator) Nullable() *LiteralValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *LiteralValidator) Nullish() *LiteralValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Refine adds custom validation logic on the matching value
+func (v *LiteralValidator) Refine(check func(any) bool, message string) *LiteralValidator {
+	v.refinements = append(v.refinements, refinement{check: check, message: message})
+	return v
+}
+
 // Parse validates the input value
 func (v *LiteralValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *LiteralValidator) parseWithState(value any, st *parseState) ParseResult {
 	// Handle nil values based on modifiers
 	if value == nil {
 		// If optional, nil is OK
@@ -56,14 +78,22 @@ func (v *LiteralValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage(fmt.Sprintf("Expected literal value %v, received null", v.expectedValue))
+		return FailureWithParams(
+			fmt.Sprintf("Expected literal value %v, received null", v.expectedValue),
+			CodeInvalidLiteral,
+			map[string]any{"expected": fmt.Sprint(v.expectedValue), "received": "null"},
+		)
 	}
 
 	// Check if value matches expected literal
 	if deepEqual(value, v.expectedValue) {
-		return Success(value)
+		return refine(v.refinements, Success(value))
 	}
 
 	// Value doesn't match
-	return FailureMessage(fmt.Sprintf("Invalid literal value. Expected %v, received %v", v.expectedValue, value))
+	return FailureWithParams(
+		fmt.Sprintf("Invalid literal value. Expected %v, received %v", v.expectedValue, value),
+		CodeInvalidLiteral,
+		map[string]any{"expected": fmt.Sprint(v.expectedValue), "received": fmt.Sprint(value)},
+	)
 }
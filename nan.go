@@ -0,0 +1,63 @@
+package zogo
+
+import "math"
+
+// NaNValidator accepts only NaN, such as the missing-value marker of a
+// data-science payload
+type NaNValidator struct {
+	// Modifiers
+	isOptional bool
+	isNullable bool
+}
+
+// NaN creates a new NaN validator
+func NaN() *NaNValidator {
+	return &NaNValidator{}
+}
+
+// Optional allows nil values
+func (v *NaNValidator) Optional() *NaNValidator {
+	v.isOptional = true
+	return v
+}
+
+// Nullable allows null values
+func (v *NaNValidator) Nullable() *NaNValidator {
+	v.isNullable = true
+	return v
+}
+
+// Parse validates the input value
+func (v *NaNValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse,
+// coercing it first when the parse asks for coercion, so that the "NaN"
+// token is accepted
+func (v *NaNValidator) parseWithState(value any, st *parseState) ParseResult {
+	if st.opts.Coerce {
+		value = coerceNumber(value)
+	}
+
+	if value == nil {
+		if v.isOptional || v.isNullable {
+			return Success(nil)
+		}
+		return FailureTypeMismatch("NaN", nil)
+	}
+
+	switch num := value.(type) {
+	case float64:
+		if math.IsNaN(num) {
+			st.meta.NonFinite = append(st.meta.NonFinite, st.currentPath())
+			return Success(num)
+		}
+	case float32:
+		if math.IsNaN(float64(num)) {
+			st.meta.NonFinite = append(st.meta.NonFinite, st.currentPath())
+			return Success(math.NaN())
+		}
+	}
+	return FailureTypeMismatch("NaN", value)
+}
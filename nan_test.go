@@ -0,0 +1,47 @@
+package zogo
+
+import (
+	"math"
+	"testing"
+)
+
+// Test NaN
+func TestNaN(t *testing.T) {
+	result := NaN().Parse(math.NaN())
+	if !result.Ok || !math.IsNaN(result.Value.(float64)) {
+		t.Errorf("Expected NaN to pass, got %v", result.Errors)
+	}
+	if !NaN().Parse(float32(math.NaN())).Ok {
+		t.Error("Expected float32 NaN to pass")
+	}
+
+	for _, value := range []any{nil, 1.5, math.Inf(1), "NaN"} {
+		result := NaN().Parse(value)
+		if result.Ok || result.Errors[0].Code != CodeInvalidType {
+			t.Errorf("Expected %#v to fail, got %v", value, result.Errors)
+		}
+	}
+	if !NaN().Optional().Parse(nil).Ok {
+		t.Error("Expected nil to pass when optional")
+	}
+
+	// The token written by SanitizeJSON is accepted with coercion
+	if !ParseWith(NaN(), NaNToken, ParseOptions{Coerce: true}).Ok {
+		t.Error("Expected the NaN token to be coerced")
+	}
+}
+
+// Test NaN in a union marking where non-finite values are acceptable
+func TestNaNUnion(t *testing.T) {
+	schema := Object(Schema{
+		"score": Union(Number().Finite(), NaN()),
+	})
+
+	result := schema.Parse(map[string]interface{}{"score": math.NaN()})
+	if !result.Ok || len(result.Meta.NonFinite) != 1 || result.Meta.NonFinite[0] != "score" {
+		t.Errorf("Expected NaN score to pass and be flagged, got %v %v", result.Errors, result.Meta.NonFinite)
+	}
+	if schema.Parse(map[string]interface{}{"score": math.Inf(1)}).Ok {
+		t.Error("Expected infinite score to fail")
+	}
+}
@@ -0,0 +1,32 @@
+package zogo
+
+// NeverValidator rejects every value. With Optional it accepts only a
+// missing value, which deprecates a field that must no longer be sent.
+type NeverValidator struct {
+	// Modifiers
+	isOptional bool
+}
+
+// Never creates a new never validator
+func Never() *NeverValidator {
+	return &NeverValidator{}
+}
+
+// Optional allows nil values, so that the field may only be left out
+func (v *NeverValidator) Optional() *NeverValidator {
+	v.isOptional = true
+	return v
+}
+
+// Parse rejects the input value
+func (v *NeverValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *NeverValidator) parseWithState(value any, st *parseState) ParseResult {
+	if value == nil && v.isOptional {
+		return Success(nil)
+	}
+	return FailureTypeMismatch("never", value)
+}
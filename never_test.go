@@ -0,0 +1,29 @@
+package zogo
+
+import "testing"
+
+// Test Never
+func TestNever(t *testing.T) {
+	for _, value := range []any{nil, "", 0, false} {
+		result := Never().Parse(value)
+		if result.Ok || result.Errors[0].Code != CodeInvalidType || result.Errors[0].Params["expected"] != "never" {
+			t.Errorf("Expected %#v to fail, got %v", value, result.Errors)
+		}
+	}
+}
+
+// Test Never as a deprecated field
+func TestNeverDeprecatedField(t *testing.T) {
+	schema := Object(Schema{
+		"name":     String(),
+		"username": Never().Optional(),
+	})
+
+	if !schema.Parse(map[string]interface{}{"name": "ada"}).Ok {
+		t.Error("Expected the object to pass without the deprecated field")
+	}
+	result := schema.Parse(map[string]interface{}{"name": "ada", "username": "ada"})
+	if result.Ok || !result.Errors.HasPath("username") {
+		t.Errorf("Expected the deprecated field to be rejected, got %v", result.Errors)
+	}
+}
@@ -0,0 +1,23 @@
+package zogo
+
+// NullValidator accepts only nil, such as the null member of
+// Union(String(), Null())
+type NullValidator struct{}
+
+// Null creates a new null validator
+func Null() *NullValidator {
+	return &NullValidator{}
+}
+
+// Parse validates the input value
+func (v *NullValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *NullValidator) parseWithState(value any, st *parseState) ParseResult {
+	if value != nil {
+		return FailureTypeMismatch("null", value)
+	}
+	return Success(nil)
+}
@@ -0,0 +1,48 @@
+package zogo
+
+import "testing"
+
+// Test Null
+func TestNull(t *testing.T) {
+	if !Null().Parse(nil).Ok {
+		t.Error("Expected nil to pass")
+	}
+	for _, value := range []any{"", 0, false, map[string]interface{}{}} {
+		result := Null().Parse(value)
+		if result.Ok || result.Errors[0].Code != CodeInvalidType || result.Errors[0].Params["expected"] != "null" {
+			t.Errorf("Expected %#v to fail, got %v", value, result.Errors)
+		}
+	}
+
+	// A nullable union member, tried last
+	schema := Union(String(), Null())
+	if !schema.Parse(nil).Ok || !schema.Parse("a").Ok || schema.Parse(1).Ok {
+		t.Error("Expected the union to accept strings and null only")
+	}
+}
+
+// Test Nullish
+func TestNullish(t *testing.T) {
+	schema := Object(Schema{
+		"name":     String(),
+		"nickname": String().Min(2).Nullish(),
+	})
+
+	for _, input := range []map[string]interface{}{
+		{"name": "ada"},
+		{"name": "ada", "nickname": nil},
+		{"name": "ada", "nickname": "ad"},
+	} {
+		if result := schema.Parse(input); !result.Ok {
+			t.Errorf("Expected %v to pass, got %v", input, result.Errors)
+		}
+	}
+	if schema.Parse(map[string]interface{}{"name": "ada", "nickname": "a"}).Ok {
+		t.Error("Expected rules to apply to present values")
+	}
+
+	// Nullish overrides Required
+	if !Number().Required().Nullish().Parse(nil).Ok {
+		t.Error("Expected Nullish to allow nil after Required")
+	}
+}
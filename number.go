@@ -8,9 +8,11 @@ import (
 // NumberValidator validates number values with chainable methods
 type NumberValidator struct {
 	// Validation rules
-	minVal     *float64
-	maxVal     *float64
-	multipleOf *float64
+	minVal       *float64
+	maxVal       *float64
+	minExclusive bool // Set by Gt, so minVal itself fails
+	maxExclusive bool // Set by Lt, so maxVal itself fails
+	multipleOf   *float64
 
 	// Type checks
 	isInt         bool
@@ -20,15 +22,22 @@ type NumberValidator struct {
 	isNonPositive bool
 	isFinite      bool
 	isSafe        bool
+	allowNaN      bool
+	allowInf      bool
 
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
-	defaultVal *float64
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  *float64
+	defaultFunc func() float64 // Set by DefaultFunc, called on each parse
 
 	// Custom validators
-	refinements []NumberRefinement
+	refinements      []NumberRefinement
+	ctxRefinements   []CheckFunc
+	superRefinements []SuperRefineFunc
+
+	examples schemaExamples // Declared with Examples
 }
 
 // NumberRefinement holds custom validation logic for numbers
@@ -44,16 +53,43 @@ func Number() *NumberValidator {
 
 // Min sets the minimum value
 func (v *NumberValidator) Min(val float64) *NumberValidator {
-	v.minVal = &val
+	v.minVal, v.minExclusive = &val, false
 	return v
 }
 
 // Max sets the maximum value
 func (v *NumberValidator) Max(val float64) *NumberValidator {
-	v.maxVal = &val
+	v.maxVal, v.maxExclusive = &val, false
+	return v
+}
+
+// Gt requires number > val
+func (v *NumberValidator) Gt(val float64) *NumberValidator {
+	v.minVal, v.minExclusive = &val, true
+	return v
+}
+
+// Gte requires number >= val, like Min
+func (v *NumberValidator) Gte(val float64) *NumberValidator {
+	return v.Min(val)
+}
+
+// Lt requires number < val
+func (v *NumberValidator) Lt(val float64) *NumberValidator {
+	v.maxVal, v.maxExclusive = &val, true
 	return v
 }
 
+// Lte requires number <= val, like Max
+func (v *NumberValidator) Lte(val float64) *NumberValidator {
+	return v.Max(val)
+}
+
+// Between requires min <= number <= max
+func (v *NumberValidator) Between(min, max float64) *NumberValidator {
+	return v.Min(min).Max(max)
+}
+
 // Int requires the number to be an integer
 func (v *NumberValidator) Int() *NumberValidator {
 	v.isInt = true
@@ -90,6 +126,21 @@ func (v *NumberValidator) Finite() *NumberValidator {
 	return v
 }
 
+// AllowNaN explicitly accepts NaN. Once AllowNaN or AllowInf is set, the
+// non-finite values that were not allowed are rejected, as with Finite.
+// NaN skips the other numeric rules, which it cannot satisfy.
+func (v *NumberValidator) AllowNaN() *NumberValidator {
+	v.allowNaN = true
+	return v
+}
+
+// AllowInf explicitly accepts positive and negative infinity, rejecting NaN
+// unless AllowNaN is also set
+func (v *NumberValidator) AllowInf() *NumberValidator {
+	v.allowInf = true
+	return v
+}
+
 // Safe requires number to be within safe integer range
 func (v *NumberValidator) Safe() *NumberValidator {
 	v.isSafe = true
@@ -122,9 +173,24 @@ func (v *NumberValidator) Nullable() *NumberValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *NumberValidator) Nullish() *NumberValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
 // Default sets a default value if input is nil
 func (v *NumberValidator) Default(val float64) *NumberValidator {
-	v.defaultVal = &val
+	v.defaultVal, v.defaultFunc = &val, nil
+	return v
+}
+
+// DefaultFunc sets a function called for the default each time the input
+// is nil, such as a sequence number or random seed
+func (v *NumberValidator) DefaultFunc(fn func() float64) *NumberValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
 	return v
 }
 
@@ -137,15 +203,60 @@ func (v *NumberValidator) Refine(check func(float64) bool, message string) *Numb
 	return v
 }
 
+// RefineCtx adds a context-aware check, such as a database lookup. It runs
+// after all other rules pass and receives the context given to ParseCtx.
+func (v *NumberValidator) RefineCtx(check CheckFunc) *NumberValidator {
+	v.ctxRefinements = append(v.ctxRefinements, check)
+	return v
+}
+
+// SuperRefine adds a refinement that can report several issues, each with
+// its own path, code and message. It runs after all other rules pass.
+func (v *NumberValidator) SuperRefine(refine SuperRefineFunc) *NumberValidator {
+	v.superRefinements = append(v.superRefinements, refine)
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *NumberValidator) Examples(valid, invalid []any) *NumberValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value
 func (v *NumberValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse,
+// coercing it first when the parse asks for coercion
+func (v *NumberValidator) parseWithState(value any, st *parseState) ParseResult {
+	if st.opts.Coerce {
+		value = coerceNumber(value)
+	}
+	// Validate the default in place of a missing number
+	if value == nil && v.defaultVal != nil {
+		value = *v.defaultVal
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+
+	result := v.parseValue(value)
+	if num, ok := result.Value.(float64); ok && (math.IsNaN(num) || math.IsInf(num, 0)) {
+		st.meta.NonFinite = append(st.meta.NonFinite, st.currentPath())
+	}
+	if result.Ok && value != nil {
+		return runChecks(st.ctx, v.ctxRefinements, superRefine(st.ctx, v.superRefinements, result))
+	}
+	return result
+}
+
+// parseValue validates the input value against the synchronous rules
+func (v *NumberValidator) parseValue(value any) ParseResult {
 	// Handle nil values based on modifiers
 	if value == nil {
-		// If default is set, use it
-		if v.defaultVal != nil {
-			return Success(*v.defaultVal)
-		}
-
 		// If optional, nil is OK
 		if v.isOptional {
 			return Success(nil)
@@ -157,7 +268,7 @@ func (v *NumberValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected number, received null")
+		return FailureTypeMismatch("number", nil)
 	}
 
 	// Convert to float64
@@ -188,17 +299,21 @@ func (v *NumberValidator) Parse(value any) ParseResult {
 	case float64:
 		num = v
 	default:
-		return FailureMessage("Expected number, received " + typeof(value))
+		return FailureTypeMismatch("number", value)
 	}
 
-	// Check if finite (no Infinity or NaN)
-	if v.isFinite && (math.IsInf(num, 0) || math.IsNaN(num)) {
-		return FailureMessage("Number must be finite")
+	// Check if finite (no Infinity or NaN), unless explicitly allowed
+	finite := v.isFinite || v.allowNaN || v.allowInf
+	if finite && ((math.IsInf(num, 0) && !v.allowInf) || (math.IsNaN(num) && !v.allowNaN)) {
+		return FailureWithCode("Number must be finite", CodeNotFinite)
+	}
+	if math.IsNaN(num) && v.allowNaN {
+		return v.runRefinements(num)
 	}
 
 	// Check if integer
 	if v.isInt && num != math.Floor(num) {
-		return FailureMessage("Number must be an integer")
+		return FailureWithCode("Number must be an integer", CodeNotInteger)
 	}
 
 	// Check if safe integer
@@ -206,38 +321,60 @@ func (v *NumberValidator) Parse(value any) ParseResult {
 		const maxSafeInt = 9007199254740991  // 2^53 - 1
 		const minSafeInt = -9007199254740991 // -(2^53 - 1)
 		if num > maxSafeInt || num < minSafeInt {
-			return FailureMessage("Number must be within safe integer range")
+			return FailureWithCode("Number must be within safe integer range", CodeUnsafeInteger)
 		}
 	}
 
 	// Check minimum value
+	if v.minVal != nil && v.minExclusive && num <= *v.minVal {
+		return FailureWithParams(
+			fmt.Sprintf("Number must be greater than %v", *v.minVal),
+			CodeTooSmall,
+			map[string]any{"type": "number", "minimum": *v.minVal, "inclusive": false},
+		)
+	}
 	if v.minVal != nil && num < *v.minVal {
-		return FailureMessage(fmt.Sprintf("Number must be at least %v", *v.minVal))
+		return FailureWithParams(
+			fmt.Sprintf("Number must be at least %v", *v.minVal),
+			CodeTooSmall,
+			map[string]any{"type": "number", "minimum": *v.minVal},
+		)
 	}
 
 	// Check maximum value
+	if v.maxVal != nil && v.maxExclusive && num >= *v.maxVal {
+		return FailureWithParams(
+			fmt.Sprintf("Number must be less than %v", *v.maxVal),
+			CodeTooBig,
+			map[string]any{"type": "number", "maximum": *v.maxVal, "inclusive": false},
+		)
+	}
 	if v.maxVal != nil && num > *v.maxVal {
-		return FailureMessage(fmt.Sprintf("Number must be at most %v", *v.maxVal))
+		return FailureWithParams(
+			fmt.Sprintf("Number must be at most %v", *v.maxVal),
+			CodeTooBig,
+			map[string]any{"type": "number", "maximum": *v.maxVal},
+		)
 	}
 
 	// Check positive
 	if v.isPositive && num <= 0 {
-		return FailureMessage("Number must be positive")
+		return FailureWithCode("Number must be positive", CodeNotPositive)
 	}
 
 	// Check negative
 	if v.isNegative && num >= 0 {
-		return FailureMessage("Number must be negative")
+		return FailureWithCode("Number must be negative", CodeNotNegative)
 	}
 
 	// Check non-negative
 	if v.isNonNegative && num < 0 {
-		return FailureMessage("Number must be non-negative")
+		return FailureWithCode("Number must be non-negative", CodeNotNonNegative)
 	}
 
 	// Check non-positive
 	if v.isNonPositive && num > 0 {
-		return FailureMessage("Number must be non-positive")
+		return FailureWithCode("Number must be non-positive", CodeNotNonPositive)
 	}
 
 	// Check multiple of
@@ -245,14 +382,22 @@ func (v *NumberValidator) Parse(value any) ParseResult {
 		remainder := math.Mod(num, *v.multipleOf)
 		// Use small epsilon for floating point comparison
 		if math.Abs(remainder) > 1e-10 && math.Abs(remainder-*v.multipleOf) > 1e-10 {
-			return FailureMessage(fmt.Sprintf("Number must be a multiple of %v", *v.multipleOf))
+			return FailureWithParams(
+				fmt.Sprintf("Number must be a multiple of %v", *v.multipleOf),
+				CodeNotMultipleOf,
+				map[string]any{"multipleOf": *v.multipleOf},
+			)
 		}
 	}
 
-	// Run custom refinements
+	return v.runRefinements(num)
+}
+
+// runRefinements runs the custom refinements on a number that passed the other rules
+func (v *NumberValidator) runRefinements(num float64) ParseResult {
 	for _, refinement := range v.refinements {
 		if !refinement.Check(num) {
-			return FailureMessage(refinement.Message)
+			return FailureWithCode(refinement.Message, CodeCustom)
 		}
 	}
 
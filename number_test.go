@@ -262,6 +262,48 @@ func TestNumberFinite(t *testing.T) {
 	}
 }
 
+// Test AllowNaN and AllowInf
+func TestNumberAllowNonFinite(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *NumberValidator
+		nan    bool
+		inf    bool
+	}{
+		{"default", Number(), true, true},
+		{"nan", Number().AllowNaN(), true, false},
+		{"inf", Number().AllowInf(), false, true},
+		{"both", Number().AllowNaN().AllowInf(), true, true},
+		{"finite with nan", Number().Finite().AllowNaN(), true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schema.Parse(math.NaN()).Ok; got != tt.nan {
+				t.Errorf("Expected NaN ok=%v, got %v", tt.nan, got)
+			}
+			if got := tt.schema.Parse(math.Inf(-1)).Ok; got != tt.inf {
+				t.Errorf("Expected -Infinity ok=%v, got %v", tt.inf, got)
+			}
+			if !tt.schema.Parse(1.5).Ok {
+				t.Error("Expected finite numbers to pass")
+			}
+		})
+	}
+
+	// NaN skips the rules it cannot satisfy, and is flagged in the metadata
+	result := Number().Int().Min(0).AllowNaN().Parse(math.NaN())
+	if !result.Ok || len(result.Meta.NonFinite) != 1 {
+		t.Errorf("Expected NaN to pass and be flagged, got %v %v", result.Errors, result.Meta.NonFinite)
+	}
+	if result := Number().AllowInf().Parse(math.NaN()); result.Ok || result.Errors[0].Code != CodeNotFinite {
+		t.Errorf("Expected not_finite error, got %v", result.Errors)
+	}
+	if Number().AllowInf().Max(10).Parse(math.Inf(1)).Ok {
+		t.Error("Expected rules to apply to infinity")
+	}
+}
+
 // Test Safe
 func TestNumberSafe(t *testing.T) {
 	schema := Number().Safe()
@@ -336,6 +378,24 @@ func TestNumberNullable(t *testing.T) {
 }
 
 // Test Default
+// Test the default is checked against the number's rules
+func TestNumberDefaultRules(t *testing.T) {
+	if result := Number().Min(10).Default(5).Parse(nil); result.Ok || result.Errors[0].Code != CodeTooSmall {
+		t.Errorf("Expected a default below Min(10) to fail, got %v", result)
+	}
+	refined := Number().Default(3).SuperRefine(func(value any, ctx *RefinementCtx) {
+		if value.(float64) < 10 {
+			ctx.AddIssue(ValidationError{Message: "Too low"})
+		}
+	})
+	if refined.Parse(nil).Ok {
+		t.Error("Expected SuperRefine to check the default")
+	}
+	if Number().Min(10).DefaultFunc(func() float64 { return 5 }).Parse(nil).Ok {
+		t.Error("Expected a generated default below Min(10) to fail")
+	}
+}
+
 func TestNumberDefault(t *testing.T) {
 	schema := Number().Default(99)
 
@@ -409,3 +469,46 @@ func TestNumberChained(t *testing.T) {
 		t.Error("Expected 150 to fail Max(100)")
 	}
 }
+
+// Test exclusive and inclusive bounds
+func TestNumberExclusiveBounds(t *testing.T) {
+	schema := Number().Gt(0).Lt(1)
+
+	for _, n := range []float64{0.001, 0.5, 0.999} {
+		if !schema.Parse(n).Ok {
+			t.Errorf("Expected %v to pass Gt(0).Lt(1)", n)
+		}
+	}
+
+	result := schema.Parse(0)
+	if result.Ok || result.Errors[0].Code != CodeTooSmall || result.Errors[0].Params["inclusive"] != false {
+		t.Errorf("Expected 0 to fail Gt(0) as exclusive, got %v", result.Errors)
+	}
+	if got := result.Errors[0].Message; got != "Number must be greater than 0" {
+		t.Errorf("Unexpected message %q", got)
+	}
+	if got := result.Errors[0].Translate("en"); got != "Number must be greater than 0" {
+		t.Errorf("Unexpected translated message %q", got)
+	}
+
+	result = schema.Parse(1)
+	if result.Ok || result.Errors[0].Code != CodeTooBig {
+		t.Errorf("Expected 1 to fail Lt(1), got %v", result.Errors)
+	}
+
+	between := Number().Between(1, 10)
+	if !between.Parse(1).Ok || !between.Parse(10).Ok {
+		t.Error("Expected Between to include its bounds")
+	}
+	if between.Parse(0.5).Ok || between.Parse(10.5).Ok {
+		t.Error("Expected Between to reject values outside its bounds")
+	}
+
+	// The last bound set wins
+	if !Number().Gt(5).Gte(5).Parse(5).Ok {
+		t.Error("Expected Gte to replace an earlier Gt")
+	}
+	if Number().Lte(5).Lt(5).Parse(5).Ok {
+		t.Error("Expected Lt to replace an earlier Lte")
+	}
+}
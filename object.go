@@ -1,14 +1,55 @@
 package zogo
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
 // ObjectValidator validates object/map values with nested schemas
 type ObjectValidator struct {
 	schema        Schema
-	unknownFields string // "strict", "passthrough", or "strip"
+	unknownFields string              // "strict", "passthrough", "strip" or "catchall"
+	catchall      Validator           // Validates unknown fields in "catchall" mode
+	aliases       map[string]string   // Alias key to field name
+	normalizeKeys func(string) string // Applied to input keys and field names before matching
+
+	conditions       []objectCondition
+	dependencies     []objectDependency
+	keyOrder         []string
+	refinements      []ObjectRefinement
+	superRefinements []SuperRefineFunc
 
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  map[string]interface{}
+	defaultFunc func() map[string]interface{} // Set by DefaultFunc, called on each parse
+
+	examples schemaExamples // Declared with Examples
+}
+
+// ObjectRefinement holds cross-field validation logic for objects
+type ObjectRefinement struct {
+	Check   func(map[string]interface{}) bool
+	Message string
+	Path    string // Field path the error is reported at; empty for the object itself
+}
+
+// objectCondition switches field schemas on the value of another field
+type objectCondition struct {
+	field     string
+	condition Validator
+	then      Schema
+	otherwise Schema
+}
+
+// objectDependency is a presence rule between fields
+type objectDependency struct {
+	code   ErrorCode // CodeRequiredWith, CodeRequiredWithout or CodeMutuallyExclusive
+	field  string
+	fields []string
 }
 
 // Object creates a new object validator with the given schema
@@ -37,6 +78,421 @@ func (v *ObjectValidator) Strip() *ObjectValidator {
 	return v
 }
 
+// Catchall keeps unknown fields, validating each one with validator. It
+// replaces Strict, Passthrough and Strip, and is replaced by them in turn:
+//
+//	labels := zogo.Object(zogo.Schema{"name": zogo.String()}).Catchall(zogo.String())
+func (v *ObjectValidator) Catchall(validator Validator) *ObjectValidator {
+	v.unknownFields = "catchall"
+	v.catchall = validator
+	return v
+}
+
+// Alias accepts each alias as another key for field, such as "user_name"
+// for "userName". The parsed object holds the value under field. An alias
+// is an error if the input also has the field or another of its aliases,
+// and errors for an aliased value are reported at the key the input used.
+func (v *ObjectValidator) Alias(field string, aliases ...string) *ObjectValidator {
+	if v.aliases == nil {
+		v.aliases = map[string]string{}
+	}
+	for _, alias := range aliases {
+		v.aliases[alias] = field
+	}
+	return v
+}
+
+// NormalizeKeys matches input keys to fields after converting both with
+// normalize, so "EMAIL" matches "email" with strings.ToLower and "user_name"
+// matches "userName" with CamelCase or SnakeCase. The parsed object holds
+// matched values under the schema's field names and other keys normalized.
+// Keys that normalize to the same field are reported as duplicates.
+//
+//	zogo.Object(zogo.Schema{"userName": zogo.String()}).NormalizeKeys(zogo.SnakeCase)
+func (v *ObjectValidator) NormalizeKeys(normalize func(string) string) *ObjectValidator {
+	v.normalizeKeys = normalize
+	return v
+}
+
+// resolveKeys returns a copy of the input with keys normalized and aliases
+// renamed to their fields, and the input key of each renamed field
+func (v *ObjectValidator) resolveKeys(objMap map[string]interface{}) (map[string]interface{}, map[string]string, ValidationErrors) {
+	normalize := v.normalizeKeys
+	if normalize == nil {
+		normalize = func(key string) string { return key }
+	}
+
+	// Map the normalized names of fields and aliases to the fields
+	fields := map[string]string{}
+	addFields := func(schema Schema) {
+		for name := range schema {
+			fields[normalize(name)] = name
+		}
+	}
+	addFields(v.schema)
+	for _, condition := range v.conditions {
+		addFields(condition.then)
+		addFields(condition.otherwise)
+	}
+	for alias, field := range v.aliases {
+		fields[normalize(alias)] = field
+	}
+
+	return renameKeys(objMap, func(key string) string {
+		if _, isField := v.schema[key]; isField {
+			return key
+		}
+		key = normalize(key)
+		if field, ok := fields[key]; ok {
+			return field
+		}
+		return key
+	})
+}
+
+// Extend returns a copy of the schema with the given fields added. Fields
+// that already exist are replaced, so an extension can tighten a base
+// field. The original schema is unchanged:
+//
+//	user := auditFields.Extend(zogo.Schema{"name": zogo.String()})
+func (v *ObjectValidator) Extend(fields Schema) *ObjectValidator {
+	c := v.clone()
+	for name, field := range fields {
+		c.schema[name] = field
+	}
+	return c
+}
+
+// Merge combines two object schemas into a new one. Fields in b replace
+// fields of the same name in a, and b's unknown field mode (Strict,
+// Passthrough, Strip or Catchall) applies. The When, dependency and refinement rules
+// of both run; a's key order is kept unless b requires one. Neither input is
+// changed.
+func Merge(a, b *ObjectValidator) *ObjectValidator {
+	c := a.Extend(b.schema)
+	c.unknownFields = b.unknownFields
+	c.catchall = b.catchall
+	if b.normalizeKeys != nil {
+		c.normalizeKeys = b.normalizeKeys
+	}
+	for alias, field := range b.aliases {
+		c.aliases[alias] = field
+	}
+	c.conditions = append(c.conditions, b.conditions...)
+	c.dependencies = append(c.dependencies, b.dependencies...)
+	c.refinements = append(c.refinements, b.refinements...)
+	c.superRefinements = append(c.superRefinements, b.superRefinements...)
+	if len(b.keyOrder) > 0 {
+		c.keyOrder = b.keyOrder
+	}
+	return c
+}
+
+// Keyof returns an Enum of the schema's field names, in sorted order, for
+// parameters that name a field of the object such as "sortBy". Fields added
+// by When are not included.
+//
+//	sortBy := user.Keyof().Optional()
+func (v *ObjectValidator) Keyof() *EnumValidator {
+	names := make([]string, 0, len(v.schema))
+	for name := range v.schema {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	values := make([]interface{}, len(names))
+	for i, name := range names {
+		values[i] = name
+	}
+	return Enum(values)
+}
+
+// RequiredAll returns a copy of the schema in which every field must be
+// present and not null, however its validator was declared (Optional,
+// Nullable or with a Default). Fields in When schemas are included. The
+// original schema is unchanged, so a lenient base schema can be reused in a
+// strict context:
+//
+//	strictUser := user.RequiredAll()
+func (v *ObjectValidator) RequiredAll() *ObjectValidator {
+	c := v.clone()
+	c.schema = requireFields(v.schema)
+	for i, condition := range c.conditions {
+		condition.then = requireFields(condition.then)
+		condition.otherwise = requireFields(condition.otherwise)
+		c.conditions[i] = condition
+	}
+	return c
+}
+
+// clone returns a copy of the validator whose rules can be changed without
+// affecting the original
+func (v *ObjectValidator) clone() *ObjectValidator {
+	c := *v
+	c.schema = make(Schema, len(v.schema))
+	for name, field := range v.schema {
+		c.schema[name] = field
+	}
+	c.aliases = make(map[string]string, len(v.aliases))
+	for alias, field := range v.aliases {
+		c.aliases[alias] = field
+	}
+	c.conditions = slices.Clone(v.conditions)
+	c.dependencies = slices.Clip(v.dependencies)
+	c.keyOrder = slices.Clip(v.keyOrder)
+	c.refinements = slices.Clip(v.refinements)
+	c.superRefinements = slices.Clip(v.superRefinements)
+	return &c
+}
+
+// requireFields wraps the validators of a schema so that nil is rejected
+func requireFields(schema Schema) Schema {
+	if schema == nil {
+		return nil
+	}
+	required := make(Schema, len(schema))
+	for name, field := range schema {
+		if _, ok := field.(*requiredValidator); !ok {
+			field = &requiredValidator{validator: field}
+		}
+		required[name] = field
+	}
+	return required
+}
+
+// requiredValidator rejects nil before validating with its validator
+type requiredValidator struct {
+	validator Validator
+}
+
+// Parse validates the input value
+func (v *requiredValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *requiredValidator) parseWithState(value any, st *parseState) ParseResult {
+	if value != nil {
+		return st.parse(v.validator, value)
+	}
+	// Report the validator's own error for nil if it has one
+	if result := st.isolate().parse(v.validator, nil); !result.Ok {
+		return result
+	}
+	return FailureTypeMismatch(expectedType(v.validator), nil)
+}
+
+// expectedType names the type a validator accepts, for type mismatch errors
+func expectedType(validator Validator) string {
+	switch validator := validator.(type) {
+	case *StringValidator:
+		return "string"
+	case *NumberValidator, *BigIntValidator, *DecimalValidator:
+		return "number"
+	case *BooleanValidator:
+		return "boolean"
+	case *BytesValidator:
+		return "bytes"
+	case *NullValidator:
+		return "null"
+	case *NeverValidator:
+		return "never"
+	case *NaNValidator:
+		return "NaN"
+	case *DateValidator:
+		return "date"
+	case *ObjectValidator, *RecordValidator, *DiscriminatedUnionValidator:
+		return "object"
+	case *ArrayValidator, *TupleValidator:
+		return "array"
+	case *requiredValidator:
+		return expectedType(validator.validator)
+	case *SensitiveValidator:
+		return expectedType(validator.validator)
+	}
+	return "value"
+}
+
+// When makes field rules depend on another field. If the value of field
+// passes condition, the fields in then replace or extend the object's
+// schema; otherwise the fields in otherwise do (which may be nil):
+//
+//	Object(Schema{"type": Enum(...), "vatNumber": String().Optional()}).
+//		When("type", Literal("company"), Schema{"vatNumber": String().Min(8)}, nil)
+func (v *ObjectValidator) When(field string, condition Validator, then, otherwise Schema) *ObjectValidator {
+	v.conditions = append(v.conditions, objectCondition{
+		field:     field,
+		condition: condition,
+		then:      then,
+		otherwise: otherwise,
+	})
+	return v
+}
+
+// schemaFor returns the field schema that applies to an input, taking
+// conditions into account
+func (v *ObjectValidator) schemaFor(objMap map[string]interface{}, st *parseState) Schema {
+	if len(v.conditions) == 0 {
+		return v.schema
+	}
+
+	schema := make(Schema, len(v.schema))
+	for name, field := range v.schema {
+		schema[name] = field
+	}
+	for _, cond := range v.conditions {
+		fields := cond.otherwise
+		if st.fork().parse(cond.condition, objMap[cond.field]).Ok {
+			fields = cond.then
+		}
+		for name, field := range fields {
+			schema[name] = field
+		}
+	}
+	return schema
+}
+
+// RequiredWith requires field whenever any of the other fields is present
+// (not missing or nil), e.g. RequiredWith("billingAddress", "paymentMethod")
+func (v *ObjectValidator) RequiredWith(field string, others ...string) *ObjectValidator {
+	v.dependencies = append(v.dependencies, objectDependency{code: CodeRequiredWith, field: field, fields: others})
+	return v
+}
+
+// RequiredWithout requires field whenever any of the other fields is missing,
+// e.g. RequiredWithout("email", "phone") for a contact method
+func (v *ObjectValidator) RequiredWithout(field string, others ...string) *ObjectValidator {
+	v.dependencies = append(v.dependencies, objectDependency{code: CodeRequiredWithout, field: field, fields: others})
+	return v
+}
+
+// MutuallyExclusive allows at most one of the fields to be present; each
+// present field gets an error otherwise
+func (v *ObjectValidator) MutuallyExclusive(fields ...string) *ObjectValidator {
+	v.dependencies = append(v.dependencies, objectDependency{code: CodeMutuallyExclusive, fields: fields})
+	return v
+}
+
+// checkDependencies checks the presence rules against the input, skipping
+// fields that already have errors
+func (v *ObjectValidator) checkDependencies(objMap map[string]interface{}, errors ValidationErrors) ValidationErrors {
+	present := func(field string) bool {
+		return objMap[field] != nil
+	}
+	report := func(field, message string, code ErrorCode, params map[string]any) {
+		if !errors.HasPath(field) {
+			errors = append(errors, ValidationError{Path: field, Message: message, Code: code, Params: params})
+		}
+	}
+
+	for _, dep := range v.dependencies {
+		switch dep.code {
+		case CodeRequiredWith, CodeRequiredWithout:
+			if present(dep.field) {
+				continue
+			}
+			var triggers []string
+			for _, other := range dep.fields {
+				if present(other) == (dep.code == CodeRequiredWith) {
+					triggers = append(triggers, other)
+				}
+			}
+			if len(triggers) == 0 {
+				continue
+			}
+			others := strings.Join(triggers, ", ")
+			message := "Required when " + others + " is present"
+			if dep.code == CodeRequiredWithout {
+				message = "Required when " + others + " is missing"
+			}
+			report(dep.field, message, dep.code, map[string]any{"others": others})
+		case CodeMutuallyExclusive:
+			var set []string
+			for _, field := range dep.fields {
+				if present(field) {
+					set = append(set, field)
+				}
+			}
+			if len(set) < 2 {
+				continue
+			}
+			fields := strings.Join(dep.fields, ", ")
+			for _, field := range set {
+				report(field, "Only one of "+fields+" may be set", dep.code, map[string]any{"fields": fields})
+			}
+		}
+	}
+	return errors
+}
+
+// RequireKeyOrder requires the given keys, where present, to appear in this
+// order in the raw JSON, for protocols that sign the payload as written
+// (such as some payment callbacks). Decoded maps have no key order, so the
+// check only applies to input decoded by ParseJSON.
+func (v *ObjectValidator) RequireKeyOrder(keys []string) *ObjectValidator {
+	v.keyOrder = keys
+	return v
+}
+
+// checkKeyOrder checks the order of keys recorded by ParseJSON, reporting
+// the first key that appears too early
+func (v *ObjectValidator) checkKeyOrder(st *parseState, errors ValidationErrors) ValidationErrors {
+	raw, ok := st.keyOrder[st.currentPath()]
+	if !ok {
+		return errors
+	}
+
+	positions := make(map[string]int, len(raw))
+	for i := len(raw) - 1; i >= 0; i-- {
+		positions[raw[i]] = i
+	}
+
+	last := -1
+	for _, key := range v.keyOrder {
+		position, present := positions[key]
+		if !present {
+			continue
+		}
+		if position < last {
+			order := strings.Join(v.keyOrder, ", ")
+			return append(errors, ValidationError{
+				Path:    key,
+				Message: "Keys must appear in the order: " + order,
+				Code:    CodeInvalidKeyOrder,
+				Params:  map[string]any{"order": order},
+			})
+		}
+		last = position
+	}
+	return errors
+}
+
+// Refine adds cross-field validation logic, such as checking that two fields
+// match. It runs after all fields pass and receives the parsed object.
+func (v *ObjectValidator) Refine(check func(map[string]interface{}) bool, message string) *ObjectValidator {
+	return v.RefineAt("", check, message)
+}
+
+// RefineAt adds cross-field validation logic whose error is reported at a
+// field path, e.g. "passwordConfirm" or "dates.end"
+func (v *ObjectValidator) RefineAt(path string, check func(map[string]interface{}) bool, message string) *ObjectValidator {
+	v.refinements = append(v.refinements, ObjectRefinement{
+		Check:   check,
+		Message: message,
+		Path:    path,
+	})
+	return v
+}
+
+// SuperRefine adds a refinement over the whole object for rules involving
+// several fields. The callback receives the parsed map[string]interface{}
+// and can report any number of issues, each at its own field path. It runs
+// after all fields pass.
+func (v *ObjectValidator) SuperRefine(refine SuperRefineFunc) *ObjectValidator {
+	v.superRefinements = append(v.superRefinements, refine)
+	return v
+}
+
 // Required marks the field as required
 func (v *ObjectValidator) Required() *ObjectValidator {
 	v.isRequired = true
@@ -57,8 +513,55 @@ func (v *ObjectValidator) Nullable() *ObjectValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *ObjectValidator) Nullish() *ObjectValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Default sets an object to validate in place of a missing one. It runs
+// through the schema like any input, so the defaults of its fields apply:
+//
+//	zogo.Object(zogo.Schema{
+//		"retries": zogo.Number().Default(3),
+//		"backoff": zogo.String().Default("exponential"),
+//	}).Default(map[string]interface{}{})
+func (v *ObjectValidator) Default(val map[string]interface{}) *ObjectValidator {
+	v.defaultVal, v.defaultFunc = val, nil
+	return v
+}
+
+// DefaultFunc sets a function building the object to validate in place of
+// a missing one, called on each parse, for defaults computed at parse time
+func (v *ObjectValidator) DefaultFunc(fn func() map[string]interface{}) *ObjectValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *ObjectValidator) Examples(valid, invalid []any) *ObjectValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value
 func (v *ObjectValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *ObjectValidator) parseWithState(value any, st *parseState) ParseResult {
+	// Validate a copy of the default in place of a missing object
+	if value == nil && v.defaultVal != nil {
+		value = copyValue(v.defaultVal)
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+
 	// Handle nil values based on modifiers
 	if value == nil {
 		// If optional, nil is OK
@@ -72,13 +575,13 @@ func (v *ObjectValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected object, received null")
+		return FailureTypeMismatch("object", nil)
 	}
 
 	// Check if value is a map
 	objMap, ok := value.(map[string]interface{})
 	if !ok {
-		return FailureMessage("Expected object, received " + typeof(value))
+		return FailureTypeMismatch("object", value)
 	}
 
 	// Result object to build
@@ -87,8 +590,20 @@ func (v *ObjectValidator) Parse(value any) ParseResult {
 	// Track all errors
 	var errors ValidationErrors
 
+	// Rename normalized and aliased keys to their fields
+	var inputKeys map[string]string
+	if len(v.aliases) > 0 || v.normalizeKeys != nil {
+		objMap, inputKeys, errors = v.resolveKeys(objMap)
+		st.errors += len(errors)
+	}
+
 	// Validate each field in the schema
-	for fieldName, fieldValidator := range v.schema {
+	schema := v.schemaFor(objMap, st)
+	for fieldName, fieldValidator := range schema {
+		if st.stopped() {
+			break
+		}
+
 		fieldValue, exists := objMap[fieldName]
 
 		// If field doesn't exist, pass nil to validator
@@ -97,17 +612,20 @@ func (v *ObjectValidator) Parse(value any) ParseResult {
 			fieldValue = nil
 		}
 
+		// Report errors at the key the input used, which may be an alias
+		key := fieldName
+		if inputKey, aliased := inputKeys[fieldName]; aliased {
+			key = inputKey
+		}
+
 		// Validate the field
-		fieldResult := fieldValidator.Parse(fieldValue)
+		fieldResult := st.parseField(key, fieldValidator, fieldValue)
 
 		if !fieldResult.Ok {
 			// Add field path to errors
 			for _, err := range fieldResult.Errors {
-				errors = append(errors, ValidationError{
-					Path:    fieldName + prependPath(err.Path),
-					Message: err.Message,
-					Value:   err.Value,
-				})
+				err.nest(key, pathToken{name: key})
+				errors = append(errors, err)
 			}
 		} else {
 			// Only add to result if value is not nil
@@ -118,31 +636,130 @@ func (v *ObjectValidator) Parse(value any) ParseResult {
 		}
 	}
 
+	// Strict schemas list every unknown key in each error
+	var unknownKeys []string
+	if v.unknownFields == "strict" {
+		for fieldName := range objMap {
+			if _, inSchema := schema[fieldName]; !inSchema {
+				unknownKeys = append(unknownKeys, fieldName)
+			}
+		}
+		slices.Sort(unknownKeys)
+	}
+
 	// Handle unknown fields (fields in objMap but not in schema)
 	for fieldName, fieldValue := range objMap {
+		if st.stopped() {
+			break
+		}
+
 		// Check if field is in schema
-		if _, inSchema := v.schema[fieldName]; !inSchema {
+		if _, inSchema := schema[fieldName]; !inSchema {
 			switch v.unknownFields {
 			case "strict":
-				errors = append(errors, ValidationError{
-					Path:    fieldName,
-					Message: "Unknown field",
-					Value:   fieldValue,
-				})
+				errors = append(errors, unknownFieldError(fieldName, fieldValue, unknownKeys, schema, objMap))
+				st.errors++
 			case "passthrough":
 				result[fieldName] = fieldValue
+			case "catchall":
+				fieldResult := st.parseField(fieldName, v.catchall, fieldValue)
+				if !fieldResult.Ok {
+					for _, err := range fieldResult.Errors {
+						err.nest(fieldName, pathToken{name: fieldName})
+						errors = append(errors, err)
+					}
+				} else {
+					result[fieldName] = fieldResult.Value
+				}
 			case "strip":
 				// Do nothing - field is stripped
 			}
 		}
 	}
 
+	// Check presence rules between fields and the order of keys in the raw input
+	if (len(v.dependencies) > 0 || len(v.keyOrder) > 0) && !st.stopped() {
+		before := len(errors)
+		errors = v.checkDependencies(objMap, errors)
+		if len(v.keyOrder) > 0 && st.keyOrder != nil {
+			errors = v.checkKeyOrder(st, errors)
+		}
+		st.errors += len(errors) - before
+	}
+
 	// Return errors if any
 	if len(errors) > 0 {
 		return Failure(errors...)
 	}
 
-	return Success(result)
+	// Run cross-field refinements on the parsed object
+	for _, refinement := range v.refinements {
+		if !refinement.Check(result) {
+			errors = append(errors, ValidationError{
+				Path:    refinement.Path,
+				Message: refinement.Message,
+				Code:    CodeCustom,
+			})
+		}
+	}
+	if len(errors) > 0 {
+		return Failure(errors...)
+	}
+
+	return superRefine(st.ctx, v.superRefinements, Success(result))
+}
+
+// unknownFieldError reports a field a Strict schema does not allow, with
+// all the unknown keys and, for a likely typo, the field that was meant
+func unknownFieldError(key string, value any, unknownKeys []string, schema Schema, objMap map[string]interface{}) ValidationError {
+	err := ValidationError{
+		Message: "Unknown field",
+		Value:   value,
+		Code:    CodeUnrecognizedKeys,
+		Params:  map[string]any{"key": key, "keys": unknownKeys},
+	}.atKey(key)
+	if suggestion := suggestField(key, schema, objMap); suggestion != "" {
+		err.Message = fmt.Sprintf("Unknown field '%s', did you mean '%s'?", key, suggestion)
+		err.Params["type"] = "typo"
+		err.Params["suggestion"] = suggestion
+	}
+	return err
+}
+
+// suggestField returns the field of the schema missing from the input that
+// is closest to key, if it is close enough to be a likely typo
+func suggestField(key string, schema Schema, objMap map[string]interface{}) string {
+	best, bestDistance := "", len([]rune(key))/3+1
+	for field := range schema {
+		if _, present := objMap[field]; present {
+			continue
+		}
+		distance := keyDistance(key, field)
+		if distance < bestDistance || (distance == bestDistance && field < best) {
+			best, bestDistance = field, distance
+		}
+	}
+	return best
+}
+
+// copyValue returns a copy of a value with its maps and slices copied too,
+// so results built from a default never share them with it
+func copyValue(value any) any {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			copied[key] = copyValue(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(value))
+		for i, item := range value {
+			copied[i] = copyValue(item)
+		}
+		return copied
+	}
+	return value
 }
 
 // Helper function to prepend path separator
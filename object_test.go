@@ -1,7 +1,10 @@
 package zogo
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Test basic object validation
@@ -368,3 +371,444 @@ func TestObjectDeeplyNestedErrorPath(t *testing.T) {
 		t.Errorf("Expected error path 'user.profile.email', got '%s'", result.Errors[0].Path)
 	}
 }
+
+// Test cross-field refinements on objects
+func TestObjectRefine(t *testing.T) {
+	schema := Object(Schema{
+		"password":        String().Min(8),
+		"passwordConfirm": String(),
+	}).RefineAt("passwordConfirm", func(obj map[string]interface{}) bool {
+		return obj["password"] == obj["passwordConfirm"]
+	}, "Passwords must match")
+
+	result := schema.Parse(map[string]interface{}{"password": "secret123", "passwordConfirm": "secret123"})
+	if !result.Ok {
+		t.Errorf("Expected matching passwords to pass: %v", result.Errors)
+	}
+
+	result = schema.Parse(map[string]interface{}{"password": "secret123", "passwordConfirm": "secret124"})
+	if result.Ok {
+		t.Fatal("Expected mismatched passwords to fail")
+	}
+	err := result.Errors[0]
+	if err.Path != "passwordConfirm" || err.Message != "Passwords must match" || err.Code != CodeCustom {
+		t.Errorf("Expected error at passwordConfirm, got %+v", err)
+	}
+
+	// Refinements don't run until the fields themselves are valid
+	result = schema.Parse(map[string]interface{}{"password": "short", "passwordConfirm": "other"})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "password" {
+		t.Errorf("Expected only the field error, got %v", result.Errors)
+	}
+}
+
+// Test object refinements report every failure, nested under the object's path
+func TestObjectRefineNested(t *testing.T) {
+	dates := Object(Schema{
+		"start": Date(),
+		"end":   Date(),
+	}).RefineAt("end", func(obj map[string]interface{}) bool {
+		return obj["end"].(time.Time).After(obj["start"].(time.Time))
+	}, "End date must be after start date").Refine(func(obj map[string]interface{}) bool {
+		return obj["end"].(time.Time).Sub(obj["start"].(time.Time)) < 30*24*time.Hour
+	}, "Booking must be shorter than 30 days")
+
+	schema := Object(Schema{"booking": dates})
+	result := schema.Parse(map[string]interface{}{
+		"booking": map[string]interface{}{"start": "2025-06-10T00:00:00Z", "end": "2025-05-01T00:00:00Z"},
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "booking.end" {
+		t.Errorf("Expected error at booking.end, got %v", result.Errors)
+	}
+
+	result = schema.Parse(map[string]interface{}{
+		"booking": map[string]interface{}{"start": "2025-06-10T00:00:00Z", "end": "2025-09-01T00:00:00Z"},
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "booking" {
+		t.Errorf("Expected object-level error at booking, got %v", result.Errors)
+	}
+}
+
+// Test conditional field rules with When
+func TestObjectWhen(t *testing.T) {
+	schema := Object(Schema{
+		"type":      Enum([]interface{}{"person", "company"}),
+		"name":      String(),
+		"vatNumber": String().Optional(),
+	}).When("type", Literal("company"),
+		Schema{"vatNumber": String().Min(8)},
+		Schema{"birthDate": Date()},
+	).Strict()
+
+	company := map[string]interface{}{"type": "company", "name": "Acme", "vatNumber": "DE123456789"}
+	if result := schema.Parse(company); !result.Ok {
+		t.Errorf("Expected company with VAT number to pass: %v", result.Errors)
+	}
+
+	result := schema.Parse(map[string]interface{}{"type": "company", "name": "Acme"})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "vatNumber" {
+		t.Errorf("Expected missing vatNumber error for companies, got %v", result.Errors)
+	}
+
+	person := map[string]interface{}{"type": "person", "name": "Ada", "birthDate": "1815-12-10T00:00:00Z"}
+	if result := schema.Parse(person); !result.Ok {
+		t.Errorf("Expected person with birth date to pass: %v", result.Errors)
+	}
+
+	// Fields from the branch that doesn't apply are unknown to a strict object
+	result = schema.Parse(map[string]interface{}{"type": "company", "name": "Acme", "vatNumber": "DE123456789", "birthDate": "2000-01-01T00:00:00Z"})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "birthDate" || result.Errors[0].Code != CodeUnrecognizedKeys {
+		t.Errorf("Expected birthDate to be unknown for companies, got %v", result.Errors)
+	}
+
+	// An invalid condition field takes the otherwise branch without extra errors
+	result = schema.Parse(map[string]interface{}{"type": 42, "name": "Ada"})
+	if !result.Errors.HasPath("type") || !result.Errors.HasPath("birthDate") || len(result.Errors) != 2 {
+		t.Errorf("Expected type and birthDate errors, got %v", result.Errors)
+	}
+}
+
+// Test RequiredWith and RequiredWithout dependencies
+func TestObjectRequiredWith(t *testing.T) {
+	schema := Object(Schema{
+		"paymentMethod":  String().Optional(),
+		"billingAddress": String().Optional(),
+		"email":          String().Email().Optional(),
+		"phone":          String().Optional(),
+	}).RequiredWith("billingAddress", "paymentMethod").RequiredWithout("email", "phone")
+
+	if result := schema.Parse(map[string]interface{}{"email": "a@example.com"}); !result.Ok {
+		t.Errorf("Expected object without payment method to pass: %v", result.Errors)
+	}
+
+	result := schema.Parse(map[string]interface{}{"paymentMethod": "card", "phone": "+15555550100"})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected one dependency error, got %v", result.Errors)
+	}
+	err := result.Errors[0]
+	if err.Path != "billingAddress" || err.Code != CodeRequiredWith || err.Message != "Required when paymentMethod is present" {
+		t.Errorf("Unexpected RequiredWith error: %+v", err)
+	}
+
+	result = schema.Parse(map[string]interface{}{})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "email" || result.Errors[0].Code != CodeRequiredWithout {
+		t.Errorf("Expected email to be required without phone, got %v", result.Errors)
+	}
+
+	// Fields with their own errors don't get a dependency error too
+	result = schema.Parse(map[string]interface{}{"email": "nope"})
+	if len(result.Errors) != 1 || result.Errors[0].Code != CodeInvalidEmail {
+		t.Errorf("Expected only the email format error, got %v", result.Errors)
+	}
+}
+
+// Test MutuallyExclusive reports every present field
+func TestObjectMutuallyExclusive(t *testing.T) {
+	schema := Object(Schema{
+		"email": String().Optional(),
+		"phone": String().Optional(),
+		"fax":   String().Optional(),
+	}).MutuallyExclusive("email", "phone", "fax")
+
+	if result := schema.Parse(map[string]interface{}{"phone": "+15555550100", "fax": nil}); !result.Ok {
+		t.Errorf("Expected a single contact to pass: %v", result.Errors)
+	}
+
+	result := schema.Parse(map[string]interface{}{"email": "a@example.com", "fax": "+15555550100"})
+	if len(result.Errors) != 2 || !result.Errors.HasPath("email") || !result.Errors.HasPath("fax") {
+		t.Fatalf("Expected errors on email and fax, got %v", result.Errors)
+	}
+	if err := result.Errors[0]; err.Code != CodeMutuallyExclusive || err.Message != "Only one of email, phone, fax may be set" {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+}
+
+// Test RequiredAll requires every field without changing the original schema
+func TestObjectRequiredAll(t *testing.T) {
+	base := Object(Schema{
+		"name":     String().Optional(),
+		"nickname": String().Nullable(),
+		"role":     String().Default("user"),
+		"age":      Number(),
+	}).When("age", Number().Min(18), Schema{"email": String().Optional()}, nil)
+	strict := base.RequiredAll()
+
+	result := strict.Parse(map[string]interface{}{"age": 30, "nickname": nil})
+	if result.Ok {
+		t.Fatal("Expected missing fields to fail")
+	}
+	for _, field := range []string{"name", "nickname", "role", "email"} {
+		errs := result.Errors.ByPath(field)
+		if len(errs) != 1 || errs[0].Code != CodeInvalidType {
+			t.Errorf("Expected a required error at %s, got %v", field, result.Errors)
+		}
+	}
+	if msg := result.Errors.ByPath("name")[0].Message; msg != "Expected string, received null" {
+		t.Errorf("Unexpected message: %q", msg)
+	}
+	if result.Errors.HasPath("age") {
+		t.Error("Expected age to pass")
+	}
+
+	full := map[string]interface{}{"name": "Ada", "nickname": "ada", "role": "admin", "age": 30, "email": "ada@example.com"}
+	if result := strict.Parse(full); !result.Ok {
+		t.Errorf("Expected a complete object to pass, got %v", result.Errors)
+	}
+	if result := base.Parse(map[string]interface{}{"age": 10}); !result.Ok {
+		t.Errorf("Expected the base schema to stay lenient, got %v", result.Errors)
+	}
+}
+
+// Test Extend adds and replaces fields on a copy
+func TestObjectExtend(t *testing.T) {
+	base := Object(Schema{
+		"id":        String(),
+		"createdAt": Date(),
+		"note":      String().Optional(),
+	})
+	user := base.Extend(Schema{
+		"name": String().Min(2),
+		"note": String().Max(5).Optional(),
+	})
+
+	input := map[string]interface{}{"id": "u1", "createdAt": "2026-01-01", "name": "Ada", "note": "too long"}
+	result := user.Parse(input)
+	if result.Ok || len(result.Errors) != 1 || !result.Errors.HasPath("note") {
+		t.Errorf("Expected the extended note rule to apply, got %v", result.Errors)
+	}
+	input["note"] = "ok"
+	if result := user.Parse(input); !result.Ok || result.Value.(map[string]interface{})["name"] != "Ada" {
+		t.Errorf("Expected the extended schema to pass, got %v", result.Errors)
+	}
+
+	result = base.Parse(map[string]interface{}{"id": "u1", "createdAt": "2026-01-01", "note": "too long"})
+	if !result.Ok {
+		t.Errorf("Expected the base schema to be unchanged, got %v", result.Errors)
+	}
+	if _, ok := result.Value.(map[string]interface{})["name"]; ok {
+		t.Error("Expected the base schema not to gain fields")
+	}
+}
+
+// Test Merge combines fields, rules and the unknown field mode of the second schema
+func TestMerge(t *testing.T) {
+	pagination := Object(Schema{
+		"page":  Number().Int().Min(1),
+		"limit": Number().Int().Max(100),
+	}).Refine(func(m map[string]interface{}) bool { return m["page"].(float64) < 1000 }, "Page out of range")
+	filters := Object(Schema{
+		"limit": Number().Int().Max(50),
+		"q":     String().Optional(),
+	}).Strict()
+	query := Merge(pagination, filters)
+
+	if result := query.Parse(map[string]interface{}{"page": 1, "limit": 80}); result.Ok || !result.Errors.HasPath("limit") {
+		t.Errorf("Expected the second schema's limit to win, got %v", result.Errors)
+	}
+	if result := query.Parse(map[string]interface{}{"page": 1, "limit": 10, "sort": "x"}); result.Ok || !result.Errors.HasPath("sort") {
+		t.Errorf("Expected the merged schema to be strict, got %v", result.Errors)
+	}
+	if result := query.Parse(map[string]interface{}{"page": 5000, "limit": 10}); result.Ok || result.Errors[0].Message != "Page out of range" {
+		t.Errorf("Expected refinements of both schemas to run, got %v", result.Errors)
+	}
+	if !query.Parse(map[string]interface{}{"page": 2, "limit": 10, "q": "shoes"}).Ok {
+		t.Error("Expected a valid query to pass")
+	}
+	if !pagination.Parse(map[string]interface{}{"page": 1, "limit": 80, "q": "x"}).Ok {
+		t.Error("Expected the first schema to be unchanged")
+	}
+}
+
+// Test Keyof validates field names of the object
+func TestObjectKeyof(t *testing.T) {
+	user := Object(Schema{
+		"name":      String(),
+		"email":     String().Email(),
+		"createdAt": Date(),
+	})
+	sortBy := user.Keyof()
+
+	for _, field := range []string{"name", "email", "createdAt"} {
+		if !sortBy.Parse(field).Ok {
+			t.Errorf("Expected %q to be a key", field)
+		}
+	}
+	result := sortBy.Parse("password")
+	if result.Ok || result.Errors[0].Code != CodeInvalidEnumValue {
+		t.Errorf("Expected an invalid_enum_value error, got %v", result.Errors)
+	}
+	if !user.Keyof().Optional().Parse(nil).Ok {
+		t.Error("Expected an optional Keyof to accept nil")
+	}
+	if options := result.Errors[0].Params["options"]; options != "[createdAt email name]" {
+		t.Errorf("Expected sorted options, got %v", options)
+	}
+}
+
+// Test Catchall validates unknown fields
+func TestObjectCatchall(t *testing.T) {
+	schema := Object(Schema{"name": String()}).Catchall(String().Trim())
+
+	result := schema.Parse(map[string]interface{}{"name": "api", "env": " prod ", "team": "core"})
+	if !result.Ok {
+		t.Fatalf("Expected string extras to pass, got %v", result.Errors)
+	}
+	value := result.Value.(map[string]interface{})
+	if value["env"] != "prod" || value["team"] != "core" {
+		t.Errorf("Expected unknown fields to hold their parsed values, got %v", value)
+	}
+
+	result = schema.Parse(map[string]interface{}{"name": "api", "replicas": 3, "tags": []interface{}{}})
+	if result.Ok || len(result.Errors) != 2 || !result.Errors.HasPath("replicas") || !result.Errors.HasPath("tags") {
+		t.Errorf("Expected an error per invalid unknown field, got %v", result.Errors)
+	}
+	if result := schema.Parse(map[string]interface{}{"env": "prod"}); result.Ok || !result.Errors.HasPath("name") {
+		t.Errorf("Expected known fields to be validated by their own schema, got %v", result.Errors)
+	}
+
+	if schema.Strict().Parse(map[string]interface{}{"name": "api", "env": "prod"}).Ok {
+		t.Error("Expected Strict to replace Catchall")
+	}
+}
+
+// Test Alias accepts other keys for a field and outputs the field name
+func TestObjectAlias(t *testing.T) {
+	schema := Object(Schema{
+		"userName": String().Min(3),
+		"age":      Number().Optional(),
+	}).Alias("userName", "user_name", "username").Strict()
+
+	for _, key := range []string{"userName", "user_name", "username"} {
+		result := schema.Parse(map[string]interface{}{key: "ada"})
+		if !result.Ok {
+			t.Fatalf("Expected %s to be accepted, got %v", key, result.Errors)
+		}
+		if value := result.Value.(map[string]interface{}); value["userName"] != "ada" || len(value) != 1 {
+			t.Errorf("Expected the canonical key in the output, got %v", value)
+		}
+	}
+
+	result := schema.Parse(map[string]interface{}{"user_name": "x"})
+	if result.Ok || result.Errors[0].Path != "user_name" {
+		t.Errorf("Expected the error at the alias the input used, got %v", result.Errors)
+	}
+
+	result = schema.Parse(map[string]interface{}{"userName": "ada", "user_name": "grace"})
+	if result.Ok || len(result.Errors) != 1 || result.Errors[0].Code != CodeDuplicateKey || result.Errors[0].Path != "user_name" {
+		t.Errorf("Expected a duplicate_key error for a field given twice, got %v", result.Errors)
+	}
+
+	extended := schema.Extend(Schema{"email": String().Optional()}).Alias("email", "mail")
+	if !extended.Parse(map[string]interface{}{"username": "ada", "mail": "a@b.c"}).Ok {
+		t.Error("Expected aliases to carry over to an extended schema")
+	}
+	if schema.Parse(map[string]interface{}{"userName": "ada", "mail": "a@b.c"}).Ok {
+		t.Error("Expected the original schema not to get the extension's alias")
+	}
+}
+
+// Test NormalizeKeys matches input keys in another case or convention
+func TestObjectNormalizeKeys(t *testing.T) {
+	schema := Object(Schema{
+		"userName": String().Min(3),
+		"userID":   Number(),
+	}).NormalizeKeys(SnakeCase).Passthrough()
+
+	result := schema.Parse(map[string]interface{}{"user_name": "ada", "UserID": 7, "Extra-Field": true})
+	if !result.Ok {
+		t.Fatalf("Expected keys in other conventions to match, got %v", result.Errors)
+	}
+	value := result.Value.(map[string]interface{})
+	if value["userName"] != "ada" || value["userID"] != float64(7) || value["extra_field"] != true || len(value) != 3 {
+		t.Errorf("Expected field names and normalized unknown keys, got %v", value)
+	}
+
+	result = schema.Parse(map[string]interface{}{"user_name": "x", "userID": 1})
+	if result.Ok || result.Errors[0].Path != "user_name" {
+		t.Errorf("Expected the error at the input key, got %v", result.Errors)
+	}
+
+	result = schema.Parse(map[string]interface{}{"user_name": "ada", "USER_NAME": "grace", "userID": 1})
+	if result.Ok || len(result.Errors) != 1 || result.Errors[0].Code != CodeDuplicateKey || result.Errors[0].Path != "user_name" {
+		t.Errorf("Expected a duplicate_key error for keys normalizing to one field, got %v", result.Errors)
+	}
+
+	lower := Object(Schema{"email": String()}).NormalizeKeys(strings.ToLower).Alias("email", "mail").Strict()
+	if !lower.Parse(map[string]interface{}{"EMAIL": "a@b.c"}).Ok || !lower.Parse(map[string]interface{}{"Mail": "a@b.c"}).Ok {
+		t.Error("Expected NormalizeKeys to apply to fields and aliases")
+	}
+}
+
+// Test Strict suggests the intended field for a likely typo
+func TestObjectStrictSuggestions(t *testing.T) {
+	schema := Object(Schema{
+		"email":   String(),
+		"address": String().Optional(),
+		"id":      String().Optional(),
+	}).Strict()
+
+	result := schema.Parse(map[string]interface{}{"emial": "a@b.c", "adress": "x", "xy": 1})
+	if result.Ok {
+		t.Fatal("Expected unknown fields to fail")
+	}
+
+	emial := result.Errors.ByPath("emial")
+	if len(emial) != 1 || emial[0].Message != "Unknown field 'emial', did you mean 'email'?" || emial[0].Params["suggestion"] != "email" {
+		t.Errorf("Expected a suggestion for emial, got %v", emial)
+	}
+	if adress := result.Errors.ByPath("adress"); len(adress) != 1 || adress[0].Params["suggestion"] != "address" {
+		t.Errorf("Expected a suggestion for adress, got %v", adress)
+	}
+	xy := result.Errors.ByPath("xy")
+	if len(xy) != 1 || xy[0].Message != "Unknown field" || xy[0].Params["suggestion"] != nil {
+		t.Errorf("Expected no suggestion for a short unrelated key, got %v", xy)
+	}
+	if keys := fmt.Sprint(xy[0].Params["keys"]); keys != "[adress emial xy]" {
+		t.Errorf("Expected every unknown key in the error, got %s", keys)
+	}
+
+	result = schema.Parse(map[string]interface{}{"email": "a@b.c", "emial": "x"})
+	if result.Ok || result.Errors[0].Params["suggestion"] != nil {
+		t.Errorf("Expected no suggestion of a field already in the input, got %v", result.Errors)
+	}
+
+	RegisterLocale("x-typo", Messages{"unrecognized_keys.typo": "'{key}' → '{suggestion}'?"})
+	if got := emial[0].Translate("x-typo"); got != "'emial' → 'email'?" {
+		t.Errorf("Expected the typo template to be used, got %q", got)
+	}
+}
+
+// Test Default validates a default object in place of a missing one
+func TestObjectDefault(t *testing.T) {
+	retry := Object(Schema{
+		"attempts": Number().Default(3),
+		"backoff":  String().Default("exponential"),
+		"codes":    Any(),
+	}).Default(map[string]interface{}{"codes": []interface{}{502, 503}})
+	config := Object(Schema{"url": String(), "retry": retry})
+
+	result := config.Parse(map[string]interface{}{"url": "https://example.com"})
+	if !result.Ok {
+		t.Fatalf("Expected a missing section to use its default, got %v", result.Errors)
+	}
+	section := result.Value.(map[string]interface{})["retry"].(map[string]interface{})
+	if section["attempts"] != float64(3) || section["backoff"] != "exponential" || len(section["codes"].([]interface{})) != 2 {
+		t.Errorf("Expected nested defaults to apply, got %v", section)
+	}
+
+	section["codes"].([]interface{})[0] = 500
+	again := config.Parse(map[string]interface{}{"url": "https://example.com"}).Value.(map[string]interface{})["retry"].(map[string]interface{})
+	if again["codes"].([]interface{})[0] != 502 {
+		t.Error("Expected results not to share values with the default")
+	}
+
+	result = config.Parse(map[string]interface{}{"url": "https://example.com", "retry": map[string]interface{}{"attempts": 5}})
+	if attempts := result.Value.(map[string]interface{})["retry"].(map[string]interface{})["attempts"]; attempts != float64(5) {
+		t.Errorf("Expected a given section to be used, got %v", attempts)
+	}
+
+	invalid := Object(Schema{"port": Number().Int()}).Default(map[string]interface{}{"port": 1.5})
+	if invalid.Parse(nil).Ok {
+		t.Error("Expected an invalid default to fail validation")
+	}
+}
@@ -0,0 +1,514 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// OpenAPIDocument is a parsed OpenAPI 3.x (or JSON Schema) document whose
+// schemas can be compiled into validators. Compiled schemas are cached, so
+// validators for several components of one document share their $ref targets.
+// A document is safe for concurrent use: validators can be compiled from it
+// while others compiled from it are parsing.
+type OpenAPIDocument struct {
+	root     map[string]any
+	mu       sync.Mutex // Held while compiling
+	compiled map[string]*refTarget
+}
+
+// refTarget is the compiled validator of a $ref target. It is set once the
+// target is compiled, before any validator referring to it is returned.
+type refTarget struct {
+	validator Validator
+}
+
+// LoadOpenAPI parses a JSON OpenAPI document
+func LoadOpenAPI(data []byte) (*OpenAPIDocument, error) {
+	var root map[string]any
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("zogo: parsing OpenAPI document: %w", err)
+	}
+	return NewOpenAPIDocument(root), nil
+}
+
+// NewOpenAPIDocument wraps an already decoded OpenAPI document
+func NewOpenAPIDocument(root map[string]any) *OpenAPIDocument {
+	return &OpenAPIDocument{
+		root:     root,
+		compiled: map[string]*refTarget{},
+	}
+}
+
+// FromOpenAPI compiles a schema of a JSON OpenAPI document into a Validator.
+// The location is a dotted path ("components.schemas.User") or a JSON
+// Pointer ("#/components/schemas/User"); $ref graphs, including recursive
+// ones, are resolved.
+func FromOpenAPI(doc []byte, location string) (Validator, error) {
+	d, err := LoadOpenAPI(doc)
+	if err != nil {
+		return nil, err
+	}
+	return d.Validator(location)
+}
+
+// Validator compiles the schema at a dotted path or JSON Pointer
+func (d *OpenAPIDocument) Validator(location string) (Validator, error) {
+	ref := location
+	if !strings.HasPrefix(ref, "#") {
+		ref = "#/" + strings.ReplaceAll(location, ".", "/")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.ref(ref)
+}
+
+// ref returns a validator for a $ref. Each reference site gets its own Lazy
+// wrapper, so modifiers applied at one site don't leak into others, while the
+// target is compiled once. The wrapper holds the target itself rather than
+// looking it up in the document, so parsing never touches the cache that
+// other compilations write to. Callers must hold d.mu.
+func (d *OpenAPIDocument) ref(ref string) (Validator, error) {
+	target, ok := d.compiled[ref]
+	if !ok {
+		node, err := d.resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		// Register before compiling so recursive references find the entry
+		target = &refTarget{}
+		d.compiled[ref] = target
+		compiled, err := d.compile(node, ref)
+		if err != nil {
+			delete(d.compiled, ref)
+			return nil, err
+		}
+		target.validator = compiled
+	}
+
+	return Lazy(func() Validator { return target.validator }), nil
+}
+
+// resolve finds the schema a local JSON Pointer reference points to
+func (d *OpenAPIDocument) resolve(ref string) (map[string]any, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("zogo: unsupported $ref %q: only local references are supported", ref)
+	}
+
+	var node any = d.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch n := node.(type) {
+		case map[string]any:
+			next, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("zogo: $ref %q not found", ref)
+			}
+			node = next
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, fmt.Errorf("zogo: $ref %q not found", ref)
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("zogo: $ref %q not found", ref)
+		}
+	}
+
+	schema, ok := node.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("zogo: $ref %q is not a schema object", ref)
+	}
+	return schema, nil
+}
+
+// compile converts a JSON Schema object into a validator
+func (d *OpenAPIDocument) compile(schema map[string]any, at string) (Validator, error) {
+	if ref, ok := schema["$ref"].(string); ok {
+		return d.ref(ref)
+	}
+
+	types, nullable := schemaTypes(schema)
+
+	var validator Validator
+	var err error
+	switch {
+	case schema["enum"] != nil:
+		values, _ := schema["enum"].([]any)
+		enum := Enum(values)
+		if def, ok := schema["default"]; ok {
+			enum.Default(def)
+		}
+		validator = enum
+	case hasKey(schema, "const"):
+		validator = Literal(schema["const"])
+	case schema["allOf"] != nil:
+		var members []Validator
+		members, err = d.compileAll(schema["allOf"], at+"/allOf")
+		validator = Intersection(members...)
+	case schema["anyOf"] != nil || schema["oneOf"] != nil:
+		key := "anyOf"
+		if schema["oneOf"] != nil {
+			key = "oneOf"
+		}
+		var members []Validator
+		members, err = d.compileAll(schema[key], at+"/"+key)
+		union := Union(members...)
+		if key == "oneOf" {
+			union.Exclusive()
+		}
+		validator = union
+	case len(types) > 1:
+		members := make([]Validator, len(types))
+		for i, typ := range types {
+			if members[i], err = d.compileType(typ, schema, at); err != nil {
+				break
+			}
+		}
+		validator = Union(members...)
+	case len(types) == 1:
+		validator, err = d.compileType(types[0], schema, at)
+	case schema["properties"] != nil || schema["additionalProperties"] != nil:
+		validator, err = d.compileType("object", schema, at)
+	case schema["items"] != nil || schema["prefixItems"] != nil:
+		validator, err = d.compileType("array", schema, at)
+	default:
+		validator = Any()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if nullable {
+		validator = withModifiers(validator, false, true)
+	}
+	return validator, nil
+}
+
+// compileAll compiles a list of subschemas
+func (d *OpenAPIDocument) compileAll(list any, at string) ([]Validator, error) {
+	items, ok := list.([]any)
+	if !ok {
+		return nil, fmt.Errorf("zogo: %s must be an array of schemas", at)
+	}
+
+	validators := make([]Validator, len(items))
+	for i, item := range items {
+		schema, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("zogo: %s/%d is not a schema object", at, i)
+		}
+		validator, err := d.compile(schema, fmt.Sprintf("%s/%d", at, i))
+		if err != nil {
+			return nil, err
+		}
+		validators[i] = validator
+	}
+	return validators, nil
+}
+
+// compileType compiles the keywords of a single JSON Schema type
+func (d *OpenAPIDocument) compileType(typ string, schema map[string]any, at string) (Validator, error) {
+	switch typ {
+	case "string":
+		return compileString(schema)
+	case "number", "integer":
+		return compileNumber(typ, schema), nil
+	case "boolean":
+		validator := Boolean()
+		if def, ok := schema["default"].(bool); ok {
+			validator.Default(def)
+		}
+		return validator, nil
+	case "null":
+		return Null(), nil
+	case "array":
+		return d.compileArray(schema, at)
+	case "object":
+		return d.compileObject(schema, at)
+	default:
+		return nil, fmt.Errorf("zogo: %s: unsupported type %q", at, typ)
+	}
+}
+
+// compileString compiles string keywords and formats
+func compileString(schema map[string]any) (Validator, error) {
+	switch schema["format"] {
+	case "date-time":
+		return Date(), nil
+	case "date":
+		return Date().DateOnly(), nil
+	}
+
+	validator := String()
+	// JSON Schema lengths count characters, not bytes
+	if n, ok := schemaInt(schema, "minLength"); ok {
+		validator.Min(n, CountRunes)
+	}
+	if n, ok := schemaInt(schema, "maxLength"); ok {
+		validator.Max(n, CountRunes)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		if _, err := validator.TryRegex(pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	switch schema["format"] {
+	case "email", "idn-email":
+		validator.Email()
+	case "uri", "url", "iri":
+		validator.URL()
+	case "uuid":
+		validator.UUID()
+	case "ipv4":
+		validator.IPv4()
+	case "ipv6":
+		validator.IPv6()
+	case "hostname", "idn-hostname":
+		validator.Domain()
+	case "byte":
+		validator.Base64()
+	case "cuid":
+		validator.CUID()
+	case "cuid2":
+		validator.CUID2()
+	case "ulid":
+		validator.ULID()
+	case "objectid":
+		validator.ObjectID()
+	case "cron":
+		validator.Cron()
+	case "iban":
+		validator.IBAN()
+	case "bic":
+		validator.BIC()
+	}
+	if format, _ := schema["format"].(string); strings.HasPrefix(format, "idn-") || format == "iri" {
+		validator.AllowIDN()
+	}
+
+	if def, ok := schema["default"].(string); ok {
+		validator.Default(def)
+	}
+	return validator, nil
+}
+
+// compileNumber compiles number and integer keywords, including both the
+// OpenAPI 3.0 (boolean) and 3.1 (numeric) forms of exclusive bounds
+func compileNumber(typ string, schema map[string]any) Validator {
+	validator := Number()
+	if typ == "integer" {
+		validator.Int()
+	}
+
+	exclusiveMin, _ := schema["exclusiveMinimum"].(bool)
+	exclusiveMax, _ := schema["exclusiveMaximum"].(bool)
+
+	min, hasMin := schema["minimum"].(float64)
+	if hasMin && exclusiveMin {
+		validator.Gt(min)
+	} else if hasMin {
+		validator.Min(min)
+	}
+	max, hasMax := schema["maximum"].(float64)
+	if hasMax && exclusiveMax {
+		validator.Lt(max)
+	} else if hasMax {
+		validator.Max(max)
+	}
+
+	// A numeric exclusive bound replaces an inclusive one it is at least as
+	// strict as; otherwise the inclusive bound already implies it
+	if exclusive, ok := schema["exclusiveMinimum"].(float64); ok && (!hasMin || exclusive >= min) {
+		validator.Gt(exclusive)
+	}
+	if exclusive, ok := schema["exclusiveMaximum"].(float64); ok && (!hasMax || exclusive <= max) {
+		validator.Lt(exclusive)
+	}
+	if multiple, ok := schema["multipleOf"].(float64); ok {
+		validator.MultipleOf(multiple)
+	}
+
+	if def, ok := schema["default"].(float64); ok {
+		validator.Default(def)
+	}
+	return validator
+}
+
+// compileArray compiles array and tuple keywords
+func (d *OpenAPIDocument) compileArray(schema map[string]any, at string) (Validator, error) {
+	if schema["prefixItems"] != nil {
+		members, err := d.compileAll(schema["prefixItems"], at+"/prefixItems")
+		if err != nil {
+			return nil, err
+		}
+		tuple := Tuple(members...)
+		if rest, ok := schema["items"].(map[string]any); ok {
+			restValidator, err := d.compile(rest, at+"/items")
+			if err != nil {
+				return nil, err
+			}
+			tuple.Rest(restValidator)
+		}
+		return tuple, nil
+	}
+
+	var element Validator = Any()
+	if items, ok := schema["items"].(map[string]any); ok {
+		var err error
+		if element, err = d.compile(items, at+"/items"); err != nil {
+			return nil, err
+		}
+	}
+
+	validator := Array(element)
+	if n, ok := schemaInt(schema, "minItems"); ok {
+		validator.Min(n)
+	}
+	if n, ok := schemaInt(schema, "maxItems"); ok {
+		validator.Max(n)
+	}
+	return validator, nil
+}
+
+// compileObject compiles object keywords. Properties missing from "required"
+// are optional; additionalProperties false makes the object strict, and a
+// schema-valued additionalProperties becomes a Record without properties or
+// a Catchall with them.
+func (d *OpenAPIDocument) compileObject(schema map[string]any, at string) (Validator, error) {
+	properties, _ := schema["properties"].(map[string]any)
+	additional, hasAdditional := schema["additionalProperties"]
+
+	var catchall Validator
+	if additionalSchema, ok := additional.(map[string]any); ok {
+		value, err := d.compile(additionalSchema, at+"/additionalProperties")
+		if err != nil {
+			return nil, err
+		}
+		if len(properties) == 0 {
+			return Record(String(), value), nil
+		}
+		catchall = value
+	}
+
+	required := map[string]bool{}
+	if list, ok := schema["required"].([]any); ok {
+		for _, name := range list {
+			if s, ok := name.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+
+	fields := Schema{}
+	for name, raw := range properties {
+		property, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("zogo: %s/properties/%s is not a schema object", at, name)
+		}
+		validator, err := d.compile(property, at+"/properties/"+name)
+		if err != nil {
+			return nil, err
+		}
+		if !required[name] {
+			validator = withModifiers(validator, true, false)
+		}
+		fields[name] = validator
+	}
+
+	object := Object(fields)
+	if catchall != nil {
+		return object.Catchall(catchall), nil
+	}
+	if hasAdditional && additional == false {
+		return object.Strict(), nil
+	}
+	return object.Passthrough(), nil
+}
+
+// schemaTypes returns the non-null types of a schema and whether null is
+// allowed, from "type" (a string or an array) and OpenAPI 3.0's "nullable"
+func schemaTypes(schema map[string]any) ([]string, bool) {
+	nullable, _ := schema["nullable"].(bool)
+
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+	}
+
+	if len(types) > 1 {
+		filtered := types[:0]
+		for _, typ := range types {
+			if typ == "null" {
+				nullable = true
+				continue
+			}
+			filtered = append(filtered, typ)
+		}
+		types = filtered
+	}
+	return types, nullable
+}
+
+// schemaInt reads a non-negative integer keyword
+func schemaInt(schema map[string]any, key string) (int, bool) {
+	n, ok := schema[key].(float64)
+	if !ok || n < 0 {
+		return 0, false
+	}
+	return int(n), true
+}
+
+// hasKey reports whether a keyword is present, even with a null value
+func hasKey(schema map[string]any, key string) bool {
+	_, ok := schema[key]
+	return ok
+}
+
+// withModifiers marks a compiled validator as optional and/or nullable
+func withModifiers(validator Validator, optional, nullable bool) Validator {
+	switch v := validator.(type) {
+	case *StringValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *NumberValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *BooleanValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *DateValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *ArrayValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *ObjectValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *RecordValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *TupleValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *EnumValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *LiteralValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *UnionValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *IntersectionValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	case *LazyValidator:
+		v.isOptional, v.isNullable = v.isOptional || optional, v.isNullable || nullable
+	}
+	return validator
+}
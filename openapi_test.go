@@ -0,0 +1,187 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"os"
+	"sync"
+	"testing"
+)
+
+func loadPetstore(t *testing.T) *OpenAPIDocument {
+	t.Helper()
+	data, err := os.ReadFile("testdata/petstore.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := LoadOpenAPI(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+// Test compiling a component with nested $refs
+func TestFromOpenAPI(t *testing.T) {
+	data, err := os.ReadFile("testdata/petstore.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pet, err := FromOpenAPI(data, "components.schemas.Pet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := map[string]interface{}{
+		"id":       float64(1),
+		"name":     "Rex",
+		"status":   "available",
+		"tags":     []interface{}{map[string]interface{}{"name": "good-boy"}},
+		"owner":    map[string]interface{}{"email": "owner@example.com", "phone": nil},
+		"weight":   12.5,
+		"nickname": nil,
+		"born":     "2020-05-01T10:00:00Z",
+	}
+	if result := pet.Parse(valid); !result.Ok {
+		t.Errorf("Expected valid pet to pass: %v", result.Errors)
+	}
+
+	invalid := map[string]interface{}{
+		"id":     1.5,
+		"name":   "",
+		"status": "lost",
+		"tags":   []interface{}{map[string]interface{}{"name": "Bad Tag"}},
+		"owner":  map[string]interface{}{"email": "nope"},
+		"weight": float64(0),
+		"color":  "brown",
+	}
+	result := pet.Parse(invalid)
+	for _, path := range []string{"id", "name", "status", "tags[0].name", "owner.email", "weight", "color"} {
+		if !result.Errors.HasPath(path) {
+			t.Errorf("Expected error at %s, got %v", path, result.Errors)
+		}
+	}
+}
+
+// Test recursive $ref graphs
+func TestFromOpenAPIRecursive(t *testing.T) {
+	category, err := loadPetstore(t).Validator("#/components/schemas/Category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree := map[string]interface{}{
+		"name": "root",
+		"children": []interface{}{
+			map[string]interface{}{"name": "child", "parent": map[string]interface{}{"name": "root"}},
+			map[string]interface{}{"children": []interface{}{}},
+		},
+	}
+	result := category.Parse(tree)
+	if result.Ok || !result.Errors.HasPath("children[1].name") || len(result.Errors) != 1 {
+		t.Errorf("Expected a single error at children[1].name, got %v", result.Errors)
+	}
+}
+
+// Test parsing with a compiled schema while others are compiled from the
+// same document, which the race detector checks
+func TestOpenAPIConcurrentCompile(t *testing.T) {
+	doc := loadPetstore(t)
+	category, err := doc.Validator("components.schemas.Category")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := map[string]interface{}{
+		"name":     "root",
+		"children": []interface{}{map[string]interface{}{"name": "child"}},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if result := category.Parse(tree); !result.Ok {
+					t.Errorf("Expected the category to pass, got %v", result.Errors)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for _, name := range []string{"Pet", "Shape", "Category"} {
+				if _, err := doc.Validator("components.schemas." + name); err != nil {
+					t.Error(err)
+				}
+			}
+			if _, err := NewGateway(doc); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Test oneOf, additionalProperties schemas and OpenAPI 3.0 exclusive bounds
+func TestFromOpenAPIKeywords(t *testing.T) {
+	doc := loadPetstore(t)
+
+	shape, err := doc.Validator("components.schemas.Shape")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !shape.Parse(map[string]interface{}{"side": float64(2)}).Ok || shape.Parse(map[string]interface{}{}).Ok {
+		t.Error("Expected oneOf to accept either member only")
+	}
+	if result := shape.Parse(map[string]interface{}{"side": float64(2), "radius": float64(1)}); result.Ok || result.Errors[0].Code != CodeAmbiguousUnion {
+		t.Errorf("Expected oneOf to reject a value matching both members, got %v", result.Errors)
+	}
+
+	labels, err := doc.Validator("components.schemas.Labels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labels.Parse(map[string]interface{}{"env": "production-eu"}).Ok {
+		t.Error("Expected additionalProperties schema to validate values")
+	}
+
+	metadata, err := doc.Validator("components.schemas.Metadata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !metadata.Parse(map[string]interface{}{"version": float64(2), "owner": "ops"}).Ok {
+		t.Error("Expected unknown fields matching additionalProperties to pass")
+	}
+	if result := metadata.Parse(map[string]interface{}{"version": float64(2), "owner": true}); result.Ok || !result.Errors.HasPath("owner") {
+		t.Errorf("Expected additionalProperties with properties to validate unknown fields, got %v", result.Errors)
+	}
+
+	legacy, err := doc.Validator("components.schemas.Legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if legacy.Parse(map[string]interface{}{"score": float64(0)}).Ok {
+		t.Error("Expected exclusiveMinimum: true to exclude the minimum")
+	}
+	if !legacy.Parse(map[string]interface{}{"score": float64(10)}).Ok {
+		t.Error("Expected maximum to be inclusive")
+	}
+}
+
+// Test errors for missing components and unresolvable references
+func TestFromOpenAPIErrors(t *testing.T) {
+	doc := loadPetstore(t)
+
+	if _, err := doc.Validator("components.schemas.Nope"); err == nil {
+		t.Error("Expected error for missing component")
+	}
+	if _, err := doc.Validator("components.schemas.Broken"); err == nil {
+		t.Error("Expected error for unresolvable $ref")
+	}
+	if _, err := FromOpenAPI([]byte("{"), "components.schemas.Pet"); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+	if _, err := FromOpenAPI([]byte(`{"components":{"schemas":{"A":{"type":"string","pattern":"("}}}}`), "components.schemas.A"); err == nil {
+		t.Error("Expected error for invalid pattern")
+	}
+}
@@ -0,0 +1,432 @@
+package zogo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseOptions configures a single parse call
+type ParseOptions struct {
+	// AbortEarly stops validation at the first error instead of collecting
+	// every error. Useful on hot paths where any failure rejects the input.
+	AbortEarly bool
+
+	// MaxErrors caps the number of errors collected; validation stops once
+	// the limit is reached. Zero means no limit.
+	MaxErrors int
+
+	// MaxDepth limits how deeply objects and arrays may be nested, guarding
+	// recursive schemas against deeply nested input. Zero means no limit.
+	MaxDepth int
+
+	// Coerce converts input to the expected primitive type before
+	// validating, e.g. "42" for Number() or "true" for Boolean()
+	Coerce bool
+
+	// Valve receives the payload if validation fails, overriding the valve
+	// installed with SetValve
+	Valve *Valve
+
+	// Recorder samples the payload into a replay corpus, overriding the
+	// recorder installed with SetRecorder
+	Recorder *Recorder
+
+	// SchemaName names the schema in rejections reported to a valve and in
+	// recorded corpus entries. By default the name it was registered under
+	// is used.
+	SchemaName string
+
+	// StrictJSON makes ParseJSON reject objects with duplicate keys, which
+	// encoding/json silently resolves by keeping the last value. Parsers
+	// that disagree on which value wins can be used to smuggle values past
+	// validation.
+	StrictJSON bool
+
+	// Quota caps the work the parse may do; see ParseUsage
+	Quota Quota
+}
+
+// Quota sets hard ceilings on the work a single parse may do, so one caller
+// cannot monopolize validation with a huge or pathological payload. The parse
+// stops with a CodeQuotaExceeded error once a ceiling is passed. Zero fields
+// mean no limit.
+type Quota struct {
+	MaxFields      int // Object fields and record entries visited
+	MaxStringBytes int // Bytes of strings validated
+	MaxRegexEvals  int // Regular expressions evaluated
+}
+
+// ParseOption sets a parse option for ParseCtx
+type ParseOption func(*ParseOptions)
+
+// WithAbortEarly stops validation at the first error
+func WithAbortEarly() ParseOption {
+	return func(o *ParseOptions) { o.AbortEarly = true }
+}
+
+// WithMaxErrors caps the number of errors collected
+func WithMaxErrors(n int) ParseOption {
+	return func(o *ParseOptions) { o.MaxErrors = n }
+}
+
+// WithMaxDepth limits how deeply objects and arrays may be nested
+func WithMaxDepth(n int) ParseOption {
+	return func(o *ParseOptions) { o.MaxDepth = n }
+}
+
+// WithCoercion converts input to the expected primitive types before validating
+func WithCoercion() ParseOption {
+	return func(o *ParseOptions) { o.Coerce = true }
+}
+
+// WithValve reports the payload to a valve if validation fails
+func WithValve(valve *Valve) ParseOption {
+	return func(o *ParseOptions) { o.Valve = valve }
+}
+
+// WithRecorder samples the payload into a replay corpus
+func WithRecorder(recorder *Recorder) ParseOption {
+	return func(o *ParseOptions) { o.Recorder = recorder }
+}
+
+// WithSchemaName names the schema in rejections reported to a valve and in
+// recorded corpus entries
+func WithSchemaName(name string) ParseOption {
+	return func(o *ParseOptions) { o.SchemaName = name }
+}
+
+// WithStrictJSON makes ParseJSON reject objects with duplicate keys
+func WithStrictJSON() ParseOption {
+	return func(o *ParseOptions) { o.StrictJSON = true }
+}
+
+// WithQuota caps the work the parse may do
+func WithQuota(quota Quota) ParseOption {
+	return func(o *ParseOptions) { o.Quota = quota }
+}
+
+// ParseWith validates the value with per-call options:
+//
+//	result := zogo.ParseWith(schema, data, zogo.ParseOptions{AbortEarly: true})
+func ParseWith(validator Validator, value any, opts ParseOptions) ParseResult {
+	return parseRoot(context.Background(), validator, value, opts)
+}
+
+// ParseCtx validates the value with a context and per-call options. The
+// context is passed to expensive checks, and validation stops with a
+// CodeCanceled error when it is canceled or its deadline passes:
+//
+//	result := zogo.ParseCtx(ctx, schema, data, zogo.WithAbortEarly(), zogo.WithMaxDepth(32))
+func ParseCtx(ctx context.Context, validator Validator, value any, opts ...ParseOption) ParseResult {
+	var options ParseOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return parseRoot(ctx, validator, value, options)
+}
+
+// parseRoot runs a complete parse call
+func parseRoot(ctx context.Context, validator Validator, value any, opts ParseOptions) ParseResult {
+	st := newParseState(opts)
+	st.ctx = ctx
+	return st.root(validator, value)
+}
+
+// root validates the value as a complete parse call, applying the error
+// limit and reporting failures to the valve
+func (st *parseState) root(validator Validator, value any) ParseResult {
+	ctx, opts := st.ctx, st.opts
+
+	var result ParseResult
+	if ctx.Err() != nil {
+		*st.canceled = true
+	} else {
+		result = st.parse(validator, value)
+	}
+
+	if limit := st.maxErrors(); limit > 0 && len(result.Errors) > limit {
+		result.Errors = result.Errors[:limit]
+	}
+	if *st.canceled {
+		result = Failure(append(result.Errors, ValidationError{
+			Message: "Validation canceled: " + ctx.Err().Error(),
+			Code:    CodeCanceled,
+			Params:  map[string]any{"reason": ctx.Err().Error()},
+		})...)
+	}
+	if resource, limit := st.exceededQuota(); resource != "" && !hasCode(result.Errors, CodeQuotaExceeded) {
+		result = Failure(append(result.Errors, quotaError(resource, limit))...)
+	}
+	result.Meta = *st.meta
+	if len(result.Meta.NonFinite) > 0 {
+		result.Meta.NonFinite = nonFinitePaths(result.Value, result.Meta.NonFinite)
+	}
+
+	if !result.Ok {
+		valve := opts.Valve
+		if valve == nil {
+			valve = globalValve.Load()
+		}
+		if valve != nil {
+			valve.reject(ctx, validator, value, result, opts.SchemaName)
+		}
+	}
+
+	recorder := opts.Recorder
+	if recorder == nil {
+		recorder = globalRecorder.Load()
+	}
+	if recorder != nil && !*st.canceled {
+		recorder.record(validator, value, result, opts.SchemaName)
+	}
+	return result
+}
+
+// parseState is shared by all validators taking part in a single parse call
+type parseState struct {
+	ctx      context.Context
+	opts     ParseOptions
+	errors   int           // Errors collected so far
+	depth    int           // Nesting depth of the value being parsed (the root is 1)
+	canceled *bool         // Set when the context ended before validation finished, shared with forked states
+	meta     *ParseMeta    // Metadata collected so far, shared with forked states
+	path     []pathSegment // Path of the value being parsed
+	keyOrder keyOrders     // Key order of the objects in the input, when decoded by ParseJSON
+	now      *time.Time    // Time relative date checks compare against, shared with forked states
+}
+
+// keyOrders holds the order of the keys of each object in a JSON document,
+// by the object's path
+type keyOrders map[string][]string
+
+// pathSegment is an object field (or record key) or array index in the
+// path of the value being parsed
+type pathSegment struct {
+	name  string
+	index int // -1 for named segments
+}
+
+// String formats the segment the way error paths do
+func (s pathSegment) String() string {
+	if s.index >= 0 {
+		return fmt.Sprintf("[%d]", s.index)
+	}
+	return s.name
+}
+
+func newParseState(opts ParseOptions) *parseState {
+	return &parseState{
+		ctx:      context.Background(),
+		opts:     opts,
+		depth:    1,
+		canceled: new(bool),
+		meta:     &ParseMeta{},
+		now:      new(time.Time),
+	}
+}
+
+// stateParser is implemented by validators that validate children, can stop
+// early or record metadata; validators that don't are parsed with Parse
+type stateParser interface {
+	parseWithState(value any, st *parseState) ParseResult
+}
+
+// parse validates a child value and adds its errors to the error count. A
+// panic in a refinement, Lazy factory or custom validator fails the value
+// with a CodeRefinementPanic error instead of crashing the caller.
+func (st *parseState) parse(validator Validator, value any) (result ParseResult) {
+	before := st.errors
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result = panicFailure(recovered, value)
+		}
+		st.errors = before + len(result.Errors)
+	}()
+
+	if p, ok := validator.(stateParser); ok {
+		return p.parseWithState(value, st)
+	}
+	return validator.Parse(value)
+}
+
+// parseChild validates a field or element of a composite, one nesting level deeper
+func (st *parseState) parseChild(validator Validator, value any) ParseResult {
+	if st.opts.MaxDepth <= 0 {
+		return st.parse(validator, value)
+	}
+
+	if st.depth >= st.opts.MaxDepth && isContainer(value) {
+		st.errors++
+		return FailureWithParams(
+			fmt.Sprintf("Maximum nesting depth of %d exceeded", st.opts.MaxDepth),
+			CodeTooDeep,
+			map[string]any{"maximum": st.opts.MaxDepth},
+		)
+	}
+
+	st.depth++
+	defer func() { st.depth-- }()
+	return st.parse(validator, value)
+}
+
+// parseField validates an object field or record entry
+func (st *parseState) parseField(name string, validator Validator, value any) ParseResult {
+	if failed, ok := st.use(&st.meta.Usage.Fields, 1); !ok {
+		return failed
+	}
+	return st.parseSegment(pathSegment{name: name, index: -1}, validator, value)
+}
+
+// parseIndex validates an array or tuple element
+func (st *parseState) parseIndex(index int, validator Validator, value any) ParseResult {
+	return st.parseSegment(pathSegment{index: index}, validator, value)
+}
+
+// parseSegment validates a child value at a path segment, prefixing the
+// paths of the metadata it records
+func (st *parseState) parseSegment(segment pathSegment, validator Validator, value any) ParseResult {
+	mark := st.mark()
+	st.path = append(st.path, segment)
+	result := st.parseChild(validator, value)
+	st.path = st.path[:len(st.path)-1]
+	st.prefixSince(mark, segment.String)
+	return result
+}
+
+// currentPath returns the path of the value being parsed, formatted like error paths
+func (st *parseState) currentPath() string {
+	var path strings.Builder
+	for i, segment := range st.path {
+		if i > 0 && segment.index < 0 {
+			path.WriteByte('.')
+		}
+		path.WriteString(segment.String())
+	}
+	return path.String()
+}
+
+// fork returns a state for a speculative parse (such as a union member)
+// whose errors don't count toward the caller's errors
+func (st *parseState) fork() *parseState {
+	return &parseState{ctx: st.ctx, opts: st.opts, depth: st.depth, canceled: st.canceled, meta: st.meta, path: st.path, keyOrder: st.keyOrder, now: st.now}
+}
+
+// isolate returns a state for a parse whose errors and metadata are kept
+// apart from the caller's (such as a shadowed candidate schema)
+func (st *parseState) isolate() *parseState {
+	return &parseState{ctx: st.ctx, opts: st.opts, depth: st.depth, canceled: new(bool), meta: &ParseMeta{}, path: st.path, keyOrder: st.keyOrder, now: st.now}
+}
+
+// currentTime returns the time relative date checks compare against. The
+// clock is read the first time it is needed, so a parse sees a single instant.
+func (st *parseState) currentTime() time.Time {
+	if st.now.IsZero() {
+		*st.now = clockNow()
+	}
+	return *st.now
+}
+
+// isContainer reports whether a value is an object or array for MaxDepth
+func isContainer(value any) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+// use adds n to a usage counter, failing once the parse exceeds its quota
+func (st *parseState) use(counter *int, n int) (ParseResult, bool) {
+	*counter += n
+	if resource, limit := st.exceededQuota(); resource != "" {
+		st.errors++
+		return Failure(quotaError(resource, limit)), false
+	}
+	return ParseResult{}, true
+}
+
+// exceededQuota returns the resource whose quota the parse exceeded and its
+// limit, or "" if it is within its quota
+func (st *parseState) exceededQuota() (string, int) {
+	quota, usage := st.opts.Quota, st.meta.Usage
+	switch {
+	case quota.MaxFields > 0 && usage.Fields > quota.MaxFields:
+		return "fields", quota.MaxFields
+	case quota.MaxStringBytes > 0 && usage.StringBytes > quota.MaxStringBytes:
+		return "string bytes", quota.MaxStringBytes
+	case quota.MaxRegexEvals > 0 && usage.RegexEvals > quota.MaxRegexEvals:
+		return "regex evaluations", quota.MaxRegexEvals
+	}
+	return "", 0
+}
+
+// quotaError reports a quota the parse exceeded
+func quotaError(resource string, limit int) ValidationError {
+	return ValidationError{
+		Message: fmt.Sprintf("Validation exceeded its quota of %d %s", limit, resource),
+		Code:    CodeQuotaExceeded,
+		Params:  map[string]any{"resource": resource, "limit": limit},
+	}
+}
+
+// hasCode reports whether any of the errors has the given code
+func hasCode(errors ValidationErrors, code ErrorCode) bool {
+	for _, err := range errors {
+		if err.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// stopped reports whether validation should stop, either because no more
+// errors should be collected, the quota is exhausted or the context ended
+func (st *parseState) stopped() bool {
+	if limit := st.maxErrors(); limit > 0 && st.errors >= limit {
+		return true
+	}
+	if resource, _ := st.exceededQuota(); resource != "" {
+		return true
+	}
+	if st.ctx.Err() != nil {
+		*st.canceled = true
+		return true
+	}
+	return false
+}
+
+// maxErrors returns the error limit, where AbortEarly is a limit of one
+func (st *parseState) maxErrors() int {
+	if st.opts.AbortEarly {
+		return 1
+	}
+	return st.opts.MaxErrors
+}
+
+// metaMark is a position in the collected metadata
+type metaMark struct {
+	skipped   int
+	sensitive int
+}
+
+// mark returns the current position in the collected metadata
+func (st *parseState) mark() metaMark {
+	return metaMark{skipped: len(st.meta.Skipped), sensitive: len(st.meta.Sensitive)}
+}
+
+// prefixSince prepends a path segment to metadata recorded since mark,
+// the same way composites prefix the paths of their children's errors
+func (st *parseState) prefixSince(mark metaMark, segment func() string) {
+	if len(st.meta.Skipped) == mark.skipped && len(st.meta.Sensitive) == mark.sensitive {
+		return
+	}
+	prefix := segment()
+	for i := mark.skipped; i < len(st.meta.Skipped); i++ {
+		st.meta.Skipped[i].Path = prefix + prependPath(st.meta.Skipped[i].Path)
+	}
+	for i := mark.sensitive; i < len(st.meta.Sensitive); i++ {
+		st.meta.Sensitive[i] = prefix + prependPath(st.meta.Sensitive[i])
+	}
+}
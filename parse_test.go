@@ -0,0 +1,315 @@
+package zogo
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// Test AbortEarly stops at the first error in arrays
+func TestParseWithAbortEarlyArray(t *testing.T) {
+	schema := Array(Number().Min(10))
+	input := []interface{}{1, 2, 3, 20, 4}
+
+	all := schema.Parse(input)
+	if len(all.Errors) != 4 {
+		t.Fatalf("Expected 4 errors without AbortEarly, got %d", len(all.Errors))
+	}
+
+	result := ParseWith(schema, input, ParseOptions{AbortEarly: true})
+	if result.Ok {
+		t.Fatal("Expected validation to fail")
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Path != "[0]" {
+		t.Errorf("Expected only the first error, got %v", result.Errors)
+	}
+}
+
+// Test AbortEarly stops across nested composites
+func TestParseWithAbortEarlyNested(t *testing.T) {
+	schema := Object(Schema{
+		"users": Array(Object(Schema{
+			"email": String().Email(),
+			"age":   Number().Min(18),
+		})),
+		"tags": Tuple(String(), String()),
+	})
+
+	input := map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{"email": "bad", "age": 1},
+			map[string]interface{}{"email": "bad", "age": 2},
+		},
+		"tags": []interface{}{1, 2},
+	}
+
+	if all := schema.Parse(input); len(all.Errors) != 6 {
+		t.Fatalf("Expected 6 errors without AbortEarly, got %d", len(all.Errors))
+	}
+
+	result := ParseWith(schema, input, ParseOptions{AbortEarly: true})
+	if len(result.Errors) != 1 {
+		t.Errorf("Expected a single error, got %v", result.Errors)
+	}
+}
+
+// Test AbortEarly does not count failed union members
+func TestParseWithAbortEarlyUnion(t *testing.T) {
+	schema := Array(Union(Number(), String().Min(2)))
+
+	result := ParseWith(schema, []interface{}{1, "ab", "x", true}, ParseOptions{AbortEarly: true})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "[2]" {
+		t.Errorf("Expected first error at [2], got %v", result.Errors)
+	}
+
+	valid := ParseWith(schema, []interface{}{1, "ab"}, ParseOptions{AbortEarly: true})
+	if !valid.Ok {
+		t.Errorf("Expected union members to be tried after a failed member: %v", valid.Errors)
+	}
+}
+
+// Test ParseWith with default options matches Parse
+func TestParseWithDefaults(t *testing.T) {
+	schema := Record(String(), Number())
+	input := map[string]interface{}{"a": "x", "b": "y"}
+
+	if got := ParseWith(schema, input, ParseOptions{}); len(got.Errors) != 2 {
+		t.Errorf("Expected all errors with default options, got %v", got.Errors)
+	}
+	if !ParseWith(String(), "ok", ParseOptions{AbortEarly: true}).Ok {
+		t.Error("Expected leaf validators to work with ParseWith")
+	}
+}
+
+// Test MaxErrors caps the number of collected errors
+func TestParseWithMaxErrors(t *testing.T) {
+	input := make([]interface{}, 1000)
+	for i := range input {
+		input[i] = map[string]interface{}{"id": i, "name": i}
+	}
+	schema := Array(Object(Schema{"id": String(), "name": String()}))
+
+	if all := schema.Parse(input); len(all.Errors) != 2000 {
+		t.Fatalf("Expected 2000 errors without a limit, got %d", len(all.Errors))
+	}
+
+	result := ParseWith(schema, input, ParseOptions{MaxErrors: 5})
+	if result.Ok || len(result.Errors) != 5 {
+		t.Errorf("Expected 5 errors, got %d", len(result.Errors))
+	}
+	for _, err := range result.Errors {
+		if !strings.HasPrefix(err.Path, "[0]") && !strings.HasPrefix(err.Path, "[1]") && !strings.HasPrefix(err.Path, "[2]") {
+			t.Errorf("Expected errors from the first elements, got %v", err.Path)
+		}
+	}
+
+	if got := ParseWith(schema, input[:2], ParseOptions{MaxErrors: 50}); len(got.Errors) != 4 {
+		t.Errorf("Expected all 4 errors under the limit, got %d", len(got.Errors))
+	}
+}
+
+type testManyErrorsValidator struct{}
+
+func (testManyErrorsValidator) Parse(value any) ParseResult {
+	return Failure(make(ValidationErrors, 10)...)
+}
+
+// Test MaxErrors also caps errors from validators that can't stop early
+func TestParseWithMaxErrorsCustomValidator(t *testing.T) {
+	result := ParseWith(Array(testManyErrorsValidator{}), []interface{}{1, 2}, ParseOptions{MaxErrors: 3})
+	if len(result.Errors) != 3 {
+		t.Errorf("Expected 3 errors, got %d", len(result.Errors))
+	}
+}
+
+// Test ParseCtx applies functional options
+func TestParseCtxOptions(t *testing.T) {
+	schema := Array(Number().Min(10))
+	input := []interface{}{1, 2, 3}
+
+	if got := ParseCtx(context.Background(), schema, input); len(got.Errors) != 3 {
+		t.Errorf("Expected all errors without options, got %d", len(got.Errors))
+	}
+	if got := ParseCtx(context.Background(), schema, input, WithAbortEarly()); len(got.Errors) != 1 {
+		t.Errorf("Expected 1 error with WithAbortEarly, got %d", len(got.Errors))
+	}
+	if got := ParseCtx(context.Background(), schema, input, WithMaxErrors(2)); len(got.Errors) != 2 {
+		t.Errorf("Expected 2 errors with WithMaxErrors, got %d", len(got.Errors))
+	}
+}
+
+// Test ParseCtx stops when the context is canceled
+func TestParseCtxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := ParseCtx(ctx, String(), "valid")
+	if result.Ok || result.Errors[0].Code != CodeCanceled {
+		t.Fatalf("Expected canceled error, got %+v", result)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	element := Expensive("slow", String(), func(ctx context.Context, value any) error {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	result = ParseCtx(ctx, Array(element), []interface{}{"a", "b", "c", "d"})
+	if result.Ok || calls != 2 {
+		t.Fatalf("Expected parse to stop after cancellation, got %d calls", calls)
+	}
+	last := result.Errors[len(result.Errors)-1]
+	if last.Code != CodeCanceled || !strings.Contains(last.Message, "context canceled") {
+		t.Errorf("Expected canceled error, got %+v", last)
+	}
+}
+
+// Test the context reaches expensive checks
+func TestParseCtxPassesContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "request-42")
+
+	var seen any
+	schema := Object(Schema{
+		"name": Expensive("lookup", String(), func(ctx context.Context, value any) error {
+			seen = ctx.Value(key{})
+			return nil
+		}),
+	})
+
+	ParseCtx(ctx, schema, map[string]interface{}{"name": "x"})
+	if seen != "request-42" {
+		t.Errorf("Expected check to receive the parse context, got %v", seen)
+	}
+}
+
+// Test WithMaxDepth rejects deeply nested input
+func TestParseCtxMaxDepth(t *testing.T) {
+	var node Validator
+	node = Lazy(func() Validator {
+		return Object(Schema{"child": node.(*LazyValidator).Optional()})
+	})
+
+	nested := map[string]interface{}{}
+	for i := 0; i < 10; i++ {
+		nested = map[string]interface{}{"child": nested}
+	}
+
+	if !ParseCtx(context.Background(), node, nested).Ok {
+		t.Error("Expected nested input to pass without a depth limit")
+	}
+
+	result := ParseCtx(context.Background(), node, nested, WithMaxDepth(5))
+	if result.Ok {
+		t.Fatal("Expected depth limit to fail")
+	}
+	err := result.Errors[0]
+	if err.Code != CodeTooDeep || err.Path != "child.child.child.child.child" {
+		t.Errorf("Expected too_deep error at depth 5, got %+v", err)
+	}
+
+	if !ParseCtx(context.Background(), node, nested, WithMaxDepth(11)).Ok {
+		t.Error("Expected input within the limit to pass")
+	}
+}
+
+// Test WithCoercion converts primitive input
+func TestParseCtxCoercion(t *testing.T) {
+	schema := Object(Schema{
+		"age":    Number().Int().Min(18),
+		"active": Boolean(),
+		"zip":    String().Length(5),
+	})
+	input := map[string]interface{}{"age": " 42 ", "active": "true", "zip": float64(12345)}
+
+	if ParseCtx(context.Background(), schema, input).Ok {
+		t.Error("Expected strings to fail without coercion")
+	}
+
+	result := ParseCtx(context.Background(), schema, input, WithCoercion())
+	if !result.Ok {
+		t.Fatalf("Expected coerced input to pass: %v", result.Errors)
+	}
+	out := result.Value.(map[string]interface{})
+	if out["age"] != float64(42) || out["active"] != true || out["zip"] != "12345" {
+		t.Errorf("Unexpected coerced output: %v", out)
+	}
+
+	bad := ParseCtx(context.Background(), Number(), "forty", WithCoercion())
+	if bad.Ok || bad.Errors[0].Code != CodeInvalidType {
+		t.Errorf("Expected uncoercible input to fail with invalid_type, got %v", bad.Errors)
+	}
+}
+
+// Test ParseMeta.Usage counts the work done by a parse
+func TestParseUsage(t *testing.T) {
+	schema := Object(Schema{
+		"name":  String(),
+		"email": String().Email(),
+		"tags":  Record(String(), String().Regex("^[a-z]+$")),
+	})
+	result := ParseCtx(context.Background(), schema, map[string]interface{}{
+		"name":  "Ada",
+		"email": "ada@example.com",
+		"tags":  map[string]interface{}{"team": "core", "env": "prod"},
+	})
+	if !result.Ok {
+		t.Fatal(result.Errors)
+	}
+
+	want := ParseUsage{Fields: 5, StringBytes: 3 + 15 + 4 + 4 + 4 + 3, RegexEvals: 3}
+	if result.Meta.Usage != want {
+		t.Errorf("Expected usage %+v, got %+v", want, result.Meta.Usage)
+	}
+}
+
+// Test a Quota stops the parse once a ceiling is passed
+func TestParseQuota(t *testing.T) {
+	items := make([]interface{}, 100)
+	for i := range items {
+		items[i] = map[string]interface{}{"sku": "abc", "qty": i}
+	}
+	schema := Array(Object(Schema{"sku": String().Regex("^[a-z]+$"), "qty": Number()}))
+
+	tests := []struct {
+		quota    Quota
+		resource string
+	}{
+		{Quota{MaxFields: 50}, "fields"},
+		{Quota{MaxStringBytes: 30}, "string bytes"},
+		{Quota{MaxRegexEvals: 10}, "regex evaluations"},
+	}
+	for _, tt := range tests {
+		result := ParseCtx(context.Background(), schema, items, WithQuota(tt.quota))
+		if result.Ok {
+			t.Fatalf("Expected %s quota to fail the parse", tt.resource)
+		}
+		var quotaErrors ValidationErrors
+		for _, err := range result.Errors {
+			if err.Code == CodeQuotaExceeded {
+				quotaErrors = append(quotaErrors, err)
+			}
+		}
+		if len(quotaErrors) != 1 || quotaErrors[0].Params["resource"] != tt.resource {
+			t.Errorf("Expected one %s quota error, got %v", tt.resource, result.Errors)
+		}
+		if result.Meta.Usage.Fields > 60 {
+			t.Errorf("Expected the parse to stop at the quota, visited %d fields", result.Meta.Usage.Fields)
+		}
+	}
+
+	if !ParseCtx(context.Background(), schema, items, WithQuota(Quota{MaxFields: 200, MaxRegexEvals: 100})).Ok {
+		t.Error("Expected a parse within its quota to pass")
+	}
+
+	union := Union(Object(Schema{"a": String()}), Object(Schema{"b": String()}))
+	result := ParseCtx(context.Background(), union, map[string]interface{}{"b": "x"}, WithQuota(Quota{MaxFields: 1}))
+	if result.Ok || !hasCode(result.Errors, CodeQuotaExceeded) {
+		t.Errorf("Expected union members to count toward the quota, got %v", result.Errors)
+	}
+}
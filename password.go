@@ -0,0 +1,100 @@
+package zogo
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy lists the rules String().Password checks. Rules left at
+// their zero value are not checked.
+type PasswordPolicy struct {
+	MinLength     int      // Minimum length in characters
+	RequireUpper  bool     // At least one upper case letter
+	RequireLower  bool     // At least one lower case letter
+	RequireDigit  bool     // At least one digit
+	RequireSymbol bool     // At least one character other than a letter, digit or space
+	MaxRepeats    int      // Most times a character may appear in a row
+	BannedList    []string // Passwords rejected whatever their case, such as common ones
+}
+
+// passwordIssues returns one error for each rule of the policy that the
+// password breaks
+func passwordIssues(password string, policy PasswordPolicy) []ValidationError {
+	var issues []ValidationError
+	fail := func(message, rule string, params map[string]any) {
+		if params == nil {
+			params = map[string]any{}
+		}
+		params["type"] = rule
+		issues = append(issues, ValidationError{Message: message, Code: CodeWeakPassword, Params: params})
+	}
+
+	if policy.MinLength > 0 && utf8.RuneCountInString(password) < policy.MinLength {
+		issues = append(issues, ValidationError{
+			Message: fmt.Sprintf("Password must be at least %d characters", policy.MinLength),
+			Code:    CodeTooSmall,
+			Params:  map[string]any{"type": "password", "minimum": policy.MinLength},
+		})
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if policy.RequireUpper && !upper {
+		fail("Password must contain an uppercase letter", "uppercase", nil)
+	}
+	if policy.RequireLower && !lower {
+		fail("Password must contain a lowercase letter", "lowercase", nil)
+	}
+	if policy.RequireDigit && !digit {
+		fail("Password must contain a digit", "digit", nil)
+	}
+	if policy.RequireSymbol && !symbol {
+		fail("Password must contain a symbol", "symbol", nil)
+	}
+
+	if policy.MaxRepeats > 0 && longestRun(password) > policy.MaxRepeats {
+		fail(
+			fmt.Sprintf("Password must not repeat a character more than %d times in a row", policy.MaxRepeats),
+			"repeats",
+			map[string]any{"maximum": policy.MaxRepeats},
+		)
+	}
+
+	for _, banned := range policy.BannedList {
+		if strings.EqualFold(password, banned) {
+			fail("Password is too common", "banned", nil)
+			break
+		}
+	}
+	return issues
+}
+
+// longestRun returns the length of the longest run of one repeated
+// character in s
+func longestRun(s string) int {
+	longest, run := 0, 0
+	var prev rune
+	for i, r := range s {
+		if i > 0 && r == prev {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
+		prev = r
+	}
+	return longest
+}
@@ -0,0 +1,59 @@
+package zogo
+
+import "testing"
+
+// Test Password reports one error for each broken rule
+func TestStringPassword(t *testing.T) {
+	schema := String().Password(PasswordPolicy{
+		MinLength:     10,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeats:    2,
+		BannedList:    []string{"Password123!"},
+	})
+
+	if result := schema.Parse("Correct-Horse-9"); !result.Ok {
+		t.Errorf("Expected a strong password to pass, got %v", result.Errors)
+	}
+
+	result := schema.Parse("aaab")
+	want := []string{"password", "uppercase", "digit", "symbol", "repeats"}
+	if result.Ok || len(result.Errors) != len(want) {
+		t.Fatalf("Expected %d errors, got %v", len(want), result.Errors)
+	}
+	for i, err := range result.Errors {
+		if err.Params["type"] != want[i] {
+			t.Errorf("Expected error %d to be for %s, got %v", i, want[i], err)
+		}
+	}
+	if result.Errors[0].Code != CodeTooSmall || result.Errors[0].Message != "Password must be at least 10 characters" {
+		t.Errorf("Unexpected length error: %v", result.Errors[0])
+	}
+	if result.Errors[4].Code != CodeWeakPassword || result.Errors[4].Params["maximum"] != 2 {
+		t.Errorf("Unexpected repeats error: %v", result.Errors[4])
+	}
+
+	result = schema.Parse("PASSWORD123!")
+	if result.Ok || len(result.Errors) != 2 || result.Errors[1].Message != "Password is too common" {
+		t.Errorf("Expected a banned password in another case to fail, got %v", result.Errors)
+	}
+}
+
+// Test an empty policy accepts any string
+func TestStringPasswordEmptyPolicy(t *testing.T) {
+	if !String().Password(PasswordPolicy{}).Parse("a").Ok {
+		t.Error("Expected an empty policy to check nothing")
+	}
+}
+
+// Test longestRun finds the longest run of one character
+func TestLongestRun(t *testing.T) {
+	tests := map[string]int{"": 0, "a": 1, "abc": 1, "aabbb": 3, "xyyyyz": 4, "ééé": 3}
+	for input, want := range tests {
+		if got := longestRun(input); got != want {
+			t.Errorf("longestRun(%q) = %d, want %d", input, got, want)
+		}
+	}
+}
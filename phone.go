@@ -0,0 +1,288 @@
+package zogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// phoneCountry describes the numbering plan of a country
+type phoneCountry struct {
+	dial   string // Country calling code without "+"
+	minLen int    // Minimum national significant number length
+	maxLen int    // Maximum national significant number length
+	trunk  string // National trunk prefix dropped in E.164 (e.g. "0")
+}
+
+// phoneCountries maps ISO 3166-1 alpha-2 codes to numbering plans
+var phoneCountries = map[string]phoneCountry{
+	"US": {"1", 10, 10, "1"},
+	"CA": {"1", 10, 10, "1"},
+	"GB": {"44", 9, 10, "0"},
+	"DE": {"49", 6, 13, "0"},
+	"FR": {"33", 9, 9, "0"},
+	"ES": {"34", 9, 9, ""},
+	"IT": {"39", 6, 11, ""},
+	"NL": {"31", 9, 9, "0"},
+	"BE": {"32", 8, 9, "0"},
+	"CH": {"41", 9, 9, "0"},
+	"AT": {"43", 4, 13, "0"},
+	"SE": {"46", 7, 13, "0"},
+	"NO": {"47", 8, 8, ""},
+	"DK": {"45", 8, 8, ""},
+	"FI": {"358", 5, 12, "0"},
+	"PL": {"48", 9, 9, ""},
+	"PT": {"351", 9, 9, ""},
+	"IE": {"353", 7, 9, "0"},
+	"GR": {"30", 10, 10, ""},
+	"CZ": {"420", 9, 9, ""},
+	"HU": {"36", 8, 9, "06"},
+	"RO": {"40", 9, 9, "0"},
+	"TR": {"90", 10, 10, "0"},
+	"RU": {"7", 10, 10, "8"},
+	"UA": {"380", 9, 9, "0"},
+	"IL": {"972", 8, 9, "0"},
+	"AE": {"971", 8, 9, "0"},
+	"SA": {"966", 8, 9, "0"},
+	"EG": {"20", 9, 10, "0"},
+	"ZA": {"27", 9, 9, "0"},
+	"NG": {"234", 8, 10, "0"},
+	"KE": {"254", 9, 9, "0"},
+	"IN": {"91", 10, 10, "0"},
+	"PK": {"92", 9, 10, "0"},
+	"BD": {"880", 10, 10, "0"},
+	"CN": {"86", 10, 11, "0"},
+	"JP": {"81", 9, 10, "0"},
+	"KR": {"82", 8, 10, "0"},
+	"TW": {"886", 8, 9, "0"},
+	"HK": {"852", 8, 8, ""},
+	"SG": {"65", 8, 8, ""},
+	"MY": {"60", 8, 10, "0"},
+	"TH": {"66", 8, 9, "0"},
+	"VN": {"84", 9, 10, "0"},
+	"PH": {"63", 9, 10, "0"},
+	"ID": {"62", 8, 12, "0"},
+	"AU": {"61", 9, 9, "0"},
+	"NZ": {"64", 8, 10, "0"},
+	"BR": {"55", 10, 11, "0"},
+	"MX": {"52", 10, 10, ""},
+	"AR": {"54", 10, 10, "0"},
+	"CL": {"56", 9, 9, ""},
+	"CO": {"57", 10, 10, ""},
+	"PE": {"51", 8, 9, "0"},
+}
+
+// PhoneNumberValidator validates an object holding a country code and a phone
+// number together, normalizing the number to E.164 (e.g. "+14155552671")
+type PhoneNumberValidator struct {
+	countryField   string
+	phoneField     string
+	defaultCountry *string
+
+	// Modifiers
+	isRequired bool
+	isOptional bool
+	isNullable bool
+}
+
+// PhoneNumber creates a preset that validates {countryCode, phone} pairs.
+// The country may be an ISO 3166-1 alpha-2 code ("US") or a calling code
+// ("+1"). Phone numbers may be written in national ("(415) 555-2671") or
+// international ("+1 415 555 2671") format; the output replaces the phone
+// with its E.164 form and the country with its alpha-2 code. Errors are
+// reported on whichever field is at fault. Other fields are passed through.
+func PhoneNumber() *PhoneNumberValidator {
+	return &PhoneNumberValidator{
+		countryField: "countryCode",
+		phoneField:   "phone",
+	}
+}
+
+// Fields sets the names of the country and phone fields
+func (v *PhoneNumberValidator) Fields(countryField, phoneField string) *PhoneNumberValidator {
+	v.countryField = countryField
+	v.phoneField = phoneField
+	return v
+}
+
+// DefaultCountry sets the country used when the country field is missing
+func (v *PhoneNumberValidator) DefaultCountry(country string) *PhoneNumberValidator {
+	v.defaultCountry = &country
+	return v
+}
+
+// Required marks the field as required
+func (v *PhoneNumberValidator) Required() *PhoneNumberValidator {
+	v.isRequired = true
+	v.isOptional = false
+	return v
+}
+
+// Optional allows nil values
+func (v *PhoneNumberValidator) Optional() *PhoneNumberValidator {
+	v.isOptional = true
+	v.isRequired = false
+	return v
+}
+
+// Nullable allows null values
+func (v *PhoneNumberValidator) Nullable() *PhoneNumberValidator {
+	v.isNullable = true
+	return v
+}
+
+// Nullish allows nil values, like Optional and Nullable together
+func (v *PhoneNumberValidator) Nullish() *PhoneNumberValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Parse validates the input value
+func (v *PhoneNumberValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *PhoneNumberValidator) parseWithState(value any, st *parseState) ParseResult {
+	// Handle nil values based on modifiers
+	if value == nil {
+		if v.isOptional || v.isNullable {
+			return Success(nil)
+		}
+		return FailureTypeMismatch("object", nil)
+	}
+
+	objMap, ok := value.(map[string]interface{})
+	if !ok {
+		return FailureTypeMismatch("object", value)
+	}
+
+	// Resolve the country first; the phone can only be checked against it
+	rawCountry, exists := objMap[v.countryField]
+	if (!exists || rawCountry == nil) && v.defaultCountry != nil {
+		rawCountry = *v.defaultCountry
+	}
+
+	country, iso, errs := v.parseCountry(rawCountry)
+	if errs != nil {
+		return Failure(errs...)
+	}
+
+	rawPhone, ok := objMap[v.phoneField].(string)
+	if !ok {
+		err := FailureTypeMismatch("string", objMap[v.phoneField]).Errors[0]
+		err.Path = v.phoneField
+		return Failure(err)
+	}
+
+	e164, message := normalizePhone(rawPhone, country)
+	if message != "" {
+		return Failure(ValidationError{
+			Path:    v.phoneField,
+			Message: message,
+			Value:   rawPhone,
+			Code:    CodeInvalidPhone,
+			Params:  map[string]any{"country": iso},
+		})
+	}
+
+	result := make(map[string]interface{}, len(objMap))
+	for key, val := range objMap {
+		result[key] = val
+	}
+	result[v.countryField] = iso
+	result[v.phoneField] = e164
+
+	return Success(result)
+}
+
+// parseCountry resolves an alpha-2 or calling code to a numbering plan
+func (v *PhoneNumberValidator) parseCountry(raw any) (phoneCountry, string, ValidationErrors) {
+	fail := func(message string) (phoneCountry, string, ValidationErrors) {
+		return phoneCountry{}, "", ValidationErrors{{
+			Path:    v.countryField,
+			Message: message,
+			Value:   raw,
+			Code:    CodeInvalidCountry,
+		}}
+	}
+
+	str, ok := raw.(string)
+	if !ok {
+		if raw == nil {
+			return fail("Country code is required")
+		}
+		return fail("Expected country code string, received " + typeof(raw))
+	}
+
+	str = strings.ToUpper(strings.TrimSpace(str))
+	if country, ok := phoneCountries[str]; ok {
+		return country, str, nil
+	}
+
+	// Calling codes resolve to their country (shared codes such as +1 use the preferred one)
+	dial := strings.TrimPrefix(str, "+")
+	if iso, ok := sharedDialCountries[dial]; ok {
+		return phoneCountries[iso], iso, nil
+	}
+	for iso, country := range phoneCountries {
+		if country.dial == dial {
+			return country, iso, nil
+		}
+	}
+
+	return fail(fmt.Sprintf("Unsupported country code '%s'", str))
+}
+
+// sharedDialCountries picks the country reported for calling codes used by several countries
+var sharedDialCountries = map[string]string{
+	"1": "US",
+}
+
+// normalizePhone converts a national or international number to E.164.
+// It returns an error message when the number is not valid for the country.
+func normalizePhone(raw string, country phoneCountry) (string, string) {
+	var digits strings.Builder
+	international := false
+
+	for i, r := range strings.TrimSpace(raw) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			international = true
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+			// Formatting characters are ignored
+		default:
+			return "", "Phone number contains invalid characters"
+		}
+	}
+
+	number := digits.String()
+	if !international && strings.HasPrefix(number, "00") {
+		international = true
+		number = number[2:]
+	}
+
+	if international {
+		if !strings.HasPrefix(number, country.dial) {
+			return "", "Phone number does not match country code +" + country.dial
+		}
+		number = number[len(country.dial):]
+	} else if country.trunk != "" && strings.HasPrefix(number, country.trunk) &&
+		(country.trunk == "0" || len(number) > country.maxLen) {
+		// Drop the national trunk prefix ("020..." in the UK, "1 415..." in the US)
+		number = number[len(country.trunk):]
+	}
+
+	if len(number) < country.minLen || len(number) > country.maxLen {
+		return "", "Invalid phone number length for country"
+	}
+
+	if len(country.dial)+len(number) > 15 {
+		return "", "Phone number exceeds E.164 maximum length"
+	}
+
+	return "+" + country.dial + number, ""
+}
@@ -0,0 +1,111 @@
+package zogo
+
+import "testing"
+
+// Test national and international formats normalize to E.164
+func TestPhoneNumberNormalization(t *testing.T) {
+	tests := []struct {
+		country  string
+		phone    string
+		expected string
+	}{
+		{"US", "(415) 555-2671", "+14155552671"},
+		{"US", "1 415 555 2671", "+14155552671"},
+		{"us", "+1 415 555 2671", "+14155552671"},
+		{"+1", "415.555.2671", "+14155552671"},
+		{"GB", "020 7946 0958", "+442079460958"},
+		{"GB", "+44 20 7946 0958", "+442079460958"},
+		{"GB", "0044 20 7946 0958", "+442079460958"},
+		{"DE", "030 123456", "+4930123456"},
+		{"FR", "06 12 34 56 78", "+33612345678"},
+		{"ES", "612 345 678", "+34612345678"},
+		{"+44", "07911 123456", "+447911123456"},
+	}
+
+	for _, tt := range tests {
+		result := PhoneNumber().Parse(map[string]interface{}{
+			"countryCode": tt.country,
+			"phone":       tt.phone,
+		})
+		if !result.Ok {
+			t.Errorf("Expected %s/%s to pass: %v", tt.country, tt.phone, result.Errors)
+			continue
+		}
+
+		out := result.Value.(map[string]interface{})
+		if out["phone"] != tt.expected {
+			t.Errorf("Expected %s/%s to normalize to %s, got %v", tt.country, tt.phone, tt.expected, out["phone"])
+		}
+	}
+}
+
+// Test errors are attributed to the field at fault
+func TestPhoneNumberFieldErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input map[string]interface{}
+		path  string
+		code  ErrorCode
+	}{
+		{"unknown country", map[string]interface{}{"countryCode": "XX", "phone": "4155552671"}, "countryCode", CodeInvalidCountry},
+		{"missing country", map[string]interface{}{"phone": "4155552671"}, "countryCode", CodeInvalidCountry},
+		{"too short", map[string]interface{}{"countryCode": "US", "phone": "555-2671"}, "phone", CodeInvalidPhone},
+		{"wrong country", map[string]interface{}{"countryCode": "FR", "phone": "+1 415 555 2671"}, "phone", CodeInvalidPhone},
+		{"letters", map[string]interface{}{"countryCode": "US", "phone": "415-CALL-NOW"}, "phone", CodeInvalidPhone},
+		{"not a string", map[string]interface{}{"countryCode": "US", "phone": 4155552671}, "phone", CodeInvalidType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PhoneNumber().Parse(tt.input)
+			if result.Ok {
+				t.Fatal("Expected validation to fail")
+			}
+			if result.Errors[0].Path != tt.path || result.Errors[0].Code != tt.code {
+				t.Errorf("Expected %s error on %s, got %+v", tt.code, tt.path, result.Errors[0])
+			}
+		})
+	}
+}
+
+// Test custom field names, default country and passthrough of other fields
+func TestPhoneNumberOptions(t *testing.T) {
+	schema := PhoneNumber().Fields("country", "mobile").DefaultCountry("NL")
+
+	result := schema.Parse(map[string]interface{}{
+		"mobile": "06 12345678",
+		"name":   "Anna",
+	})
+	if !result.Ok {
+		t.Fatalf("Expected valid input to pass: %v", result.Errors)
+	}
+
+	out := result.Value.(map[string]interface{})
+	if out["mobile"] != "+31612345678" || out["country"] != "NL" || out["name"] != "Anna" {
+		t.Errorf("Unexpected output: %v", out)
+	}
+}
+
+// Test embedding the preset in an object schema via Intersection
+func TestPhoneNumberInObject(t *testing.T) {
+	schema := Object(Schema{
+		"contact": Intersection(
+			Object(Schema{"name": String()}).Passthrough(),
+			PhoneNumber(),
+		),
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"contact": map[string]interface{}{"name": "Sam", "countryCode": "US", "phone": "123"},
+	})
+	if result.Ok {
+		t.Fatal("Expected invalid phone to fail")
+	}
+	if !result.Errors.HasPath("contact.phone") {
+		t.Errorf("Expected error at contact.phone, got %v", result.Errors)
+	}
+
+	if !PhoneNumber().Optional().Parse(nil).Ok {
+		t.Error("Expected nil to pass with Optional()")
+	}
+}
@@ -1,18 +1,20 @@
 package zogo
 
-import (
-	"fmt"
-)
-
 // RecordValidator validates map[string]T where all values are of the same type
 type RecordValidator struct {
 	keyValidator   Validator
 	valueValidator Validator
+	normalizeKeys  func(string) string
 
 	// Modifiers
 	isRequired bool
 	isOptional bool
 	isNullable bool
+
+	// Custom validators
+	refinements []refinement
+
+	examples schemaExamples // Declared with Examples
 }
 
 // Record creates a new record validator with key and value validators
@@ -43,8 +45,47 @@ func (v *RecordValidator) Nullable() *RecordValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *RecordValidator) Nullish() *RecordValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// NormalizeKeys converts input keys with normalize before they are
+// validated, such as strings.ToLower or SnakeCase. The parsed record holds
+// the normalized keys; keys that normalize to the same key are reported as
+// duplicates.
+func (v *RecordValidator) NormalizeKeys(normalize func(string) string) *RecordValidator {
+	v.normalizeKeys = normalize
+	return v
+}
+
+// Refine adds custom validation logic on the parsed record, such as
+// requiring a key. It runs after all entries pass.
+func (v *RecordValidator) Refine(check func(map[string]interface{}) bool, message string) *RecordValidator {
+	v.refinements = append(v.refinements, refinement{
+		check:   func(value any) bool { return check(value.(map[string]interface{})) },
+		message: message,
+	})
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *RecordValidator) Examples(valid, invalid []any) *RecordValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value
 func (v *RecordValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *RecordValidator) parseWithState(value any, st *parseState) ParseResult {
 	// Handle nil values based on modifiers
 	if value == nil {
 		// If optional, nil is OK
@@ -58,13 +99,13 @@ func (v *RecordValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected record (object), received null")
+		return FailureTypeMismatch("record (object)", nil)
 	}
 
 	// Check if value is a map
 	objMap, ok := value.(map[string]interface{})
 	if !ok {
-		return FailureMessage("Expected record (object), received " + typeof(value))
+		return FailureTypeMismatch("record (object)", value)
 	}
 
 	// Result map to build
@@ -73,41 +114,57 @@ func (v *RecordValidator) Parse(value any) ParseResult {
 	// Track all errors
 	var errors ValidationErrors
 
+	// Normalize keys, reporting errors at the key the input used
+	var inputKeys map[string]string
+	if v.normalizeKeys != nil {
+		objMap, inputKeys, errors = renameKeys(objMap, v.normalizeKeys)
+		st.errors += len(errors)
+	}
+
 	// Validate each key-value pair
 	for key, val := range objMap {
-		// Validate key
-		keyResult := v.keyValidator.Parse(key)
+		if st.stopped() {
+			break
+		}
+
+		path := key
+		if inputKey, renamed := inputKeys[key]; renamed {
+			path = inputKey
+		}
+
+		// Validate key, which does not count as another field toward the quota
+		keyResult := st.parseSegment(pathSegment{name: "key(" + path + ")", index: -1}, v.keyValidator, key)
 		if !keyResult.Ok {
 			for _, err := range keyResult.Errors {
-				errors = append(errors, ValidationError{
-					Path:    fmt.Sprintf("key(%s)%s", key, prependPath(err.Path)),
-					Message: err.Message,
-					Value:   err.Value,
-				})
+				err.nest("key("+path+")", pathToken{name: path})
+				if err.Code == "" {
+					err.Code = CodeInvalidKey
+				}
+				errors = append(errors, err)
 			}
 			continue // Skip this entry if key is invalid
 		}
 
 		// Validate value
-		valResult := v.valueValidator.Parse(val)
+		valResult := st.parseField(path, v.valueValidator, val)
 		if !valResult.Ok {
 			for _, err := range valResult.Errors {
-				errors = append(errors, ValidationError{
-					Path:    fmt.Sprintf("%s%s", key, prependPath(err.Path)),
-					Message: err.Message,
-					Value:   err.Value,
-				})
+				err.nest(path, pathToken{name: path})
+				errors = append(errors, err)
 			}
 		} else {
 			// Use the validated key and value
 			validatedKey, ok := keyResult.Value.(string)
 			if !ok {
 				// Key must be a string for map[string]interface{}
-				errors = append(errors, ValidationError{
-					Path:    fmt.Sprintf("key(%s)", key),
+				err := ValidationError{
 					Message: "Record key must be a string",
 					Value:   keyResult.Value,
-				})
+					Code:    CodeInvalidKey,
+				}
+				err.nest("key("+path+")", pathToken{name: path})
+				errors = append(errors, err)
+				st.errors++
 			} else {
 				result[validatedKey] = valResult.Value
 			}
@@ -119,5 +176,5 @@ func (v *RecordValidator) Parse(value any) ParseResult {
 		return Failure(errors...)
 	}
 
-	return Success(result)
+	return refine(v.refinements, Success(result))
 }
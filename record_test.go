@@ -426,3 +426,27 @@ func TestRecordEnumValues(t *testing.T) {
 		t.Error("Expected invalid enum value to fail")
 	}
 }
+
+// Test NormalizeKeys converts record keys before they are validated
+func TestRecordNormalizeKeys(t *testing.T) {
+	schema := Record(String().Regex("^[a-z_]+$"), Number()).NormalizeKeys(SnakeCase)
+
+	result := schema.Parse(map[string]interface{}{"requestCount": 3, "ErrorRate": 0.1})
+	if !result.Ok {
+		t.Fatalf("Expected normalized keys to pass, got %v", result.Errors)
+	}
+	value := result.Value.(map[string]interface{})
+	if value["request_count"] != float64(3) || value["error_rate"] != 0.1 {
+		t.Errorf("Expected normalized keys in the output, got %v", value)
+	}
+
+	result = schema.Parse(map[string]interface{}{"requestCount": "x"})
+	if result.Ok || result.Errors[0].Path != "requestCount" {
+		t.Errorf("Expected the error at the input key, got %v", result.Errors)
+	}
+
+	result = schema.Parse(map[string]interface{}{"request_count": 1, "requestCount": 2})
+	if result.Ok || result.Errors[0].Code != CodeDuplicateKey {
+		t.Errorf("Expected a duplicate_key error, got %v", result.Errors)
+	}
+}
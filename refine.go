@@ -0,0 +1,139 @@
+package zogo
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// SuperRefineFunc is a refinement that can report any number of issues
+type SuperRefineFunc func(value any, ctx *RefinementCtx)
+
+// RefinementCtx collects the issues reported by a SuperRefine callback
+type RefinementCtx struct {
+	ctx    context.Context
+	issues ValidationErrors
+}
+
+// AddIssue reports an error. Its Path is relative to the refined value (so
+// "items[0].sku" inside an object), and its Code defaults to CodeCustom.
+func (c *RefinementCtx) AddIssue(issue ValidationError) {
+	if issue.Code == "" {
+		issue.Code = CodeCustom
+	}
+	c.issues = append(c.issues, issue)
+}
+
+// Context returns the context given to ParseCtx
+func (c *RefinementCtx) Context() context.Context {
+	return c.ctx
+}
+
+// superRefine runs SuperRefine callbacks on a successfully parsed value,
+// collecting the issues of all of them
+func superRefine(ctx context.Context, refinements []SuperRefineFunc, result ParseResult) ParseResult {
+	if !result.Ok || len(refinements) == 0 {
+		return result
+	}
+
+	rc := &RefinementCtx{ctx: ctx}
+	for _, refine := range refinements {
+		refine(result.Value, rc)
+	}
+	if len(rc.issues) > 0 {
+		return Failure(rc.issues...)
+	}
+	return result
+}
+
+// refinement is a Refine check of a validator whose checks receive the
+// parsed value as any
+type refinement struct {
+	check   func(any) bool
+	message string
+}
+
+// refine runs Refine checks on a successfully parsed non-nil value, failing
+// with the message of the first check that rejects it
+func refine(refinements []refinement, result ParseResult) ParseResult {
+	if !result.Ok || result.Value == nil {
+		return result
+	}
+	for _, r := range refinements {
+		if !r.check(result.Value) {
+			return FailureWithCode(r.message, CodeCustom)
+		}
+	}
+	return result
+}
+
+// runChecks runs context-aware refinements on a successfully parsed value,
+// stopping at the first failure
+func runChecks(ctx context.Context, checks []CheckFunc, result ParseResult) ParseResult {
+	if !result.Ok {
+		return result
+	}
+	for _, check := range checks {
+		if err := check(ctx, result.Value); err != nil {
+			return Failure(checkErrors(err, result.Value)...)
+		}
+	}
+	return result
+}
+
+// PanicError is the Cause of a CodeRefinementPanic error. It holds the
+// value a custom check panicked with and the stack where it panicked, for
+// logging; neither is included in Issues beyond the panic message.
+type PanicError struct {
+	Value any    // The value passed to panic
+	Stack []byte // The stack trace of the panicking goroutine
+}
+
+// Error returns the panic message
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the value passed to panic, if it is an error
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// panicFailure converts a recovered panic into a CodeRefinementPanic error
+func panicFailure(recovered, value any) ParseResult {
+	reason := fmt.Sprint(recovered)
+	return Failure(ValidationError{
+		Message: "Validation check panicked: " + reason,
+		Value:   value,
+		Code:    CodeRefinementPanic,
+		Params:  map[string]any{"reason": reason},
+		Cause:   &PanicError{Value: recovered, Stack: debug.Stack()},
+	})
+}
+
+// checkErrors converts the error returned by a check into validation errors.
+// Validation errors keep their details (defaulting to CodeCustom); any other
+// error, such as a timeout, becomes a CodeCheckFailed error wrapping it.
+func checkErrors(err error, value any) ValidationErrors {
+	errs, ok := validationErrors(err)
+	if !ok {
+		return ValidationErrors{{
+			Message: "Validation check failed: " + err.Error(),
+			Value:   value,
+			Code:    CodeCheckFailed,
+			Params:  map[string]any{"reason": err.Error()},
+			Cause:   err,
+		}}
+	}
+
+	for i := range errs {
+		if errs[i].Code == "" {
+			errs[i].Code = CodeCustom
+		}
+		if errs[i].Value == nil {
+			errs[i].Value = value
+		}
+	}
+	return errs
+}
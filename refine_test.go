@@ -0,0 +1,308 @@
+package zogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test RefineCtx on strings with a lookup that rejects values
+func TestStringRefineCtx(t *testing.T) {
+	taken := map[string]bool{"admin": true}
+	schema := String().Min(3).RefineCtx(func(ctx context.Context, value any) error {
+		if taken[value.(string)] {
+			return ValidationError{Message: "Username is already taken"}
+		}
+		return nil
+	})
+
+	if !schema.Parse("alice").Ok {
+		t.Error("Expected available username to pass")
+	}
+
+	result := schema.Parse("admin")
+	if result.Ok || result.Errors[0].Code != CodeCustom || result.Errors[0].Message != "Username is already taken" {
+		t.Errorf("Expected custom error, got %v", result.Errors)
+	}
+
+	calls := 0
+	counted := String().Min(3).RefineCtx(func(ctx context.Context, value any) error {
+		calls++
+		return nil
+	})
+	counted.Parse("ab")
+	counted.Optional().Parse(nil)
+	if calls != 0 {
+		t.Error("Expected check to run only after other rules pass")
+	}
+}
+
+// Test RefineCtx receives the parse context and propagates its errors
+func TestRefineCtxContext(t *testing.T) {
+	schema := Object(Schema{
+		"token": String().RefineCtx(func(ctx context.Context, value any) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+				return nil
+			}
+		}),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	result := ParseCtx(ctx, schema, map[string]interface{}{"token": "abc"})
+	if result.Ok {
+		t.Fatal("Expected timed out check to fail")
+	}
+	err := result.Errors.ByPath("token")[0]
+	if err.Code != CodeCheckFailed || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected check_failed wrapping the deadline, got %+v", err)
+	}
+}
+
+// Test RefineCtx on numbers and dates, including multiple errors from one check
+func TestRefineCtxNumberDate(t *testing.T) {
+	number := Number().RefineCtx(func(ctx context.Context, value any) error {
+		if value.(float64) > 100 {
+			return ValidationErrors{
+				{Message: "Exceeds account limit", Code: "limit_exceeded"},
+				{Message: "Requires approval"},
+			}
+		}
+		return nil
+	})
+
+	result := number.Parse(500)
+	if len(result.Errors) != 2 || result.Errors[0].Code != "limit_exceeded" || result.Errors[1].Code != CodeCustom {
+		t.Errorf("Expected both check errors with codes, got %v", result.Errors)
+	}
+
+	holidays := Date().RefineCtx(func(ctx context.Context, value any) error {
+		if value.(time.Time).Month() == time.December && value.(time.Time).Day() == 25 {
+			return ValidationError{Message: "Closed on holidays"}
+		}
+		return nil
+	})
+	if holidays.Parse("2025-12-25T10:00:00Z").Ok {
+		t.Error("Expected holiday to fail")
+	}
+	if !holidays.Parse("2025-12-24T10:00:00Z").Ok {
+		t.Error("Expected regular day to pass")
+	}
+}
+
+// Test SuperRefine reports several issues with their own paths and codes
+func TestObjectSuperRefine(t *testing.T) {
+	schema := Object(Schema{
+		"items": Array(Object(Schema{
+			"sku":      String(),
+			"quantity": Number().Int(),
+		})),
+		"total": Number(),
+	}).SuperRefine(func(value any, ctx *RefinementCtx) {
+		order := value.(map[string]interface{})
+		items := order["items"].([]interface{})
+		sum := 0.0
+		for i, item := range items {
+			quantity := item.(map[string]interface{})["quantity"].(float64)
+			if quantity > 10 {
+				ctx.AddIssue(ValidationError{
+					Path:    fmt.Sprintf("items[%d].quantity", i),
+					Message: "At most 10 of each item",
+					Code:    "bulk_order",
+				})
+			}
+			sum += quantity
+		}
+		if sum != order["total"] {
+			ctx.AddIssue(ValidationError{Path: "total", Message: "Total must match the items"})
+		}
+	})
+
+	valid := map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"sku": "A", "quantity": float64(2)}},
+		"total": float64(2),
+	}
+	if result := schema.Parse(valid); !result.Ok {
+		t.Errorf("Expected valid order to pass: %v", result.Errors)
+	}
+
+	result := schema.Parse(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "A", "quantity": float64(2)},
+			map[string]interface{}{"sku": "B", "quantity": float64(20)},
+		},
+		"total": float64(5),
+	})
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 issues, got %v", result.Errors)
+	}
+	if err := result.Errors.ByPath("items[1].quantity"); len(err) != 1 || err[0].Code != "bulk_order" {
+		t.Errorf("Expected bulk_order issue on the item, got %v", result.Errors)
+	}
+	if err := result.Errors.ByPath("total"); len(err) != 1 || err[0].Code != CodeCustom {
+		t.Errorf("Expected custom issue on total, got %v", result.Errors)
+	}
+}
+
+// Test SuperRefine paths are nested under the refined value's path
+func TestSuperRefineNested(t *testing.T) {
+	schema := Object(Schema{
+		"ranges": Array(Number()).SuperRefine(func(value any, ctx *RefinementCtx) {
+			values := value.([]interface{})
+			for i := 1; i < len(values); i++ {
+				if values[i].(float64) < values[i-1].(float64) {
+					ctx.AddIssue(ValidationError{Path: fmt.Sprintf("[%d]", i), Message: "Must be ascending"})
+				}
+			}
+		}),
+		"code": String().SuperRefine(func(value any, ctx *RefinementCtx) {
+			if ctx.Context() == nil {
+				t.Error("Expected a context")
+			}
+			if !strings.HasPrefix(value.(string), "X") {
+				ctx.AddIssue(ValidationError{Message: "Must start with X"})
+			}
+		}),
+	})
+
+	result := schema.Parse(map[string]interface{}{
+		"ranges": []interface{}{float64(1), float64(3), float64(2)},
+		"code":   "A1",
+	})
+	if !result.Errors.HasPath("ranges[2]") || !result.Errors.HasPath("code") {
+		t.Errorf("Expected nested issue paths, got %v", result.Errors)
+	}
+
+	if result := schema.Parse(map[string]interface{}{"ranges": []interface{}{"x"}, "code": "X1"}); len(result.Errors) != 1 {
+		t.Errorf("Expected SuperRefine to be skipped when elements fail, got %v", result.Errors)
+	}
+}
+
+// Test panics in custom checks become refinement_panic errors
+func TestRefinePanicRecovery(t *testing.T) {
+	var nilMap map[string]int
+	schema := Object(Schema{
+		"name": String().Refine(func(s string) bool {
+			nilMap[s] = 1 // assignment to entry in nil map
+			return true
+		}, "unreachable"),
+		"age": Number().Min(0),
+		"tree": Lazy(func() Validator {
+			panic(errors.New("schema not initialized"))
+		}).Optional(),
+	})
+
+	result := schema.Parse(map[string]interface{}{"name": "ada", "age": -1, "tree": map[string]interface{}{}})
+	if result.Ok || len(result.Errors) != 3 {
+		t.Fatalf("Expected the panics and the other errors to be reported, got %v", result.Errors)
+	}
+
+	name := result.Errors.ByPath("name")
+	if len(name) != 1 || name[0].Code != CodeRefinementPanic || !strings.Contains(name[0].Message, "nil map") {
+		t.Fatalf("Expected a refinement_panic error at name, got %v", name)
+	}
+	var panicErr *PanicError
+	if !errors.As(name[0], &panicErr) || !strings.Contains(string(panicErr.Stack), "TestRefinePanicRecovery") {
+		t.Errorf("Expected the error to carry the stack of the panic")
+	}
+
+	tree := result.Errors.ByPath("tree")
+	var cause error
+	if len(tree) == 1 {
+		cause = tree[0]
+	}
+	if !errors.As(cause, &panicErr) || panicErr.Unwrap() == nil || panicErr.Unwrap().Error() != "schema not initialized" {
+		t.Errorf("Expected a Lazy factory panic to unwrap to its error, got %v", tree)
+	}
+	if !result.Errors.HasPath("age") {
+		t.Error("Expected validation to continue after a panic")
+	}
+
+	result = String().SuperRefine(func(value any, ctx *RefinementCtx) { panic("boom") }).Parse("x")
+	if result.Ok || result.Errors[0].Code != CodeRefinementPanic || result.Errors[0].Message != "Validation check panicked: boom" {
+		t.Errorf("Expected a SuperRefine panic to fail the parse, got %v", result.Errors)
+	}
+}
+
+// Test panics are recovered in validators parsed directly, not only inside composites
+func TestRefinePanicRecoveryDirectParse(t *testing.T) {
+	boom := func() { panic("boom") }
+	tests := map[string]ParseResult{
+		"boolean": Boolean().Refine(func(bool) bool { boom(); return true }, "x").Parse(true),
+		"enum":    Enum([]interface{}{"a"}).Refine(func(any) bool { boom(); return true }, "x").Parse("a"),
+		"literal": Literal("a").Refine(func(any) bool { boom(); return true }, "x").Parse("a"),
+	}
+	for name, result := range tests {
+		if result.Ok || result.Errors[0].Code != CodeRefinementPanic || result.Errors[0].Message != "Validation check panicked: boom" {
+			t.Errorf("Expected a %s refinement panic to fail the parse, got %v", name, result.Errors)
+		}
+	}
+}
+
+// TestRefineComposite tests Refine on validators other than String, Number and Date
+func TestRefineComposite(t *testing.T) {
+	sorted := Array(Number()).Refine(func(items []interface{}) bool {
+		for i := 1; i < len(items); i++ {
+			if items[i].(float64) < items[i-1].(float64) {
+				return false
+			}
+		}
+		return true
+	}, "Array must be sorted")
+	if !sorted.Parse([]interface{}{1, 2, 3}).Ok {
+		t.Error("Expected sorted array to pass")
+	}
+	result := sorted.Parse([]interface{}{3, 1})
+	if result.Ok || result.Errors[0].Message != "Array must be sorted" || result.Errors[0].Code != CodeCustom {
+		t.Errorf("Expected the refinement error, got %v", result.Errors)
+	}
+	if result := sorted.Parse([]interface{}{"a"}); result.Ok || result.Errors[0].Code == CodeCustom {
+		t.Errorf("Expected refinements to run only on valid arrays, got %v", result.Errors)
+	}
+
+	hasDefault := Record(String(), Number()).Refine(func(m map[string]interface{}) bool {
+		_, ok := m["default"]
+		return ok
+	}, "Missing default")
+	if !hasDefault.Parse(map[string]interface{}{"default": 1}).Ok || hasDefault.Parse(map[string]interface{}{"other": 1}).Ok {
+		t.Error("Expected record refinement to require the default key")
+	}
+
+	cases := []struct {
+		name      string
+		validator Validator
+		valid     any
+		invalid   any
+	}{
+		{"boolean", Boolean().Refine(func(b bool) bool { return b }, "Must accept"), true, false},
+		{"tuple", Tuple(Number(), Number()).Refine(func(items []interface{}) bool {
+			return items[0].(float64) <= items[1].(float64)
+		}, "Invalid range"), []interface{}{1, 2}, []interface{}{2, 1}},
+		{"union", Union(String(), Number()).Refine(func(v any) bool { return v != "" }, "Must not be empty"), 0, ""},
+		{"enum", Enum([]interface{}{"a", "b"}).Refine(func(v any) bool { return v != "b" }, "Not b"), "a", "b"},
+		{"literal", Literal("x").Refine(func(v any) bool { return false }, "Never"), nil, "x"},
+		{"intersection", Intersection(
+			Object(Schema{"min": Number()}).Passthrough(),
+			Object(Schema{"max": Number()}).Passthrough(),
+		).Refine(func(v any) bool {
+			m := v.(map[string]interface{})
+			return m["min"].(float64) <= m["max"].(float64)
+		}, "min must not exceed max"), map[string]interface{}{"min": 1.0, "max": 2.0}, map[string]interface{}{"min": 3.0, "max": 2.0}},
+	}
+	for _, c := range cases {
+		if c.valid != nil && !c.validator.Parse(c.valid).Ok {
+			t.Errorf("%s: expected %v to pass", c.name, c.valid)
+		}
+		result := c.validator.Parse(c.invalid)
+		if result.Ok || result.Errors[0].Code != CodeCustom {
+			t.Errorf("%s: expected %v to fail the refinement, got %v", c.name, c.invalid, result.Errors)
+		}
+	}
+}
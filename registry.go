@@ -0,0 +1,48 @@
+package zogo
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Validator{}
+)
+
+// Register adds a named schema to the global registry, replacing any schema
+// previously registered under the same name. Registered schemas are exported
+// as OpenAPI components and snapshotted by AssertRegistrySnapshot.
+func Register(name string, validator Validator) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = validator
+}
+
+// Unregister removes a named schema from the global registry
+func Unregister(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	delete(registry, name)
+}
+
+// Lookup returns the schema registered under name
+func Lookup(name string) (Validator, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	validator, ok := registry[name]
+	return validator, ok
+}
+
+// Registered returns the names of all registered schemas, sorted
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
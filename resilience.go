@@ -0,0 +1,86 @@
+package zogo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RetryPolicy configures WithRetry
+type RetryPolicy struct {
+	Attempts   int              // Total attempts including the first (default 3)
+	Backoff    time.Duration    // Delay before the first retry (default 100ms)
+	Multiplier float64          // Backoff growth per retry (default 2)
+	MaxBackoff time.Duration    // Upper bound for the delay (0 = no bound)
+	RetryIf    func(error) bool // Reports whether an error is retryable (default: all non-validation errors)
+}
+
+// WithRetry retries a check that fails with a non-validation error, waiting
+// with exponential backoff between attempts. Validation errors are returned
+// immediately, and waiting stops when the context is done.
+func WithRetry(check CheckFunc, policy RetryPolicy) CheckFunc {
+	if policy.Attempts <= 0 {
+		policy.Attempts = 3
+	}
+	if policy.Backoff <= 0 {
+		policy.Backoff = 100 * time.Millisecond
+	}
+	if policy.Multiplier < 1 {
+		policy.Multiplier = 2
+	}
+
+	return func(ctx context.Context, value any) error {
+		delay := policy.Backoff
+		var err error
+		for attempt := 1; ; attempt++ {
+			err = check(ctx, value)
+			if err == nil || attempt >= policy.Attempts {
+				return err
+			}
+			if _, invalid := validationErrors(err); invalid {
+				return err
+			}
+			if policy.RetryIf != nil && !policy.RetryIf(err) {
+				return err
+			}
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+
+			delay = time.Duration(float64(delay) * policy.Multiplier)
+			if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+				delay = policy.MaxBackoff
+			}
+		}
+	}
+}
+
+// WithTimeout bounds a check's running time. The check receives a context
+// with the deadline; if it does not return in time, WithTimeout returns an
+// error wrapping context.DeadlineExceeded without waiting for it.
+func WithTimeout(check CheckFunc, timeout time.Duration) CheckFunc {
+	return func(ctx context.Context, value any) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		done := make(chan error, 1)
+		go func() {
+			done <- check(ctx, value)
+		}()
+
+		select {
+		case err := <-done:
+			return err
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("zogo: check timed out after %s: %w", timeout, ctx.Err())
+			}
+			return ctx.Err()
+		}
+	}
+}
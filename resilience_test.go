@@ -0,0 +1,102 @@
+package zogo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Test WithRetry retries dependency failures until success
+func TestWithRetry(t *testing.T) {
+	calls := 0
+	check := WithRetry(func(ctx context.Context, value any) error {
+		calls++
+		if calls < 3 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	}, RetryPolicy{Attempts: 3, Backoff: time.Millisecond})
+
+	if err := check(context.Background(), "x"); err != nil || calls != 3 {
+		t.Errorf("Expected success on the third attempt, got %v after %d calls", err, calls)
+	}
+
+	calls = 0
+	failing := WithRetry(func(ctx context.Context, value any) error {
+		calls++
+		return errors.New("down")
+	}, RetryPolicy{Attempts: 2, Backoff: time.Millisecond})
+
+	if err := failing(context.Background(), "x"); err == nil || calls != 2 {
+		t.Errorf("Expected failure after 2 attempts, got %v after %d calls", err, calls)
+	}
+}
+
+// Test WithRetry does not retry validation errors or non-retryable errors
+func TestWithRetryStops(t *testing.T) {
+	calls := 0
+	invalid := WithRetry(func(ctx context.Context, value any) error {
+		calls++
+		return ValidationError{Message: "Username is taken"}
+	}, RetryPolicy{Backoff: time.Millisecond})
+
+	if _, ok := validationErrors(invalid(context.Background(), "x")); !ok || calls != 1 {
+		t.Errorf("Expected validation error without retry, got %d calls", calls)
+	}
+
+	calls = 0
+	permanent := errors.New("permanent")
+	check := WithRetry(func(ctx context.Context, value any) error {
+		calls++
+		return permanent
+	}, RetryPolicy{Backoff: time.Millisecond, RetryIf: func(err error) bool { return err != permanent }})
+
+	if err := check(context.Background(), "x"); err != permanent || calls != 1 {
+		t.Errorf("Expected permanent error without retry, got %v after %d calls", err, calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	slow := WithRetry(func(ctx context.Context, value any) error {
+		return errors.New("down")
+	}, RetryPolicy{Backoff: time.Hour})
+	if err := slow(ctx, "x"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected cancellation during backoff, got %v", err)
+	}
+}
+
+// Test WithTimeout returns when the check is too slow
+func TestWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	check := WithTimeout(func(ctx context.Context, value any) error {
+		<-release // Ignores the context
+		return nil
+	}, 10*time.Millisecond)
+
+	err := check(context.Background(), "x")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline error, got %v", err)
+	}
+
+	fast := WithTimeout(func(ctx context.Context, value any) error {
+		return ValidationError{Message: "invalid"}
+	}, time.Second)
+	if _, ok := validationErrors(fast(context.Background(), "x")); !ok {
+		t.Error("Expected the check's own result when it finishes in time")
+	}
+}
+
+// Test combinators compose with Expensive
+func TestResilienceWithExpensive(t *testing.T) {
+	schema := Expensive("lookup", String(), WithTimeout(WithRetry(func(ctx context.Context, value any) error {
+		return errors.New("down")
+	}, RetryPolicy{Attempts: 2, Backoff: time.Millisecond}), time.Second))
+
+	result := schema.Parse("x")
+	if !result.Ok || len(result.Meta.Skipped) != 1 || result.Meta.Skipped[0].Reason != SkipCheckError {
+		t.Errorf("Expected exhausted retries to be recorded as a skipped check, got %+v", result)
+	}
+}
@@ -5,6 +5,24 @@ type ParseResult struct {
 	Ok     bool
 	Value  any
 	Errors ValidationErrors
+	Meta   ParseMeta // Information about how the value was validated
+}
+
+// ParseMeta carries information about a parse beyond its errors
+type ParseMeta struct {
+	Skipped   []SkippedCheck // Expensive checks that did not run or could not complete
+	Sensitive []string       // Paths of values marked Sensitive
+	NonFinite []string       // Paths of NaN and infinite numbers in the value, which JSON cannot represent
+	Usage     ParseUsage     // Work done by the parse, counted toward its Quota
+}
+
+// ParseUsage counts the work done by a parse call, so platforms can attribute
+// validation cost to callers. Work done by union members that did not match
+// is included.
+type ParseUsage struct {
+	Fields      int // Object fields and record entries visited
+	StringBytes int // Bytes of strings validated
+	RegexEvals  int // Regex rules (Email, URL, UUID, Regex) of the strings validated
 }
 
 // Success creates a successful parse result
@@ -38,11 +56,25 @@ func FailureWithCode(message string, code string) ParseResult {
 	})
 }
 
+// FailureWithParams creates a failed parse result with a message, code and
+// the parameters used to translate the message
+func FailureWithParams(message string, code ErrorCode, params map[string]any) ParseResult {
+	return Failure(ValidationError{
+		Message: message,
+		Code:    code,
+		Params:  params,
+	})
+}
+
 // FailureTypeMismatch creates a type mismatch error
 func FailureTypeMismatch(expected string, received any) ParseResult {
 	return Failure(ValidationError{
 		Message: "Expected " + expected + ", received " + typeof(received),
-		Code:    "invalid_type",
+		Code:    CodeInvalidType,
 		Value:   received,
+		Params: map[string]any{
+			"expected": expected,
+			"received": typeof(received),
+		},
 	})
 }
@@ -0,0 +1,122 @@
+package zogo
+
+import (
+	"context"
+	"math/rand/v2"
+)
+
+// Divergence describes an input on which a candidate schema disagrees with
+// the primary schema
+type Divergence struct {
+	Input     any              // The input both schemas validated
+	Primary   ParseResult      // The result served to the caller
+	Candidate ParseResult      // The candidate's result
+	Added     ValidationErrors // Candidate errors the primary did not report (by path and code)
+	Removed   ValidationErrors // Primary errors the candidate did not report
+}
+
+// DivergenceFunc receives the inputs on which a shadowed candidate diverges
+type DivergenceFunc func(ctx context.Context, divergence Divergence)
+
+// ShadowValidator validates values against a primary schema while comparing
+// a candidate schema's results
+type ShadowValidator struct {
+	primary    Validator
+	candidate  Validator
+	report     DivergenceFunc
+	sampleRate float64
+	random     func() float64 // Source for sampling, replaced in tests
+}
+
+// Shadow validates values against primary and serves its result, while also
+// validating them against candidate and reporting any disagreement to the
+// OnDivergence callback. It lets a stricter schema version run against
+// production traffic before it is rolled out:
+//
+//	orders := zogo.Shadow(ordersV1, ordersV2).OnDivergence(func(ctx context.Context, d zogo.Divergence) {
+//		logger.WarnContext(ctx, "orders v2 diverges", "added", d.Added, "removed", d.Removed)
+//	})
+//
+// The candidate's errors don't count toward the parse, and it never changes
+// the result.
+func Shadow(primary, candidate Validator) *ShadowValidator {
+	return &ShadowValidator{
+		primary:    primary,
+		candidate:  candidate,
+		sampleRate: 1,
+		random:     rand.Float64,
+	}
+}
+
+// OnDivergence sets the callback receiving divergences
+func (v *ShadowValidator) OnDivergence(report DivergenceFunc) *ShadowValidator {
+	v.report = report
+	return v
+}
+
+// Sample validates the given fraction of values (0 to 1) against the candidate
+func (v *ShadowValidator) Sample(rate float64) *ShadowValidator {
+	v.sampleRate = rate
+	return v
+}
+
+// Parse validates the input value against the primary schema
+func (v *ShadowValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *ShadowValidator) parseWithState(value any, st *parseState) ParseResult {
+	result := st.parse(v.primary, value)
+	if v.report == nil || (v.sampleRate < 1 && v.random() >= v.sampleRate) {
+		return result
+	}
+
+	candidate := st.isolate().parse(v.candidate, value)
+	if divergence, ok := DiffResults(result, candidate); ok {
+		divergence.Input = value
+		v.report(st.ctx, divergence)
+	}
+	return result
+}
+
+// DiffResults compares the results of two schemas for the same input. They
+// diverge if one passes and the other fails, if both pass with different
+// values, or if they fail with different errors, compared by path and code.
+func DiffResults(primary, candidate ParseResult) (Divergence, bool) {
+	divergence := Divergence{
+		Primary:   primary,
+		Candidate: candidate,
+		Added:     missingErrors(candidate.Errors, primary.Errors),
+		Removed:   missingErrors(primary.Errors, candidate.Errors),
+	}
+
+	switch {
+	case primary.Ok != candidate.Ok:
+		return divergence, true
+	case primary.Ok:
+		return divergence, !deepEqual(primary.Value, candidate.Value)
+	}
+	return divergence, len(divergence.Added) > 0 || len(divergence.Removed) > 0
+}
+
+// missingErrors returns the errors in errs with no error at the same path
+// and with the same code in other
+func missingErrors(errs, other ValidationErrors) ValidationErrors {
+	type issue struct {
+		path string
+		code ErrorCode
+	}
+	seen := make(map[issue]bool, len(other))
+	for _, err := range other {
+		seen[issue{err.Path, err.Code}] = true
+	}
+
+	var missing ValidationErrors
+	for _, err := range errs {
+		if !seen[issue{err.Path, err.Code}] {
+			missing = append(missing, err)
+		}
+	}
+	return missing
+}
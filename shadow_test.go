@@ -0,0 +1,85 @@
+package zogo
+
+import (
+	"context"
+	"testing"
+)
+
+// Test the primary result is served and divergences are reported
+func TestShadow(t *testing.T) {
+	var divergences []Divergence
+	primary := Object(Schema{"name": String(), "age": Number()})
+	candidate := Object(Schema{"name": String().Min(2), "age": Number().Int()})
+	schema := Object(Schema{
+		"user": Shadow(primary, candidate).OnDivergence(func(ctx context.Context, d Divergence) {
+			divergences = append(divergences, d)
+		}),
+	})
+
+	// Both agree
+	result := schema.Parse(map[string]interface{}{"user": map[string]interface{}{"name": "Ada", "age": 36}})
+	if !result.Ok || len(divergences) != 0 {
+		t.Fatalf("Expected no divergence, got %v %v", result.Errors, divergences)
+	}
+
+	// The candidate is stricter
+	input := map[string]interface{}{"name": "A", "age": 36.5}
+	result = schema.Parse(map[string]interface{}{"user": input})
+	if !result.Ok {
+		t.Errorf("Expected the primary result to be served, got %v", result.Errors)
+	}
+	if len(divergences) != 1 {
+		t.Fatalf("Expected one divergence, got %d", len(divergences))
+	}
+	d := divergences[0]
+	if !d.Primary.Ok || d.Candidate.Ok || len(d.Added) != 2 || !d.Added.HasPath("name") || !d.Added.HasPath("age") || len(d.Removed) != 0 {
+		t.Errorf("Unexpected divergence: %+v", d)
+	}
+	if d.Input.(map[string]interface{})["name"] != "A" {
+		t.Errorf("Expected the input in the divergence, got %v", d.Input)
+	}
+
+	// Both fail, differently
+	divergences = nil
+	result = schema.Parse(map[string]interface{}{"user": map[string]interface{}{"name": 1, "age": 1.5}})
+	if result.Ok || len(result.Errors) != 1 || !result.Errors.HasPath("user.name") {
+		t.Errorf("Expected only the primary errors, got %v", result.Errors)
+	}
+	if len(divergences) != 1 || len(divergences[0].Added) != 1 || !divergences[0].Added.HasPath("age") {
+		t.Errorf("Expected the added age error, got %+v", divergences)
+	}
+}
+
+// Test DiffResults compares values of passing results
+func TestDiffResults(t *testing.T) {
+	if _, ok := DiffResults(String().Parse("a"), String().Trim().Parse("a")); ok {
+		t.Error("Expected equal results not to diverge")
+	}
+	d, ok := DiffResults(String().Parse(" a "), String().Trim().Parse(" a "))
+	if !ok || d.Candidate.Value != "a" {
+		t.Errorf("Expected different values to diverge, got %+v", d)
+	}
+	d, ok = DiffResults(Number().Int().Parse(1.5), Number().Parse(1.5))
+	if !ok || len(d.Removed) != 1 || d.Removed[0].Code != CodeNotInteger {
+		t.Errorf("Expected a removed error, got %+v", d)
+	}
+}
+
+// Test sampling skips the candidate
+func TestShadowSample(t *testing.T) {
+	calls := 0
+	candidate := String().Refine(func(string) bool { calls++; return true }, "never")
+	schema := Shadow(String(), candidate).Sample(0.5).OnDivergence(func(context.Context, Divergence) {})
+	rolls := []float64{0.7, 0.2}
+	schema.random = func() float64 {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	}
+
+	schema.Parse("a")
+	schema.Parse("b")
+	if calls != 1 {
+		t.Errorf("Expected the candidate to run once, got %d", calls)
+	}
+}
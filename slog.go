@@ -0,0 +1,48 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogSink returns a valve sink logging rejected payloads to logger at the
+// given level, with the schema name, redacted payload and errors as attributes
+func SlogSink(logger *slog.Logger, level slog.Level) RejectionSink {
+	return func(ctx context.Context, rejection Rejection) {
+		logger.LogAttrs(ctx, level, "zogo: payload rejected",
+			slog.String("schema", rejection.Schema),
+			slog.Any("payload", rejection.Payload),
+			slog.Any("errors", rejection.Errors),
+		)
+	}
+}
+
+// LogValue implements slog.LogValuer, logging the error as a group with its
+// path, code and message
+func (e ValidationError) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("path", e.Path),
+		slog.String("code", e.Code),
+		slog.String("message", e.Message),
+	)
+}
+
+// LogValue implements slog.LogValuer, logging the errors as a list of
+// {path, code, message} objects instead of one long string, so log
+// aggregators can search by field
+func (e ValidationErrors) LogValue() slog.Value {
+	issues := make([]logIssue, len(e))
+	for i, err := range e {
+		issues[i] = logIssue{Path: err.Path, Code: err.Code, Message: err.Message}
+	}
+	return slog.AnyValue(issues)
+}
+
+// logIssue is the structured form of an error in logs
+type logIssue struct {
+	Path    string `json:"path"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
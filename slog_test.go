@@ -0,0 +1,65 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+// Test the slog sink logs rejections as structured attributes
+func TestSlogSink(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	valve := NewValve(SlogSink(logger, slog.LevelWarn))
+
+	schema := Object(Schema{"password": Sensitive(String().Min(12))})
+	ParseCtx(context.Background(), schema, map[string]interface{}{"password": "short"}, WithValve(valve), WithSchemaName("Login"))
+
+	var entry struct {
+		Level   string                   `json:"level"`
+		Schema  string                   `json:"schema"`
+		Payload map[string]interface{}   `json:"payload"`
+		Errors  []map[string]interface{} `json:"errors"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected a JSON log entry, got %q", buf.String())
+	}
+	if entry.Level != "WARN" || entry.Schema != "Login" || entry.Payload["password"] != Redacted {
+		t.Errorf("Unexpected log entry: %s", buf.String())
+	}
+	if len(entry.Errors) != 1 || entry.Errors[0]["path"] != "password" {
+		t.Errorf("Expected structured errors, got %s", buf.String())
+	}
+}
+
+// Test errors are logged as structured arrays
+func TestValidationErrorsLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	result := Object(Schema{"name": String(), "age": Number().Min(18)}).Parse(map[string]interface{}{"age": float64(3)})
+	logger.Error("invalid input", "errors", result.Errors, "first", result.Errors[0])
+
+	var entry struct {
+		Errors []map[string]string `json:"errors"`
+		First  map[string]string   `json:"first"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected a JSON log entry, got %q", buf.String())
+	}
+	if len(entry.Errors) != 2 {
+		t.Fatalf("Expected 2 structured errors, got %s", buf.String())
+	}
+	for _, err := range entry.Errors {
+		if err["path"] == "" || err["code"] == "" || err["message"] == "" {
+			t.Errorf("Expected path, code and message, got %v", err)
+		}
+	}
+	if entry.First["path"] != result.Errors[0].Path || entry.First["code"] != result.Errors[0].Code {
+		t.Errorf("Expected the single error as a group, got %v", entry.First)
+	}
+}
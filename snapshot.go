@@ -0,0 +1,93 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// UpdateSnapshotsEnv is the environment variable that makes snapshot
+// assertions rewrite their files instead of comparing against them:
+//
+//	ZOGO_UPDATE_SNAPSHOTS=1 go test ./...
+const UpdateSnapshotsEnv = "ZOGO_UPDATE_SNAPSHOTS"
+
+// SnapshotDir is the directory snapshots are stored in, relative to the
+// package under test
+var SnapshotDir = filepath.Join("testdata", "schemas")
+
+// AssertSchemaSnapshot compares the JSON Schema export of a validator with
+// the golden file SnapshotDir/<name>.json and fails the test when it changed
+func AssertSchemaSnapshot(t TB, name string, validator Validator) {
+	t.Helper()
+	assertSnapshot(t, name+".json", ToJSONSchema(validator))
+}
+
+// AssertRegistrySnapshot compares the OpenAPI components export of every
+// registered schema with the golden file SnapshotDir/openapi.json. Adding,
+// removing or changing a registered schema fails the test until the
+// snapshot is updated.
+func AssertRegistrySnapshot(t TB) {
+	t.Helper()
+	assertSnapshot(t, "openapi.json", map[string]any{"components": OpenAPIComponents()})
+}
+
+// assertSnapshot compares (or, when updating, writes) a golden JSON file
+func assertSnapshot(t TB, file string, export map[string]any) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		t.Fatalf("zogo: encoding snapshot %s: %v", file, err)
+		return
+	}
+	got = append(got, '\n')
+
+	path := filepath.Join(SnapshotDir, file)
+	if os.Getenv(UpdateSnapshotsEnv) != "" {
+		if err := os.MkdirAll(SnapshotDir, 0o755); err != nil {
+			t.Fatalf("zogo: creating snapshot directory: %v", err)
+			return
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("zogo: writing snapshot %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("zogo: reading snapshot %s: %v (run with %s=1 to create it)", path, err, UpdateSnapshotsEnv)
+		return
+	}
+
+	if !bytes.Equal(want, got) {
+		t.Errorf("zogo: schema export changed from snapshot %s\n%s\nrun with %s=1 to accept the change",
+			path, snapshotDiff(string(want), string(got)), UpdateSnapshotsEnv)
+	}
+}
+
+// snapshotDiff describes the first line that differs between two snapshots
+func snapshotDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("first difference at line %d:\n  - %s\n  + %s", i+1, w, g)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,113 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// withSnapshotDir points SnapshotDir at a temporary directory for one test
+func withSnapshotDir(t *testing.T) string {
+	dir := t.TempDir()
+	previous := SnapshotDir
+	SnapshotDir = dir
+	t.Cleanup(func() { SnapshotDir = previous })
+	return dir
+}
+
+// Test the committed golden snapshot of an example schema
+func TestSchemaSnapshotGolden(t *testing.T) {
+	AssertSchemaSnapshot(t, "user", Object(Schema{
+		"email": String().Email(),
+		"age":   Number().Int().Min(18).Optional(),
+		"role":  Enum([]interface{}{"admin", "member"}).Default("member"),
+	}))
+}
+
+// Test snapshots are created on update and changes are reported
+func TestSchemaSnapshotDetectsChanges(t *testing.T) {
+	dir := withSnapshotDir(t)
+
+	tb := &recordingTB{}
+	AssertSchemaSnapshot(tb, "name", String().Min(1))
+	if len(tb.failures) != 1 || !strings.Contains(tb.failures[0], UpdateSnapshotsEnv) {
+		t.Errorf("Expected missing snapshot to fail with update hint, got %v", tb.failures)
+	}
+
+	t.Setenv(UpdateSnapshotsEnv, "1")
+	tb = &recordingTB{}
+	AssertSchemaSnapshot(tb, "name", String().Min(1))
+	if len(tb.failures) != 0 {
+		t.Fatalf("Expected update to succeed, got %v", tb.failures)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "name.json")); err != nil {
+		t.Fatalf("Expected snapshot file to be written: %v", err)
+	}
+
+	t.Setenv(UpdateSnapshotsEnv, "")
+	tb = &recordingTB{}
+	AssertSchemaSnapshot(tb, "name", String().Min(1))
+	if len(tb.failures) != 0 {
+		t.Errorf("Expected unchanged export to pass, got %v", tb.failures)
+	}
+
+	tb = &recordingTB{}
+	AssertSchemaSnapshot(tb, "name", String().Min(2))
+	if len(tb.failures) != 1 || !strings.Contains(tb.failures[0], `+   "minLength": 2`) {
+		t.Errorf("Expected changed export to fail with a diff, got %v", tb.failures)
+	}
+}
+
+// Test registry snapshots cover every registered schema
+func TestRegistrySnapshot(t *testing.T) {
+	withSnapshotDir(t)
+
+	Register("SnapshotUser", Object(Schema{"name": String()}))
+	t.Cleanup(func() { Unregister("SnapshotUser") })
+
+	t.Setenv(UpdateSnapshotsEnv, "1")
+	AssertRegistrySnapshot(t)
+	t.Setenv(UpdateSnapshotsEnv, "")
+
+	Register("SnapshotOrder", Object(Schema{"id": String()}))
+	t.Cleanup(func() { Unregister("SnapshotOrder") })
+
+	tb := &recordingTB{}
+	AssertRegistrySnapshot(tb)
+	if len(tb.failures) != 1 {
+		t.Errorf("Expected newly registered schema to fail the snapshot, got %v", tb.failures)
+	}
+}
+
+// Test Register, Lookup and OpenAPI components export
+func TestRegistry(t *testing.T) {
+	var comment Validator
+	comment = Lazy(func() Validator {
+		return Object(Schema{"replies": Array(comment)})
+	})
+	Register("RegistryComment", comment)
+	t.Cleanup(func() { Unregister("RegistryComment") })
+
+	if _, ok := Lookup("RegistryComment"); !ok {
+		t.Error("Expected registered schema to be found")
+	}
+	found := false
+	for _, name := range Registered() {
+		found = found || name == "RegistryComment"
+	}
+	if !found {
+		t.Errorf("Expected RegistryComment in %v", Registered())
+	}
+
+	schemas := OpenAPIComponents()["schemas"].(map[string]any)
+	root := schemas["RegistryComment"].(map[string]any)
+	if root["$ref"] != "#/components/schemas/RegistryComment_Lazy1" {
+		t.Errorf("Expected component reference, got %v", root)
+	}
+	if _, ok := schemas["RegistryComment_Lazy1"]; !ok {
+		t.Error("Expected recursive definition in components")
+	}
+}
@@ -0,0 +1,139 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// StatusPolicy maps validation errors to HTTP status codes. It is used by the
+// HTTP helpers (Gateway and WriteErrors), so an API's choice between 400 and
+// 422, or its status for oversized bodies, is made in one place:
+//
+//	zogo.SetStatusPolicy(zogo.DefaultStatusPolicy().Fallback(http.StatusUnprocessableEntity))
+type StatusPolicy struct {
+	fallback int
+	codes    map[ErrorCode]int
+}
+
+// NewStatusPolicy creates a policy responding with fallback to every error
+func NewStatusPolicy(fallback int) *StatusPolicy {
+	return &StatusPolicy{
+		fallback: fallback,
+		codes:    map[ErrorCode]int{},
+	}
+}
+
+// DefaultStatusPolicy returns the policy used unless another one is set:
+// 413 for oversized bodies or payloads exceeding a quota, 415 for request
+// bodies that are not JSON, 503 when a check
+// could not complete or the request was canceled, 500 when a check
+// panicked, and 400 for everything else
+func DefaultStatusPolicy() *StatusPolicy {
+	return NewStatusPolicy(http.StatusBadRequest).
+		Map(http.StatusRequestEntityTooLarge, CodePayloadTooLarge, CodeQuotaExceeded).
+		Map(http.StatusUnsupportedMediaType, CodeUnsupportedMediaType).
+		Map(http.StatusServiceUnavailable, CodeCheckFailed, CodeCanceled).
+		Map(http.StatusInternalServerError, CodeRefinementPanic)
+}
+
+// Fallback sets the status for errors whose codes are not mapped
+func (p *StatusPolicy) Fallback(status int) *StatusPolicy {
+	p.fallback = status
+	return p
+}
+
+// Map responds with status to errors with the given codes. A code also
+// covers its subcodes, so CodeInvalidString maps "invalid_string.email".
+func (p *StatusPolicy) Map(status int, codes ...ErrorCode) *StatusPolicy {
+	for _, code := range codes {
+		p.codes[code] = status
+	}
+	return p
+}
+
+// Status returns the status for a set of errors: the status of the first
+// error with a mapped code, or the fallback if none is mapped. It returns
+// 200 if there are no errors.
+func (p *StatusPolicy) Status(errs ValidationErrors) int {
+	if len(errs) == 0 {
+		return http.StatusOK
+	}
+	for _, err := range errs {
+		if status, ok := p.codeStatus(err.Code); ok {
+			return status
+		}
+	}
+	return p.fallback
+}
+
+// codeStatus looks up the status for a code or the code it is a subcode of
+func (p *StatusPolicy) codeStatus(code ErrorCode) (int, bool) {
+	for {
+		if status, ok := p.codes[code]; ok {
+			return status, true
+		}
+		i := strings.LastIndexByte(string(code), '.')
+		if i < 0 {
+			return 0, false
+		}
+		code = code[:i]
+	}
+}
+
+var globalStatusPolicy atomic.Pointer[StatusPolicy]
+
+// SetStatusPolicy sets the policy used by the HTTP helpers; nil restores
+// DefaultStatusPolicy
+func SetStatusPolicy(policy *StatusPolicy) {
+	globalStatusPolicy.Store(policy)
+}
+
+// statusPolicy returns the policy set with SetStatusPolicy, or the default
+func statusPolicy() *StatusPolicy {
+	if policy := globalStatusPolicy.Load(); policy != nil {
+		return policy
+	}
+	return defaultStatusPolicy
+}
+
+var defaultStatusPolicy = DefaultStatusPolicy()
+
+// HTTPStatus returns the status for errors under the policy set with
+// SetStatusPolicy
+func HTTPStatus(errs ValidationErrors) int {
+	return statusPolicy().Status(errs)
+}
+
+// WriteErrors writes errors as a JSON response, with the status chosen by the
+// policy set with SetStatusPolicy:
+//
+//	result := users.ParseRequest(r, body)
+//	if !result.Ok {
+//		zogo.WriteErrors(w, result.Errors)
+//		return
+//	}
+func WriteErrors(w http.ResponseWriter, errs ValidationErrors) {
+	writeErrorResponse(w, HTTPStatus(errs), "Request validation failed", errs)
+}
+
+// writeErrorResponse writes a JSON error response. The body is encoded before
+// anything is written, so a value that cannot be encoded leaves out the
+// errors instead of cutting the response short.
+func writeErrorResponse(w http.ResponseWriter, status int, message string, errs ValidationErrors) {
+	body := map[string]any{"message": message}
+	if len(errs) > 0 {
+		body["errors"] = errs.Issues()
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		data, _ = json.Marshal(map[string]any{"message": message})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(data, '\n'))
+}
@@ -0,0 +1,90 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Test status policies map codes, subcodes and the fallback
+func TestStatusPolicy(t *testing.T) {
+	policy := NewStatusPolicy(http.StatusUnprocessableEntity).
+		Map(http.StatusBadRequest, CodeInvalidJSON, CodeInvalidString).
+		Map(http.StatusRequestEntityTooLarge, CodePayloadTooLarge)
+
+	tests := []struct {
+		errs     ValidationErrors
+		expected int
+	}{
+		{nil, http.StatusOK},
+		{ValidationErrors{{Code: CodeTooSmall}}, http.StatusUnprocessableEntity},
+		{ValidationErrors{{Code: CodeInvalidJSON}}, http.StatusBadRequest},
+		{ValidationErrors{{Code: CodeInvalidEmail}}, http.StatusBadRequest},
+		{ValidationErrors{{Code: CodeTooSmall}, {Code: CodePayloadTooLarge}}, http.StatusRequestEntityTooLarge},
+		{ValidationErrors{{Code: CodePayloadTooLarge}, {Code: CodeInvalidJSON}}, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Status(tt.errs); got != tt.expected {
+			t.Errorf("Expected %d for %v, got %d", tt.expected, tt.errs, got)
+		}
+	}
+
+	defaults := DefaultStatusPolicy()
+	if got := defaults.Status(ValidationErrors{{Code: CodeInvalidType}}); got != http.StatusBadRequest {
+		t.Errorf("Expected default 400, got %d", got)
+	}
+	if got := defaults.Status(ValidationErrors{{Code: CodeCheckFailed}}); got != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503 for check_failed, got %d", got)
+	}
+	if got := defaults.Status(ValidationErrors{{Code: CodeQuotaExceeded}}); got != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413 for quota_exceeded, got %d", got)
+	}
+	if got := defaults.Status(ValidationErrors{{Code: CodeRefinementPanic}}); got != http.StatusInternalServerError {
+		t.Errorf("Expected 500 for refinement_panic, got %d", got)
+	}
+}
+
+// Test WriteErrors uses the policy set with SetStatusPolicy
+func TestWriteErrors(t *testing.T) {
+	errs := String().Min(3).Parse("a").Errors
+
+	rec := httptest.NewRecorder()
+	WriteErrors(rec, errs)
+	if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Expected 400 JSON response, got %d", rec.Code)
+	}
+
+	SetStatusPolicy(DefaultStatusPolicy().Fallback(http.StatusUnprocessableEntity))
+	defer SetStatusPolicy(nil)
+
+	rec = httptest.NewRecorder()
+	WriteErrors(rec, errs)
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected 422 with the custom policy, got %d", rec.Code)
+	}
+
+	var body struct {
+		Errors []map[string]interface{} `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || len(body.Errors) != 1 || body.Errors[0]["code"] != string(CodeTooSmall) {
+		t.Errorf("Expected the errors in the body, got %s", rec.Body.String())
+	}
+}
+
+// Test error responses with non-finite values are written in full
+func TestWriteErrorsNonFinite(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteErrors(rec, ValidationErrors{{Path: "score", Message: "Too big", Value: math.Inf(1), Code: CodeTooBig}})
+
+	var body struct {
+		Errors []map[string]interface{} `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || len(body.Errors) != 1 || body.Errors[0]["received"] != InfinityToken {
+		t.Errorf("Expected the error with a sanitized value, got %s", rec.Body.String())
+	}
+}
@@ -1,48 +1,108 @@
 package zogo
 
 import (
+	"encoding/base32"
+	"encoding/base64"
 	"fmt"
+	"net/netip"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 type StringValidator struct {
 	// Validation rules
-	minLen   *int
-	maxLen   *int
-	exactLen *int
-	pattern  *regexp.Regexp
+	minLen     *int
+	maxLen     *int
+	exactLen   *int
+	isNonEmpty bool
+	oneOf      []string
+	oneOfFold  bool // Set by OneOfFold, so values match ignoring case
+	minUnit    LengthUnit
+	maxUnit    LengthUnit
+	exactUnit  LengthUnit
+	pattern    *regexp.Regexp
+	password   *PasswordPolicy
+	username   *UsernamePolicy
 
 	// Format validators
-	isEmail    bool
-	isURL      bool
-	isUUID     bool
-	isIP       bool
-	isIPv4     bool
-	isIPv6     bool
-	isBase64   bool
-	isHex      bool
-	isCUID     bool
-	isCUID2    bool
-	isULID     bool
-	isNanoid   bool
-	startsWith *string
-	endsWith   *string
-	contains   *string
+	isEmail     bool
+	email       EmailOptions
+	isURL       bool
+	isUUID      bool
+	isIP        bool
+	isIPv4      bool
+	isIPv6      bool
+	cidr        string // "any", "v4" or "v6" when CIDR notation is required
+	inCIDR      []netip.Prefix
+	isBase64    bool
+	isBase64URL bool
+	isBase32    bool
+	isDataURI   bool
+	maxDataSize *int // Set by MaxDataSize, the decoded DataURI limit
+	isHex       bool
+	isCUID      bool
+	isCUID2     bool
+	isULID      bool
+	isNanoid    bool
+	isObjectID  bool
+	isGitSHA    bool
+	isFull      bool
+	isCron      bool
+	cronStrict  bool
+	isIBAN      bool
+	isBIC       bool
+	isISBN      bool
+	isEAN       bool
+	country     string // "alpha2" or "alpha3" when country codes are required
+	isCurrency  bool
+	langTag     string // "wellformed" or "canonical" when language tags are required
+	isCSSColor  bool
+	isDomain    bool
+	allowIDN    bool
+	startsWith  *string
+	endsWith    *string
+	contains    *string
+
+	// Forbidden content
+	notPattern    *regexp.Regexp
+	notStartsWith *string
+	notContains   *string
+	noHTML        bool
+
+	// Character rules
+	asciiOnly     bool
+	printableOnly bool
+	charClass     string // "alpha" or "alphanumeric" when set
+	extraChars    string // Also allowed by Alpha and Alphanumeric
+	emojiOnly     bool
+	noEmoji       bool
+	letterCase    string // "lower" or "upper" when the case is checked
 
 	// Transformations
+	shouldStripHTML bool
 	shouldTrim      bool
 	shouldLowercase bool
 	shouldUppercase bool
+	normForm        string                // "NFC" or "NFKC" when the string is normalized
+	edits           []func(string) string // Truncate, ReplaceAll and padding, in chain order
 
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
-	defaultVal *string
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  *string
+	defaultFunc func() string // Set by DefaultFunc, called on each parse
 
 	// Custom validators
-	refinements []Refinement
+	refinements      []Refinement
+	ctxRefinements   []CheckFunc
+	superRefinements []SuperRefineFunc
+
+	examples schemaExamples // Declared with Examples
 }
 
 type Refinement struct {
@@ -50,32 +110,113 @@ type Refinement struct {
 	Message string
 }
 
+// LengthUnit selects how Min, Max and Length measure a string
+type LengthUnit int
+
+const (
+	// CountBytes measures the UTF-8 encoded length, the default
+	CountBytes LengthUnit = iota
+	// CountRunes counts Unicode code points, so "héllo" has length 5
+	CountRunes
+	// CountGraphemes counts the characters a reader sees, so an emoji
+	// sequence such as a family or a flag has length 1
+	CountGraphemes
+)
+
+// measure returns the length of s in the unit
+func (u LengthUnit) measure(s string) int {
+	switch u {
+	case CountRunes:
+		return utf8.RuneCountInString(s)
+	case CountGraphemes:
+		return graphemeCount(s)
+	}
+	return len(s)
+}
+
+// lengthUnit returns the unit given to a length rule, CountBytes if none
+func lengthUnit(unit []LengthUnit) LengthUnit {
+	if len(unit) > 0 {
+		return unit[len(unit)-1]
+	}
+	return CountBytes
+}
+
 // String creates a new string validator
 func String() *StringValidator {
 	return &StringValidator{}
 }
 
-// Min sets the minimum string length
-func (v *StringValidator) Min(length int) *StringValidator {
-	v.minLen = &length
+// Min sets the minimum string length, in bytes unless a unit such as
+// CountRunes is given
+func (v *StringValidator) Min(length int, unit ...LengthUnit) *StringValidator {
+	v.minLen, v.minUnit = &length, lengthUnit(unit)
 	return v
 }
 
-// Max sets the maximum string length
-func (v *StringValidator) Max(length int) *StringValidator {
-	v.maxLen = &length
+// Max sets the maximum string length, in bytes unless a unit such as
+// CountRunes is given
+func (v *StringValidator) Max(length int, unit ...LengthUnit) *StringValidator {
+	v.maxLen, v.maxUnit = &length, lengthUnit(unit)
+	return v
+}
+
+// Length sets the exact string length required, in bytes unless a unit
+// such as CountRunes is given
+func (v *StringValidator) Length(length int, unit ...LengthUnit) *StringValidator {
+	v.exactLen, v.exactUnit = &length, lengthUnit(unit)
+	return v
+}
+
+// NonEmpty rejects the empty string, and with Trim whitespace-only strings
+func (v *StringValidator) NonEmpty() *StringValidator {
+	v.isNonEmpty = true
+	return v
+}
+
+// Password checks the string against a password policy, reporting an error
+// for each rule it breaks rather than only the first
+func (v *StringValidator) Password(policy PasswordPolicy) *StringValidator {
+	v.password = &policy
+	return v
+}
+
+// Username checks the string is a username allowed by the policy, or by
+// DefaultUsernamePolicy when none is given
+func (v *StringValidator) Username(policy ...UsernamePolicy) *StringValidator {
+	p := DefaultUsernamePolicy()
+	if len(policy) > 0 {
+		p = policy[len(policy)-1]
+	}
+	v.username = &p
 	return v
 }
 
-// Length sets the exact string length required
-func (v *StringValidator) Length(length int) *StringValidator {
-	v.exactLen = &length
+// OneOf requires the string to be one of the given values, a typed
+// shortcut for Enum
+func (v *StringValidator) OneOf(values ...string) *StringValidator {
+	v.oneOf, v.oneOfFold = values, false
 	return v
 }
 
-// Email validates email format
-func (v *StringValidator) Email() *StringValidator {
+// OneOfFold is OneOf ignoring case. The parsed value is the matching
+// allowed value, so "RED" parses as "red" with OneOfFold("red").
+func (v *StringValidator) OneOfFold(values ...string) *StringValidator {
+	v.oneOf, v.oneOfFold = values, true
+	return v
+}
+
+// Email validates email format. By default it accepts common addresses;
+// pass EmailOptions to choose another syntax or to reject plus addressing
+// and long local parts. When several options are given the last one wins.
+func (v *StringValidator) Email(options ...EmailOptions) *StringValidator {
 	v.isEmail = true
+	if len(options) > 0 {
+		v.email = options[len(options)-1]
+		if v.email.AllowIDN {
+			v.allowIDN = true
+		}
+	}
 	return v
 }
 
@@ -109,12 +250,91 @@ func (v *StringValidator) IPv6() *StringValidator {
 	return v
 }
 
+// CIDR validates an IPv4 or IPv6 network in prefix notation, such as
+// "10.0.0.0/8". Host bits may be set, as in "192.168.1.10/24".
+func (v *StringValidator) CIDR() *StringValidator {
+	v.cidr = "any"
+	return v
+}
+
+// CIDRv4 validates an IPv4 network in prefix notation
+func (v *StringValidator) CIDRv4() *StringValidator {
+	v.cidr = "v4"
+	return v
+}
+
+// CIDRv6 validates an IPv6 network in prefix notation
+func (v *StringValidator) CIDRv6() *StringValidator {
+	v.cidr = "v6"
+	return v
+}
+
+// InCIDR requires an IP address within one of the given networks, such as
+// "10.0.0.0/8", for allowlists. It panics if a network is invalid; use
+// TryInCIDR or InPrefixes for networks that are not constants.
+func (v *StringValidator) InCIDR(cidrs ...string) *StringValidator {
+	prefixes, err := parsePrefixes(cidrs)
+	if err != nil {
+		panic(err)
+	}
+	return v.InPrefixes(prefixes...)
+}
+
+// InPrefixes requires an IP address within one of the given parsed
+// networks, like InCIDR
+func (v *StringValidator) InPrefixes(prefixes ...netip.Prefix) *StringValidator {
+	v.inCIDR = make([]netip.Prefix, len(prefixes))
+	for i, prefix := range prefixes {
+		v.inCIDR[i] = prefix.Masked()
+	}
+	return v
+}
+
+// TryInCIDR is InCIDR returning an error, instead of panicking, when a
+// network is invalid, such as an allowlist read from configuration
+func (v *StringValidator) TryInCIDR(cidrs ...string) (*StringValidator, error) {
+	prefixes, err := parsePrefixes(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	return v.InPrefixes(prefixes...), nil
+}
+
 // Base64 validates base64 encoded string
 func (v *StringValidator) Base64() *StringValidator {
 	v.isBase64 = true
 	return v
 }
 
+// Base64URL validates a string in the URL-safe base64 alphabet, with or
+// without padding, as used by JWTs
+func (v *StringValidator) Base64URL() *StringValidator {
+	v.isBase64URL = true
+	return v
+}
+
+// Base32 validates a base32 encoded string (RFC 4648), with or without
+// padding
+func (v *StringValidator) Base32() *StringValidator {
+	v.isBase32 = true
+	return v
+}
+
+// DataURI validates a data URI such as "data:image/png;base64,iVBORw0KGgo=",
+// checking its MIME type and that its content decodes. Use MaxDataSize to
+// limit the decoded size.
+func (v *StringValidator) DataURI() *StringValidator {
+	v.isDataURI = true
+	return v
+}
+
+// MaxDataSize limits the decoded content of a DataURI to the given number
+// of bytes
+func (v *StringValidator) MaxDataSize(bytes int) *StringValidator {
+	v.maxDataSize = &bytes
+	return v
+}
+
 // Hex validates hexadecimal string
 func (v *StringValidator) Hex() *StringValidator {
 	v.isHex = true
@@ -145,12 +365,153 @@ func (v *StringValidator) Nanoid() *StringValidator {
 	return v
 }
 
-// Regex validates against a regular expression pattern
+// ObjectID validates a MongoDB ObjectID (24 hexadecimal characters)
+func (v *StringValidator) ObjectID() *StringValidator {
+	v.isObjectID = true
+	return v
+}
+
+// GitSHA validates a Git commit hash: 7 to 40 hexadecimal characters, or 64
+// for SHA-256 repositories. Use Full to reject abbreviated hashes.
+func (v *StringValidator) GitSHA() *StringValidator {
+	v.isGitSHA = true
+	return v
+}
+
+// Full makes GitSHA require the complete 40 or 64 character hash
+func (v *StringValidator) Full() *StringValidator {
+	v.isFull = true
+	return v
+}
+
+// Cron validates a cron expression such as "*/15 9-17 * * MON-FRI", with
+// five fields or six including seconds. It also accepts month and day
+// names, macros such as "@daily", and the "?", "L", "W" and "#" day
+// extensions; use CronStrict to reject them.
+func (v *StringValidator) Cron() *StringValidator {
+	v.isCron = true
+	return v
+}
+
+// CronStrict validates a cron expression using only numbers, "*", ranges,
+// steps and lists
+func (v *StringValidator) CronStrict() *StringValidator {
+	v.isCron = true
+	v.cronStrict = true
+	return v
+}
+
+// IBAN validates an International Bank Account Number in upper case,
+// checking the country's length and the mod-97 check digits. Spaces between
+// groups are allowed.
+func (v *StringValidator) IBAN() *StringValidator {
+	v.isIBAN = true
+	return v
+}
+
+// BIC validates a Business Identifier Code (SWIFT code) of 8 or 11
+// characters in upper case
+func (v *StringValidator) BIC() *StringValidator {
+	v.isBIC = true
+	return v
+}
+
+// ISBN validates an ISBN-10 or ISBN-13 with its check digit. Hyphens and
+// spaces between groups are allowed.
+func (v *StringValidator) ISBN() *StringValidator {
+	v.isISBN = true
+	return v
+}
+
+// EAN validates an EAN-13 or EAN-8 barcode number with its check digit
+func (v *StringValidator) EAN() *StringValidator {
+	v.isEAN = true
+	return v
+}
+
+// CountryCode validates an ISO 3166-1 alpha-2 country code in upper case,
+// such as "DE"
+func (v *StringValidator) CountryCode() *StringValidator {
+	v.country = "alpha2"
+	return v
+}
+
+// CountryCodeAlpha3 validates an ISO 3166-1 alpha-3 country code in upper
+// case, such as "DEU"
+func (v *StringValidator) CountryCodeAlpha3() *StringValidator {
+	v.country = "alpha3"
+	return v
+}
+
+// CurrencyCode validates an active ISO 4217 currency code in upper case,
+// such as "EUR"
+func (v *StringValidator) CurrencyCode() *StringValidator {
+	v.isCurrency = true
+	return v
+}
+
+// LanguageTag validates a well-formed BCP 47 language tag such as "en-US"
+// or "zh-Hant-TW". Subtags missing from the IANA registry are accepted as
+// long as their syntax is valid.
+func (v *StringValidator) LanguageTag() *StringValidator {
+	v.langTag = "wellformed"
+	return v
+}
+
+// CanonicalLanguageTag validates a language tag like LanguageTag and
+// canonicalizes it: "EN_us" becomes "en-US", "iw" becomes "he" and
+// "en-Latn-US" becomes "en-US". Underscores are accepted as separators,
+// and tags with subtags missing from the IANA registry fail.
+func (v *StringValidator) CanonicalLanguageTag() *StringValidator {
+	v.langTag = "canonical"
+	return v
+}
+
+// CSSColor validates a CSS color: a hex color, a named color such as
+// "rebeccapurple", or an rgb(), rgba(), hsl() or hsla() function
+func (v *StringValidator) CSSColor() *StringValidator {
+	v.isCSSColor = true
+	return v
+}
+
+// Domain validates a domain name such as "example.com"
+func (v *StringValidator) Domain() *StringValidator {
+	v.isDomain = true
+	return v
+}
+
+// AllowIDN makes Email, URL and Domain accept internationalized domain names
+// (e.g. "user@bücher.de"). Unicode labels are converted to punycode before
+// validation and Unicode local parts are allowed in email addresses.
+func (v *StringValidator) AllowIDN() *StringValidator {
+	v.allowIDN = true
+	return v
+}
+
+// Regex validates against a regular expression pattern. It panics if the
+// pattern does not compile; use TryRegex or RegexCompiled for patterns that
+// are not constants.
 func (v *StringValidator) Regex(pattern string) *StringValidator {
 	v.pattern = regexp.MustCompile(pattern)
 	return v
 }
 
+// RegexCompiled validates against an already compiled regular expression
+func (v *StringValidator) RegexCompiled(re *regexp.Regexp) *StringValidator {
+	v.pattern = re
+	return v
+}
+
+// TryRegex is Regex returning an error, instead of panicking, when the
+// pattern does not compile, such as one read from configuration
+func (v *StringValidator) TryRegex(pattern string) (*StringValidator, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("zogo: invalid regex %q: %w", pattern, err)
+	}
+	return v.RegexCompiled(re), nil
+}
+
 // StartsWith checks if string starts with the given prefix
 func (v *StringValidator) StartsWith(prefix string) *StringValidator {
 	v.startsWith = &prefix
@@ -169,6 +530,119 @@ func (v *StringValidator) Contains(substring string) *StringValidator {
 	return v
 }
 
+// NotRegex rejects strings matching a regular expression pattern. Like
+// Regex, it panics if the pattern does not compile; use TryNotRegex or
+// NotRegexCompiled for patterns that are not constants.
+func (v *StringValidator) NotRegex(pattern string) *StringValidator {
+	v.notPattern = regexp.MustCompile(pattern)
+	return v
+}
+
+// NotRegexCompiled rejects strings matching an already compiled regular
+// expression
+func (v *StringValidator) NotRegexCompiled(re *regexp.Regexp) *StringValidator {
+	v.notPattern = re
+	return v
+}
+
+// TryNotRegex is NotRegex returning an error, instead of panicking, when
+// the pattern does not compile
+func (v *StringValidator) TryNotRegex(pattern string) (*StringValidator, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("zogo: invalid regex %q: %w", pattern, err)
+	}
+	return v.NotRegexCompiled(re), nil
+}
+
+// NotStartsWith rejects strings starting with the given prefix
+func (v *StringValidator) NotStartsWith(prefix string) *StringValidator {
+	v.notStartsWith = &prefix
+	return v
+}
+
+// NotContains rejects strings containing the given substring
+func (v *StringValidator) NotContains(substring string) *StringValidator {
+	v.notContains = &substring
+	return v
+}
+
+// NoHTML rejects strings containing markup such as tags or comments. A "<"
+// that does not start markup, as in "a < b", is allowed.
+func (v *StringValidator) NoHTML() *StringValidator {
+	v.noHTML = true
+	return v
+}
+
+// ASCII requires every character to be ASCII
+func (v *StringValidator) ASCII() *StringValidator {
+	v.asciiOnly = true
+	return v
+}
+
+// Printable rejects control characters and other non-printing characters
+// such as tabs, newlines and zero-width spaces. The plain space is allowed.
+func (v *StringValidator) Printable() *StringValidator {
+	v.printableOnly = true
+	return v
+}
+
+// Alpha requires every character to be a letter, in any script. Combine
+// with ASCII for A-Z only.
+func (v *StringValidator) Alpha() *StringValidator {
+	v.charClass = "alpha"
+	return v
+}
+
+// Alphanumeric requires every character to be a letter or a digit
+func (v *StringValidator) Alphanumeric() *StringValidator {
+	v.charClass = "alphanumeric"
+	return v
+}
+
+// AllowChars lets Alpha and Alphanumeric also accept the given characters,
+// such as AllowChars(" _") for spaces and underscores
+func (v *StringValidator) AllowChars(chars string) *StringValidator {
+	v.extraChars = chars
+	return v
+}
+
+// Emoji requires the string to be made only of emoji, including sequences
+// such as flags, skin tones and joined emoji like "👩‍💻"
+func (v *StringValidator) Emoji() *StringValidator {
+	v.emojiOnly = true
+	return v
+}
+
+// NoEmoji rejects strings containing emoji or other symbols outside the
+// Basic Multilingual Plane, for names and handles
+func (v *StringValidator) NoEmoji() *StringValidator {
+	v.noEmoji = true
+	return v
+}
+
+// Lowercase requires the string to have no upper case letters. Unlike
+// ToLowerCase, it rejects the value instead of rewriting it.
+func (v *StringValidator) Lowercase() *StringValidator {
+	v.letterCase = "lower"
+	return v
+}
+
+// Uppercase requires the string to have no lower case letters. Unlike
+// ToUpperCase, it rejects the value instead of rewriting it.
+func (v *StringValidator) Uppercase() *StringValidator {
+	v.letterCase = "upper"
+	return v
+}
+
+// StripHTML removes markup from the string, and the content of script and
+// style elements, before the other transforms and rules. Character
+// references such as "&lt;" are kept, so escaped markup stays escaped.
+func (v *StringValidator) StripHTML() *StringValidator {
+	v.shouldStripHTML = true
+	return v
+}
+
 // Trim removes leading and trailing whitespace
 func (v *StringValidator) Trim() *StringValidator {
 	v.shouldTrim = true
@@ -187,6 +661,58 @@ func (v *StringValidator) ToUpperCase() *StringValidator {
 	return v
 }
 
+// NFC normalizes the string to Unicode Normalization Form C before the
+// other rules run, so "é" typed as "e" and a combining accent equals the
+// precomposed "é"
+func (v *StringValidator) NFC() *StringValidator {
+	v.normForm = "NFC"
+	return v
+}
+
+// NFKC normalizes the string to Unicode Normalization Form KC, which also
+// folds compatibility characters such as "ﬁ" to "fi" and full-width "Ａ"
+// to "A", for identifiers such as usernames
+func (v *StringValidator) NFKC() *StringValidator {
+	v.normForm = "NFKC"
+	return v
+}
+
+// Truncate cuts the string to at most n characters. Truncate, ReplaceAll,
+// PadStart and PadEnd run in the order they are chained, after Trim, the
+// case transforms and normalization.
+func (v *StringValidator) Truncate(n int) *StringValidator {
+	v.edits = append(v.edits, func(s string) string {
+		return truncateRunes(s, n)
+	})
+	return v
+}
+
+// ReplaceAll replaces every occurrence of old with new, such as
+// ReplaceAll("_", "-") to normalize separators
+func (v *StringValidator) ReplaceAll(old, new string) *StringValidator {
+	v.edits = append(v.edits, func(s string) string {
+		return strings.ReplaceAll(s, old, new)
+	})
+	return v
+}
+
+// PadStart pads the string at the start with ch to n characters, such as
+// PadStart(6, '0') turning "42" into "000042"
+func (v *StringValidator) PadStart(n int, ch rune) *StringValidator {
+	v.edits = append(v.edits, func(s string) string {
+		return padding(s, n, ch) + s
+	})
+	return v
+}
+
+// PadEnd pads the string at the end with ch to n characters
+func (v *StringValidator) PadEnd(n int, ch rune) *StringValidator {
+	v.edits = append(v.edits, func(s string) string {
+		return s + padding(s, n, ch)
+	})
+	return v
+}
+
 // Required marks the field as required (this is the default behavior)
 func (v *StringValidator) Required() *StringValidator {
 	v.isRequired = true
@@ -207,9 +733,26 @@ func (v *StringValidator) Nullable() *StringValidator {
 	return v
 }
 
-// Default sets a default value if input is nil or empty string
+// Nullish allows nil values, like Optional and Nullable together
+func (v *StringValidator) Nullish() *StringValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Default sets a default value if input is nil. The default goes through
+// the transforms and rules like any input.
 func (v *StringValidator) Default(val string) *StringValidator {
-	v.defaultVal = &val
+	v.defaultVal, v.defaultFunc = &val, nil
+	return v
+}
+
+// DefaultFunc sets a function called for the default each time the input
+// is nil, such as one generating a new ID or token. Like Default, the
+// result goes through the transforms and rules.
+func (v *StringValidator) DefaultFunc(fn func() string) *StringValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
 	return v
 }
 
@@ -222,16 +765,67 @@ func (v *StringValidator) Refine(check func(string) bool, message string) *Strin
 	return v
 }
 
+// RefineCtx adds a context-aware check, such as a database lookup. It runs
+// after all other rules pass and receives the context given to ParseCtx.
+func (v *StringValidator) RefineCtx(check CheckFunc) *StringValidator {
+	v.ctxRefinements = append(v.ctxRefinements, check)
+	return v
+}
+
+// SuperRefine adds a refinement that can report several issues, each with
+// its own path, code and message. It runs after all other rules pass.
+func (v *StringValidator) SuperRefine(refine SuperRefineFunc) *StringValidator {
+	v.superRefinements = append(v.superRefinements, refine)
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *StringValidator) Examples(valid, invalid []any) *StringValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value
 func (v *StringValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse,
+// coercing it first when the parse asks for coercion
+func (v *StringValidator) parseWithState(value any, st *parseState) ParseResult {
+	if st.opts.Coerce {
+		value = coerceString(value)
+	}
+	// Validate the default in place of a missing string, so it goes through
+	// the transforms and rules like input
+	if value == nil && v.defaultVal != nil {
+		value = *v.defaultVal
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+	if str, ok := value.(string); ok {
+		if failed, ok := st.use(&st.meta.Usage.StringBytes, len(str)); !ok {
+			return failed
+		}
+		if failed, ok := st.use(&st.meta.Usage.RegexEvals, v.regexRules()); !ok {
+			return failed
+		}
+	}
+
+	result := v.parseValue(value)
+	if result.Ok && value != nil {
+		return runChecks(st.ctx, v.ctxRefinements, superRefine(st.ctx, v.superRefinements, result))
+	}
+	return result
+}
+
+// parseValue validates the input value against the synchronous rules
+func (v *StringValidator) parseValue(value any) ParseResult {
 	// Check if value is nil
 	// Handle nil values based on modifiers
 	if value == nil {
-		// If default is set, use it
-		if v.defaultVal != nil {
-			return Success(*v.defaultVal)
-		}
-
 		// If optional, nil is OK
 		if v.isOptional {
 			return Success(nil)
@@ -243,16 +837,20 @@ func (v *StringValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected string, received null")
+		return FailureTypeMismatch("string", nil)
 	}
 
 	// Check if value is a string
 	str, ok := value.(string)
 	if !ok {
-		return FailureMessage("Expected string, received " + typeof(value))
+		return FailureTypeMismatch("string", value)
 	}
 
 	// Apply transformations first
+	if v.shouldStripHTML {
+		str = stripHTML(str)
+	}
+
 	if v.shouldTrim {
 		str = strings.TrimSpace(str)
 	}
@@ -265,105 +863,333 @@ func (v *StringValidator) Parse(value any) ParseResult {
 		str = strings.ToUpper(str)
 	}
 
+	switch v.normForm {
+	case "NFC":
+		str = norm.NFC.String(str)
+	case "NFKC":
+		str = norm.NFKC.String(str)
+	}
+
+	for _, edit := range v.edits {
+		str = edit(str)
+	}
+
+	// Check non-empty, after trimming
+	if v.isNonEmpty && str == "" {
+		return FailureWithCode("String must not be empty", CodeEmpty)
+	}
+
 	// Check exact length if specified
-	if v.exactLen != nil && len(str) != *v.exactLen {
-		return FailureMessage(fmt.Sprintf("String must be exactly %d characters", *v.exactLen))
+	if v.exactLen != nil {
+		if n := v.exactUnit.measure(str); n != *v.exactLen {
+			return FailureWithParams(
+				fmt.Sprintf("String must be exactly %d characters", *v.exactLen),
+				CodeInvalidLength,
+				map[string]any{"type": "string", "length": *v.exactLen, "received": n},
+			)
+		}
 	}
 
 	// Check minimum length
-	if v.minLen != nil && len(str) < *v.minLen {
-		return FailureMessage(fmt.Sprintf("String must be at least %d characters", *v.minLen))
+	if v.minLen != nil && v.minUnit.measure(str) < *v.minLen {
+		return FailureWithParams(
+			fmt.Sprintf("String must be at least %d characters", *v.minLen),
+			CodeTooSmall,
+			map[string]any{"type": "string", "minimum": *v.minLen},
+		)
 	}
 
 	// Check maximum length
-	if v.maxLen != nil && len(str) > *v.maxLen {
-		return FailureMessage(fmt.Sprintf("String must be at most %d characters", *v.maxLen))
+	if v.maxLen != nil && v.maxUnit.measure(str) > *v.maxLen {
+		return FailureWithParams(
+			fmt.Sprintf("String must be at most %d characters", *v.maxLen),
+			CodeTooBig,
+			map[string]any{"type": "string", "maximum": *v.maxLen},
+		)
+	}
+
+	// Check allowed values
+	if v.oneOf != nil {
+		allowed, ok := matchOneOf(str, v.oneOf, v.oneOfFold)
+		if !ok {
+			return FailureWithParams(
+				fmt.Sprintf("Invalid enum value. Expected one of: %v, received: %v", v.oneOf, str),
+				CodeInvalidEnumValue,
+				map[string]any{"options": fmt.Sprint(v.oneOf), "received": str},
+			)
+		}
+		str = allowed
 	}
 
 	// Check email format
-	if v.isEmail && !isValidEmail(str) {
-		return FailureMessage("Invalid email format")
+	if v.isEmail {
+		if result, ok := v.checkEmail(str); !ok {
+			return result
+		}
 	}
 
 	// Check URL format
-	if v.isURL && !isValidURL(str) {
-		return FailureMessage("Invalid URL format")
+	if v.isURL && !v.checkURL(str) {
+		return FailureWithCode("Invalid URL format", CodeInvalidURL)
+	}
+
+	// Check domain format
+	if v.isDomain && !v.checkDomain(str) {
+		return FailureWithCode("Invalid domain name", CodeInvalidDomain)
 	}
 
 	// Check UUID format
 	if v.isUUID && !isValidUUID(str) {
-		return FailureMessage("Invalid UUID format")
+		return FailureWithCode("Invalid UUID format", CodeInvalidUUID)
 	}
 
 	// Check IP address
 	if v.isIP && !isValidIP(str) {
-		return FailureMessage("Invalid IP address")
+		return FailureWithCode("Invalid IP address", CodeInvalidIP)
 	}
 
 	// Check IPv4
 	if v.isIPv4 && !isValidIPv4(str) {
-		return FailureMessage("Invalid IPv4 address")
+		return FailureWithCode("Invalid IPv4 address", CodeInvalidIPv4)
 	}
 
 	// Check IPv6
 	if v.isIPv6 && !isValidIPv6(str) {
-		return FailureMessage("Invalid IPv6 address")
+		return FailureWithCode("Invalid IPv6 address", CodeInvalidIPv6)
+	}
+
+	// Check CIDR notation
+	switch {
+	case v.cidr == "any" && !isValidCIDR(str, ""):
+		return FailureWithCode("Invalid CIDR block", CodeInvalidCIDR)
+	case v.cidr == "v4" && !isValidCIDR(str, "v4"):
+		return FailureWithCode("Invalid IPv4 CIDR block", CodeInvalidCIDRv4)
+	case v.cidr == "v6" && !isValidCIDR(str, "v6"):
+		return FailureWithCode("Invalid IPv6 CIDR block", CodeInvalidCIDRv6)
+	}
+
+	// Check allowed networks
+	if v.inCIDR != nil && !inPrefixes(str, v.inCIDR) {
+		networks := prefixList(v.inCIDR)
+		return FailureWithParams(
+			fmt.Sprintf("IP address must be within %s", networks),
+			CodeNotInCIDR,
+			map[string]any{"networks": networks},
+		)
 	}
 
 	// Check base64
 	if v.isBase64 && !isValidBase64(str) {
-		return FailureMessage("Invalid base64 string")
+		return FailureWithCode("Invalid base64 string", CodeInvalidBase64)
+	}
+
+	// Check URL-safe base64
+	if v.isBase64URL && !isValidBase64URL(str) {
+		return FailureWithCode("Invalid base64url string", CodeInvalidBase64URL)
+	}
+
+	// Check base32
+	if v.isBase32 && !isValidBase32(str) {
+		return FailureWithCode("Invalid base32 string", CodeInvalidBase32)
+	}
+
+	// Check data URI and its decoded size
+	if v.isDataURI {
+		size, ok := parseDataURI(str)
+		if !ok {
+			return FailureWithCode("Invalid data URI", CodeInvalidDataURI)
+		}
+		if v.maxDataSize != nil && size > *v.maxDataSize {
+			return FailureWithParams(
+				fmt.Sprintf("Data URI content must be at most %d bytes", *v.maxDataSize),
+				CodeTooBig,
+				map[string]any{"type": "bytes", "maximum": *v.maxDataSize},
+			)
+		}
 	}
 
 	// Check hex
 	if v.isHex && !isValidHex(str) {
-		return FailureMessage("Invalid hexadecimal string")
+		return FailureWithCode("Invalid hexadecimal string", CodeInvalidHex)
 	}
 
 	// Check CUID
 	if v.isCUID && !isValidCUID(str) {
-		return FailureMessage("Invalid CUID format")
+		return FailureWithCode("Invalid CUID format", CodeInvalidCUID)
 	}
 
 	// Check CUID2
 	if v.isCUID2 && !isValidCUID2(str) {
-		return FailureMessage("Invalid CUID2 format")
+		return FailureWithCode("Invalid CUID2 format", CodeInvalidCUID2)
 	}
 
 	// Check ULID
 	if v.isULID && !isValidULID(str) {
-		return FailureMessage("Invalid ULID format")
+		return FailureWithCode("Invalid ULID format", CodeInvalidULID)
 	}
 
 	// Check Nanoid
 	if v.isNanoid && !isValidNanoid(str) {
-		return FailureMessage("Invalid Nanoid format")
+		return FailureWithCode("Invalid Nanoid format", CodeInvalidNanoid)
+	}
+
+	// Check ObjectID
+	if v.isObjectID && !isValidObjectID(str) {
+		return FailureWithCode("Invalid ObjectID format", CodeInvalidObjectID)
+	}
+
+	// Check Git SHA
+	if v.isGitSHA && !isValidGitSHA(str, v.isFull) {
+		return FailureWithCode("Invalid Git SHA", CodeInvalidGitSHA)
+	}
+
+	// Check cron expression
+	if v.isCron && !isValidCron(str, v.cronStrict) {
+		return FailureWithCode("Invalid cron expression", CodeInvalidCron)
+	}
+
+	// Check IBAN
+	if v.isIBAN && !isValidIBAN(str) {
+		return FailureWithCode("Invalid IBAN", CodeInvalidIBAN)
+	}
+
+	// Check BIC
+	if v.isBIC && !isValidBIC(str) {
+		return FailureWithCode("Invalid BIC", CodeInvalidBIC)
+	}
+
+	// Check ISBN
+	if v.isISBN && !isValidISBN(str) {
+		return FailureWithCode("Invalid ISBN", CodeInvalidISBN)
+	}
+
+	// Check EAN
+	if v.isEAN && !isValidEAN(str) {
+		return FailureWithCode("Invalid EAN barcode", CodeInvalidEAN)
+	}
+
+	// Check country code
+	if v.country != "" && !isValidCountryCode(str, v.country) {
+		return FailureWithCode("Invalid country code", CodeInvalidCountry)
+	}
+
+	// Check currency code
+	if v.isCurrency && !currencyCodes[str] {
+		return FailureWithCode("Invalid currency code", CodeInvalidCurrency)
+	}
+
+	// Check language tag
+	if v.langTag != "" {
+		tag, ok := parseLanguageTag(str, v.langTag == "canonical")
+		if !ok {
+			return FailureWithCode("Invalid language tag", CodeInvalidLanguageTag)
+		}
+		str = tag
+	}
+
+	// Check CSS color
+	if v.isCSSColor && !isValidCSSColor(str) {
+		return FailureWithCode("Invalid CSS color", CodeInvalidCSSColor)
 	}
 
 	// Check regex pattern
 	if v.pattern != nil && !v.pattern.MatchString(str) {
-		return FailureMessage("String does not match required pattern")
+		return FailureWithCode("String does not match required pattern", CodeInvalidRegex)
 	}
 
 	// Check startsWith
 	if v.startsWith != nil && !strings.HasPrefix(str, *v.startsWith) {
-		return FailureMessage(fmt.Sprintf("String must start with '%s'", *v.startsWith))
+		return FailureWithParams(
+			fmt.Sprintf("String must start with '%s'", *v.startsWith),
+			CodeInvalidStartsWith,
+			map[string]any{"prefix": *v.startsWith},
+		)
 	}
 
 	// Check endsWith
 	if v.endsWith != nil && !strings.HasSuffix(str, *v.endsWith) {
-		return FailureMessage(fmt.Sprintf("String must end with '%s'", *v.endsWith))
+		return FailureWithParams(
+			fmt.Sprintf("String must end with '%s'", *v.endsWith),
+			CodeInvalidEndsWith,
+			map[string]any{"suffix": *v.endsWith},
+		)
 	}
 
 	// Check contains
 	if v.contains != nil && !strings.Contains(str, *v.contains) {
-		return FailureMessage(fmt.Sprintf("String must contain '%s'", *v.contains))
+		return FailureWithParams(
+			fmt.Sprintf("String must contain '%s'", *v.contains),
+			CodeInvalidIncludes,
+			map[string]any{"substring": *v.contains},
+		)
+	}
+
+	// Check forbidden pattern, prefix and substring
+	if v.notPattern != nil && v.notPattern.MatchString(str) {
+		return FailureWithCode("String matches a forbidden pattern", CodeForbiddenRegex)
+	}
+	if v.notStartsWith != nil && strings.HasPrefix(str, *v.notStartsWith) {
+		return FailureWithParams(
+			fmt.Sprintf("String must not start with '%s'", *v.notStartsWith),
+			CodeForbiddenPrefix,
+			map[string]any{"prefix": *v.notStartsWith},
+		)
+	}
+	if v.notContains != nil && strings.Contains(str, *v.notContains) {
+		return FailureWithParams(
+			fmt.Sprintf("String must not contain '%s'", *v.notContains),
+			CodeForbiddenIncludes,
+			map[string]any{"substring": *v.notContains},
+		)
+	}
+	if v.noHTML && containsHTML(str) {
+		return FailureWithCode("String must not contain HTML", CodeContainsHTML)
+	}
+
+	// Check characters
+	if v.asciiOnly && !isASCII(str) {
+		return FailureWithCode("String must contain only ASCII characters", CodeInvalidASCII)
+	}
+	if v.printableOnly && !isPrintable(str) {
+		return FailureWithCode("String must contain only printable characters", CodeInvalidPrintable)
+	}
+	if v.charClass == "alpha" && !isCharClass(str, false, v.extraChars) {
+		return FailureWithCode("String must contain only letters", CodeInvalidAlpha)
+	}
+	if v.charClass == "alphanumeric" && !isCharClass(str, true, v.extraChars) {
+		return FailureWithCode("String must contain only letters and digits", CodeInvalidAlphanumeric)
+	}
+	if v.letterCase == "lower" && str != strings.ToLower(str) {
+		return FailureWithCode("String must be lowercase", CodeInvalidLowercase)
+	}
+	if v.letterCase == "upper" && str != strings.ToUpper(str) {
+		return FailureWithCode("String must be uppercase", CodeInvalidUppercase)
+	}
+	if v.emojiOnly && !isOnlyEmoji(str) {
+		return FailureWithCode("String must contain only emoji", CodeInvalidEmoji)
+	}
+	if v.noEmoji && containsEmoji(str) {
+		return FailureWithCode("String must not contain emoji", CodeContainsEmoji)
+	}
+
+	// Check username policy
+	if v.username != nil {
+		if result, ok := checkUsername(str, *v.username); !ok {
+			return result
+		}
+	}
+
+	// Check password policy, reporting every broken rule
+	if v.password != nil {
+		if issues := passwordIssues(str, *v.password); len(issues) > 0 {
+			return Failure(issues...)
+		}
 	}
 
 	// Run custom refinements
 	for _, refinement := range v.refinements {
 		if !refinement.Check(str) {
-			return FailureMessage(refinement.Message)
+			return FailureWithCode(refinement.Message, CodeCustom)
 		}
 	}
 
@@ -384,30 +1210,131 @@ func typeof(value any) string {
 		return "number"
 	case bool:
 		return "boolean"
+	case []byte:
+		return "bytes"
 	default:
 		return "unknown"
 	}
 }
 
+// regexRules returns the number of rules checked with regular expressions
+func (v *StringValidator) regexRules() int {
+	rules := 0
+	for _, regex := range []bool{v.isEmail && v.email.Mode == EmailBasic, v.isURL, v.isUUID, v.pattern != nil, v.notPattern != nil} {
+		if regex {
+			rules++
+		}
+	}
+	return rules
+}
+
+// checkEmail validates an email address against the syntax of the email
+// mode, honoring AllowIDN, then against the other email options
+func (v *StringValidator) checkEmail(str string) (ParseResult, bool) {
+	var valid bool
+	switch {
+	case v.email.Mode == EmailRFC5322:
+		valid = isValidRFC5322Email(str, v.allowIDN)
+	case v.email.Mode == EmailStrict:
+		valid = isValidStrictEmail(str, v.allowIDN)
+	case v.allowIDN && !isASCII(str):
+		valid = isValidIDNEmail(str)
+	default:
+		valid = isValidEmail(str)
+	}
+	if !valid {
+		return FailureWithCode("Invalid email format", CodeInvalidEmail), false
+	}
+
+	local, _, _ := splitEmail(str)
+	if v.email.NoPlus && strings.Contains(local, "+") {
+		return FailureWithParams("Email must not use plus addressing", CodeInvalidEmail,
+			map[string]any{"type": "plus"}), false
+	}
+	if limit := v.email.MaxLocalLength; limit > 0 && utf8.RuneCountInString(local) > limit {
+		return FailureWithParams(
+			fmt.Sprintf("Email local part must be at most %d characters", limit),
+			CodeInvalidEmail,
+			map[string]any{"type": "local", "maximum": limit},
+		), false
+	}
+	return ParseResult{}, true
+}
+
+// checkURL validates a URL, honoring AllowIDN
+func (v *StringValidator) checkURL(str string) bool {
+	if v.allowIDN && !isASCII(str) {
+		return isValidIDNURL(str)
+	}
+	return isValidURL(str)
+}
+
+// checkDomain validates a domain name, honoring AllowIDN
+func (v *StringValidator) checkDomain(str string) bool {
+	if v.allowIDN {
+		return isValidIDNDomain(str)
+	}
+	return isValidDomain(str)
+}
+
+// truncateRunes cuts s to at most n runes
+func truncateRunes(s string, n int) string {
+	for i := range s {
+		if n <= 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// padding returns the copies of ch that bring s to n runes
+func padding(s string, n int, ch rune) string {
+	missing := n - utf8.RuneCountInString(s)
+	if missing <= 0 {
+		return ""
+	}
+	return strings.Repeat(string(ch), missing)
+}
+
+// matchOneOf returns the allowed value that s matches, ignoring case when
+// fold is set
+func matchOneOf(s string, values []string, fold bool) (string, bool) {
+	for _, value := range values {
+		if s == value {
+			return value, true
+		}
+	}
+	if fold {
+		for _, value := range values {
+			if strings.EqualFold(s, value) {
+				return value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Format patterns, compiled once rather than on every check
+var (
+	emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	urlRegex   = regexp.MustCompile(`^https?://[a-zA-Z0-9\-._~:/?#[\]@!$&'()*+,;=%]+$`)
+	uuidRegex  = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+)
+
 // isValidEmail checks if string is a valid email
 func isValidEmail(email string) bool {
-	pattern := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
-	re := regexp.MustCompile(pattern)
-	return re.MatchString(email)
+	return emailRegex.MatchString(email)
 }
 
 // isValidURL checks if string is a valid URL
 func isValidURL(str string) bool {
-	pattern := `^https?://[a-zA-Z0-9\-._~:/?#[\]@!$&'()*+,;=%]+$`
-	re := regexp.MustCompile(pattern)
-	return re.MatchString(str)
+	return urlRegex.MatchString(str)
 }
 
 // isValidUUID checks if string is a valid UUID
 func isValidUUID(str string) bool {
-	pattern := `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`
-	re := regexp.MustCompile(pattern)
-	return re.MatchString(strings.ToLower(str))
+	return uuidRegex.MatchString(strings.ToLower(str))
 }
 
 // isValidIP checks if string is a valid IP address (v4 or v6)
@@ -538,6 +1465,35 @@ func isValidBase64(s string) bool {
 	return true
 }
 
+// isValidBase64URL checks a non-empty string in the URL-safe base64
+// alphabet, padded or not
+func isValidBase64URL(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return true
+	}
+	_, err := base64.URLEncoding.DecodeString(s)
+	return err == nil
+}
+
+// isValidBase32 checks a non-empty string in the standard base32 alphabet,
+// padded or not
+func isValidBase32(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	// Unpadded, the last block holds 2, 4, 5 or 7 characters
+	if n := len(s) % 8; n != 1 && n != 3 && n != 6 {
+		if _, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s); err == nil {
+			return true
+		}
+	}
+	_, err := base32.StdEncoding.DecodeString(s)
+	return err == nil
+}
+
 // isValidHex checks if string is valid hexadecimal
 func isValidHex(s string) bool {
 	if len(s) == 0 {
@@ -640,3 +1596,44 @@ func isValidNanoid(s string) bool {
 
 	return true
 }
+
+// isValidObjectID checks if string is a valid MongoDB ObjectID
+// Format: 24 hexadecimal characters (12 bytes)
+// Example: 507f1f77bcf86cd799439011
+func isValidObjectID(s string) bool {
+	return len(s) == 24 && isValidHex(s)
+}
+
+// isValidGitSHA checks if string is a Git commit hash
+// Format: a full SHA-1 (40) or SHA-256 (64) hash in hexadecimal, or unless
+// full is set an abbreviated hash of at least 7 characters
+// Example: 9fceb02d0ae598e95dc970b74767f19372d61af8
+func isValidGitSHA(s string, full bool) bool {
+	length := len(s)
+	if length != 40 && length != 64 && (full || length < 7 || length > 40) {
+		return false
+	}
+	return isValidHex(s)
+}
+
+// isPrintable checks that every character is printable as defined by
+// unicode.IsPrint, and that the string is valid UTF-8
+func isPrintable(s string) bool {
+	for _, r := range s {
+		if r == utf8.RuneError || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCharClass checks that every character is a letter, or a digit when
+// digits is set, or one of the extra characters
+func isCharClass(s string, digits bool, extra string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !(digits && unicode.IsDigit(r)) && !strings.ContainsRune(extra, r) {
+			return false
+		}
+	}
+	return true
+}
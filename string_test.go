@@ -1,6 +1,8 @@
 package zogo
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 )
@@ -100,6 +102,88 @@ func TestStringLength(t *testing.T) {
 	}
 }
 
+// Test counting length in runes or graphemes instead of bytes
+func TestStringLengthUnit(t *testing.T) {
+	if !String().Max(5, CountRunes).Parse("héllo").Ok {
+		t.Error("Expected héllo to pass Max(5, CountRunes)")
+	}
+	if String().Max(5).Parse("héllo").Ok {
+		t.Error("Expected héllo, 6 bytes, to fail Max(5)")
+	}
+	if !String().Min(5, CountRunes).Max(5).Parse("hello").Ok {
+		t.Error("Expected each rule to keep its own unit")
+	}
+	if String().Min(3, CountRunes).Parse("日本").Ok {
+		t.Error("Expected 2 runes to fail Min(3, CountRunes)")
+	}
+
+	result := String().Length(2, CountRunes).Parse("👍👍👍")
+	if result.Ok || result.Errors[0].Params["received"] != 3 {
+		t.Errorf("Expected Length to report 3 runes received, got %v", result.Errors)
+	}
+
+	family := "\U0001F468\u200d\U0001F469\u200d\U0001F467"
+	if !String().Max(1, CountGraphemes).Parse(family).Ok {
+		t.Error("Expected a family emoji to pass Max(1, CountGraphemes)")
+	}
+	if String().Max(1, CountRunes).Parse(family).Ok {
+		t.Error("Expected a family emoji, 5 runes, to fail Max(1, CountRunes)")
+	}
+}
+
+// Test NonEmpty rejects empty strings, and with Trim blank ones
+func TestStringNonEmpty(t *testing.T) {
+	if !String().NonEmpty().Parse(" ").Ok {
+		t.Error("Expected a space to pass NonEmpty() without Trim")
+	}
+	if !String().Trim().NonEmpty().Parse(" a ").Ok {
+		t.Error("Expected \" a \" to pass Trim().NonEmpty()")
+	}
+
+	for _, schema := range []*StringValidator{String().NonEmpty(), String().Trim().NonEmpty()} {
+		result := schema.Parse("")
+		if result.Ok || result.Errors[0].Code != CodeEmpty || result.Errors[0].Message != "String must not be empty" {
+			t.Errorf("Expected empty string to fail with code empty, got %v", result.Errors)
+		}
+	}
+	if String().Trim().NonEmpty().Parse(" \t\n").Ok {
+		t.Error("Expected whitespace to fail Trim().NonEmpty()")
+	}
+}
+
+// Test OneOf accepts only the listed values
+func TestStringOneOf(t *testing.T) {
+	schema := String().OneOf("red", "green", "blue")
+
+	if result := schema.Parse("green"); !result.Ok || result.Value != "green" {
+		t.Errorf("Expected green to pass OneOf, got %v", result.Errors)
+	}
+
+	result := schema.Parse("Red")
+	if result.Ok || result.Errors[0].Code != CodeInvalidEnumValue || result.Errors[0].Params["options"] != "[red green blue]" {
+		t.Errorf("Expected Red to fail OneOf with invalid_enum_value, got %v", result.Errors)
+	}
+
+	if !String().Trim().OneOf("a", "b").Parse(" a ").Ok {
+		t.Error("Expected OneOf to check the trimmed string")
+	}
+}
+
+// Test OneOfFold ignores case and returns the allowed value
+func TestStringOneOfFold(t *testing.T) {
+	schema := String().OneOfFold("red", "Green")
+
+	tests := map[string]string{"red": "red", "RED": "red", "green": "Green", "GREEN": "Green"}
+	for input, want := range tests {
+		if result := schema.Parse(input); !result.Ok || result.Value != want {
+			t.Errorf("Expected %q to parse as %q, got %v %v", input, want, result.Value, result.Errors)
+		}
+	}
+	if schema.Parse("blue").Ok {
+		t.Error("Expected blue to fail OneOfFold")
+	}
+}
+
 // Test chaining Min and Max
 func TestStringMinMax(t *testing.T) {
 	schema := String().Min(3).Max(10)
@@ -206,6 +290,31 @@ func TestStringUUID(t *testing.T) {
 	}
 }
 
+// Test the format checks reuse their compiled patterns instead of
+// compiling them on every call
+func TestStringFormatPatternsPrecompiled(t *testing.T) {
+	patterns := []struct {
+		name  string
+		regex **regexp.Regexp
+		check func(string) bool
+	}{
+		{"email", &emailRegex, isValidEmail},
+		{"URL", &urlRegex, isValidURL},
+		{"UUID", &uuidRegex, isValidUUID},
+	}
+	for _, p := range patterns {
+		// Swap in a pattern that accepts anything: a check compiling its
+		// own pattern would still reject the input
+		saved := *p.regex
+		*p.regex = regexp.MustCompile(`^`)
+		accepted := p.check("not a match")
+		*p.regex = saved
+		if !accepted {
+			t.Errorf("Expected the %s check to use its package-level pattern", p.name)
+		}
+	}
+}
+
 // Test Regex validation
 func TestStringRegex(t *testing.T) {
 	schema := String().Regex("^[a-z]+$")
@@ -228,6 +337,40 @@ func TestStringRegex(t *testing.T) {
 	}
 }
 
+// Test RegexCompiled, TryRegex and their NotRegex forms build patterns without panicking
+func TestStringTryRegex(t *testing.T) {
+	if String().RegexCompiled(regexp.MustCompile(`^\d+$`)).Parse("12a").Ok {
+		t.Error("Expected RegexCompiled to check the pattern")
+	}
+
+	schema, err := String().Min(2).TryRegex(`^\d+$`)
+	if err != nil {
+		t.Fatalf("Expected a valid pattern to compile, got %v", err)
+	}
+	if !schema.Parse("42").Ok || schema.Parse("4").Ok || schema.Parse("4a").Ok {
+		t.Error("Expected TryRegex to keep the other rules and add the pattern")
+	}
+
+	schema, err = String().TryRegex(`^[a-z`)
+	if err == nil || schema != nil {
+		t.Fatal("Expected an invalid pattern to return an error")
+	}
+	if !strings.Contains(err.Error(), `invalid regex "^[a-z"`) {
+		t.Errorf("Expected the error to name the pattern, got %v", err)
+	}
+
+	if String().NotRegexCompiled(regexp.MustCompile(`\s`)).Parse("a b").Ok {
+		t.Error("Expected NotRegexCompiled to reject matches")
+	}
+	schema, err = String().TryNotRegex(`^admin`)
+	if err != nil || schema.Parse("admin1").Ok || !schema.Parse("user").Ok {
+		t.Errorf("Expected TryNotRegex to reject matches, got %v", err)
+	}
+	if schema, err = String().TryNotRegex(`(`); err == nil || schema != nil {
+		t.Error("Expected TryNotRegex to return an error for an invalid pattern")
+	}
+}
+
 // Test StartsWith
 func TestStringStartsWith(t *testing.T) {
 	schema := String().StartsWith("https://")
@@ -279,6 +422,38 @@ func TestStringContains(t *testing.T) {
 	}
 }
 
+// Test NotRegex, NotStartsWith and NotContains reject forbidden content
+func TestStringForbiddenContent(t *testing.T) {
+	schema := String().NotRegex(`(?i)\bdamn\b`).NotStartsWith("test_").NotContains("<script")
+
+	if result := schema.Parse("production_user"); !result.Ok {
+		t.Errorf("Expected production_user to pass, got %v", result.Errors)
+	}
+	if !schema.Parse("user_test_").Ok {
+		t.Error("Expected NotStartsWith to allow the prefix elsewhere")
+	}
+
+	tests := []struct {
+		input string
+		code  ErrorCode
+	}{
+		{"oh DAMN it", CodeForbiddenRegex},
+		{"test_user", CodeForbiddenPrefix},
+		{"hi<script>", CodeForbiddenIncludes},
+	}
+	for _, tt := range tests {
+		result := schema.Parse(tt.input)
+		if result.Ok || result.Errors[0].Code != tt.code {
+			t.Errorf("Expected %q to fail with %s, got %v", tt.input, tt.code, result.Errors)
+		}
+	}
+
+	result := schema.Parse("test_user")
+	if result.Errors[0].Message != "String must not start with 'test_'" || result.Errors[0].Params["prefix"] != "test_" {
+		t.Errorf("Unexpected NotStartsWith error: %v", result.Errors[0])
+	}
+}
+
 // Test chaining format validators
 func TestStringChainedFormats(t *testing.T) {
 	schema := String().Email().Min(5).Max(50)
@@ -541,9 +716,41 @@ func TestStringDefaultWithTransform(t *testing.T) {
 	if !result.Ok {
 		t.Error("Expected nil to pass with Default()")
 	}
-	// Default value doesn't go through transformations (applied at nil check)
-	if result.Value != "HELLO" {
-		t.Errorf("Expected 'HELLO', got '%v'", result.Value)
+	if result.Value != "hello" {
+		t.Errorf("Expected 'hello', got '%v'", result.Value)
+	}
+
+	// The default is checked against the rules too
+	result = String().Default("hi").Min(3).Parse(nil)
+	if result.Ok || result.Errors[0].Code != CodeTooSmall {
+		t.Errorf("Expected a default shorter than Min(3) to fail, got %v", result)
+	}
+	refined := String().Default("guest").SuperRefine(func(value any, ctx *RefinementCtx) {
+		if value == "guest" {
+			ctx.AddIssue(ValidationError{Message: "Reserved"})
+		}
+	})
+	if refined.Parse(nil).Ok {
+		t.Error("Expected SuperRefine to check the default")
+	}
+}
+
+// Test DefaultFunc defaults go through the transforms and rules
+func TestStringDefaultFunc(t *testing.T) {
+	next := 0
+	schema := String().Trim().Min(3).DefaultFunc(func() string {
+		next++
+		return fmt.Sprintf(" id-%d ", next)
+	})
+
+	if result := schema.Parse(nil); !result.Ok || result.Value != "id-1" {
+		t.Errorf("Expected the trimmed first ID, got %v", result)
+	}
+	if result := schema.Parse(nil); result.Value != "id-2" {
+		t.Errorf("Expected a new ID per parse, got %v", result.Value)
+	}
+	if String().Min(3).DefaultFunc(func() string { return "x" }).Parse(nil).Ok {
+		t.Error("Expected a generated default breaking Min(3) to fail")
 	}
 }
 
@@ -680,6 +887,46 @@ func TestStringBase64(t *testing.T) {
 	}
 }
 
+// Test Base64URL accepts the URL-safe alphabet with or without padding
+func TestStringBase64URL(t *testing.T) {
+	schema := String().Base64URL()
+
+	valid := []string{
+		"SGVsbG8gV29ybGQ",      // Unpadded
+		"SGVsbG8gV29ybGQ=",     // Padded
+		"eyJhbGciOiJIUzI1NiJ9", // JWT header
+		"-_8",
+	}
+	for _, s := range valid {
+		if result := schema.Parse(s); !result.Ok {
+			t.Errorf("Expected %q to pass Base64URL(), got %v", s, result.Errors)
+		}
+	}
+
+	for _, s := range []string{"", "a+b/", "SGVsbG8=gV2", "S", "===="} {
+		if schema.Parse(s).Ok {
+			t.Errorf("Expected %q to fail Base64URL()", s)
+		}
+	}
+}
+
+// Test Base32 accepts the RFC 4648 alphabet with or without padding
+func TestStringBase32(t *testing.T) {
+	schema := String().Base32()
+
+	for _, s := range []string{"JBSWY3DPEE======", "JBSWY3DPEE", "MFRGG===", "JBSWY3DPEHPK3PXP"} {
+		if result := schema.Parse(s); !result.Ok {
+			t.Errorf("Expected %q to pass Base32(), got %v", s, result.Errors)
+		}
+	}
+
+	for _, s := range []string{"", "jbswy3dp", "JBSWY3D1", "JBSWY3DPEE====", "A"} {
+		if schema.Parse(s).Ok {
+			t.Errorf("Expected %q to fail Base32()", s)
+		}
+	}
+}
+
 // Test Hex validation
 func TestStringHex(t *testing.T) {
 	schema := String().Hex()
@@ -862,6 +1109,72 @@ func TestStringNanoid(t *testing.T) {
 	}
 }
 
+// Test ObjectID validation
+func TestStringObjectID(t *testing.T) {
+	schema := String().ObjectID()
+
+	// Valid ObjectIDs (24 hex chars, either case)
+	validIDs := []string{
+		"507f1f77bcf86cd799439011",
+		"507F1F77BCF86CD799439011",
+		strings.Repeat("0", 24),
+	}
+
+	for _, id := range validIDs {
+		result := schema.Parse(id)
+		if !result.Ok {
+			t.Errorf("Expected valid ObjectID '%s' to pass", id)
+		}
+	}
+
+	// Invalid ObjectIDs
+	invalidIDs := []string{
+		"507f1f77bcf86cd79943901",   // too short (23 chars)
+		"507f1f77bcf86cd7994390111", // too long (25 chars)
+		"507f1f77bcf86cd79943901g",  // not hex
+		"",
+	}
+
+	for _, id := range invalidIDs {
+		result := schema.Parse(id)
+		if result.Ok {
+			t.Errorf("Expected invalid ObjectID '%s' to fail", id)
+		}
+	}
+}
+
+// Test Git SHA validation
+func TestStringGitSHA(t *testing.T) {
+	sha1 := "9fceb02d0ae598e95dc970b74767f19372d61af8"
+	sha256 := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		sha   string
+		short bool // Valid for GitSHA
+		full  bool // Valid for GitSHA().Full()
+	}{
+		{sha1, true, true},
+		{strings.ToUpper(sha1), true, true},
+		{sha256, true, true},
+		{"9fceb02", true, false},
+		{sha1[:12], true, false},
+		{"9fceb0", false, false},               // too short
+		{sha1 + "0", false, false},             // between SHA-1 and SHA-256
+		{sha256 + "0", false, false},           // too long
+		{"9fceb02d0ae598e95dcg", false, false}, // not hex
+		{"", false, false},
+	}
+
+	for _, tt := range tests {
+		if got := String().GitSHA().Parse(tt.sha).Ok; got != tt.short {
+			t.Errorf("GitSHA().Parse(%q).Ok = %v, want %v", tt.sha, got, tt.short)
+		}
+		if got := String().GitSHA().Full().Parse(tt.sha).Ok; got != tt.full {
+			t.Errorf("GitSHA().Full().Parse(%q).Ok = %v, want %v", tt.sha, got, tt.full)
+		}
+	}
+}
+
 // Test multiple format validators chained
 func TestStringMultipleFormats(t *testing.T) {
 	// This should work - base64 that's also hex (subset)
@@ -897,3 +1210,152 @@ func TestStringFormatsInObject(t *testing.T) {
 		t.Errorf("Expected object with format validators to pass. Errors: %v", result.Errors)
 	}
 }
+
+// Test ASCII and printable character rules
+func TestStringCharacterRules(t *testing.T) {
+	ascii := String().ASCII()
+	for _, s := range []string{"", "hello", "report_2024.txt", "tab\tand\nnewline"} {
+		if !ascii.Parse(s).Ok {
+			t.Errorf("Expected %q to pass ASCII()", s)
+		}
+	}
+	for _, s := range []string{"café", "naïve", "日本", "emoji 🙂"} {
+		if ascii.Parse(s).Ok {
+			t.Errorf("Expected %q to fail ASCII()", s)
+		}
+	}
+
+	printable := String().Printable()
+	for _, s := range []string{"", "hello world", "café", "日本語"} {
+		if !printable.Parse(s).Ok {
+			t.Errorf("Expected %q to pass Printable()", s)
+		}
+	}
+	for _, s := range []string{"tab\t", "line\n", "bell\a", "zero\u200bwidth", "nul\x00", "bad\xff"} {
+		if printable.Parse(s).Ok {
+			t.Errorf("Expected %q to fail Printable()", s)
+		}
+	}
+
+	// Both together only allow printable ASCII
+	legacy := String().ASCII().Printable()
+	if !legacy.Parse("X-Request-Id").Ok || legacy.Parse("X-Request\r\n").Ok {
+		t.Error("Expected ASCII().Printable() to allow only printable ASCII")
+	}
+}
+
+// Test Alpha and Alphanumeric
+func TestStringAlpha(t *testing.T) {
+	tests := []struct {
+		schema *StringValidator
+		value  string
+		ok     bool
+	}{
+		{String().Alpha(), "hello", true},
+		{String().Alpha(), "Müller", true},
+		{String().Alpha(), "日本", true},
+		{String().Alpha(), "", true},
+		{String().Alpha(), "abc1", false},
+		{String().Alpha(), "two words", false},
+		{String().Alpha().AllowChars(" "), "two words", true},
+		{String().Alpha().ASCII(), "Müller", false},
+		{String().Alphanumeric(), "abc123", true},
+		{String().Alphanumeric(), "abc_123", false},
+		{String().Alphanumeric().AllowChars("_"), "abc_123", true},
+		{String().Alphanumeric().AllowChars("_"), "abc-123", false},
+		{String().Alphanumeric(), "١٢٣", true}, // Arabic-Indic digits
+	}
+
+	for _, tt := range tests {
+		if got := tt.schema.Parse(tt.value).Ok; got != tt.ok {
+			t.Errorf("Parse(%q).Ok = %v, want %v", tt.value, got, tt.ok)
+		}
+	}
+}
+
+// Test case assertions
+func TestStringCase(t *testing.T) {
+	lower := String().Lowercase()
+	for _, s := range []string{"", "hello", "user_42", "straße", "日本"} {
+		if !lower.Parse(s).Ok {
+			t.Errorf("Expected %q to pass Lowercase()", s)
+		}
+	}
+	for _, s := range []string{"Hello", "userID", "ÉCOLE"} {
+		result := lower.Parse(s)
+		if result.Ok || result.Errors[0].Code != CodeInvalidLowercase {
+			t.Errorf("Expected %q to fail Lowercase()", s)
+		}
+	}
+
+	upper := String().Uppercase()
+	if !upper.Parse("EUR_USD").Ok || upper.Parse("Eur").Ok {
+		t.Error("Expected Uppercase() to accept only upper case values")
+	}
+
+	// Unlike ToLowerCase, the value is rejected rather than rewritten
+	if result := String().Lowercase().Parse("ABC"); result.Ok {
+		t.Errorf("Expected ABC to fail Lowercase(), got %v", result.Value)
+	}
+	if result := String().ToLowerCase().Lowercase().Parse("ABC"); !result.Ok || result.Value != "abc" {
+		t.Errorf("Expected ToLowerCase to run before Lowercase, got %v", result)
+	}
+}
+
+// Test Unicode normalization transforms
+func TestStringNormalize(t *testing.T) {
+	decomposed := "Cafe\u0301" // "e" followed by a combining acute accent
+
+	result := String().NFC().Parse(decomposed)
+	if !result.Ok || result.Value != "Café" {
+		t.Errorf("Expected NFC to compose the accent, got %q", result.Value)
+	}
+
+	// Length checks see the normalized string
+	if !String().NFC().Max(5).Parse(decomposed).Ok {
+		t.Error("Expected the normalized string to fit Max(5)")
+	}
+	if String().Max(5).Parse(decomposed).Ok {
+		t.Error("Expected the decomposed string to exceed Max(5) without NFC")
+	}
+
+	// NFKC also folds compatibility characters
+	if result := String().NFKC().Parse("ｊｏｈｎﬁ"); !result.Ok || result.Value != "johnfi" {
+		t.Errorf("Expected NFKC to fold full-width letters and ligatures, got %q", result.Value)
+	}
+	if result := String().NFC().Parse("ﬁ"); result.Value != "ﬁ" {
+		t.Errorf("Expected NFC to keep the ligature, got %q", result.Value)
+	}
+}
+
+// Test Truncate, ReplaceAll and padding run in chain order
+func TestStringEditTransforms(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *StringValidator
+		input  string
+		want   string
+	}{
+		{"truncate", String().Truncate(5), "hello world", "hello"},
+		{"truncate short", String().Truncate(5), "hi", "hi"},
+		{"truncate runes", String().Truncate(2), "héllo", "hé"},
+		{"replace all", String().ReplaceAll("_", "-"), "a_b_c", "a-b-c"},
+		{"pad start", String().PadStart(6, '0'), "42", "000042"},
+		{"pad end", String().PadEnd(4, '·'), "ab", "ab··"},
+		{"pad long", String().PadStart(2, '0'), "1234", "1234"},
+		{"chain order", String().ReplaceAll(" ", "").Truncate(3), "a b c d", "abc"},
+		{"reverse order", String().Truncate(3).ReplaceAll(" ", ""), "a b c d", "ab"},
+		{"after trim", String().Trim().PadEnd(3, '.'), " a ", "a.."},
+	}
+	for _, tt := range tests {
+		result := tt.schema.Parse(tt.input)
+		if !result.Ok || result.Value != tt.want {
+			t.Errorf("%s: expected %q, got %q %v", tt.name, tt.want, result.Value, result.Errors)
+		}
+	}
+
+	// Rules see the edited string
+	if !String().Truncate(3).Max(3).Parse("abcdef").Ok {
+		t.Error("Expected Max to check the truncated string")
+	}
+}
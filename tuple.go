@@ -10,9 +10,16 @@ type TupleValidator struct {
 	rest       Validator // Optional validator for remaining elements
 
 	// Modifiers
-	isRequired bool
-	isOptional bool
-	isNullable bool
+	isRequired  bool
+	isOptional  bool
+	isNullable  bool
+	defaultVal  []interface{}
+	defaultFunc func() []interface{} // Set by DefaultFunc, called on each parse
+
+	// Custom validators
+	refinements []refinement
+
+	examples schemaExamples // Declared with Examples
 }
 
 // Tuple creates a new tuple validator with the given position validators
@@ -48,8 +55,60 @@ func (v *TupleValidator) Nullable() *TupleValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *TupleValidator) Nullish() *TupleValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Default sets a tuple to validate in place of a missing one. It runs
+// through the schema like any input.
+func (v *TupleValidator) Default(val []interface{}) *TupleValidator {
+	v.defaultVal, v.defaultFunc = val, nil
+	return v
+}
+
+// DefaultFunc sets a function called for the tuple to validate when the
+// input is nil
+func (v *TupleValidator) DefaultFunc(fn func() []interface{}) *TupleValidator {
+	v.defaultVal, v.defaultFunc = nil, fn
+	return v
+}
+
+// Refine adds custom validation logic on the parsed tuple. It runs after
+// all positions pass.
+func (v *TupleValidator) Refine(check func([]interface{}) bool, message string) *TupleValidator {
+	v.refinements = append(v.refinements, refinement{
+		check:   func(value any) bool { return check(value.([]interface{})) },
+		message: message,
+	})
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *TupleValidator) Examples(valid, invalid []any) *TupleValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value
 func (v *TupleValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *TupleValidator) parseWithState(value any, st *parseState) ParseResult {
+	// Validate a copy of the default in place of a missing tuple
+	if value == nil && v.defaultVal != nil {
+		value = copyValue(v.defaultVal)
+	}
+	if value == nil && v.defaultFunc != nil {
+		value = v.defaultFunc()
+	}
+
 	// Handle nil values based on modifiers
 	if value == nil {
 		// If optional, nil is OK
@@ -63,13 +122,13 @@ func (v *TupleValidator) Parse(value any) ParseResult {
 		}
 
 		// Otherwise, nil is not allowed
-		return FailureMessage("Expected tuple, received null")
+		return FailureTypeMismatch("tuple", nil)
 	}
 
 	// Check if value is an array
 	arr, ok := value.([]interface{})
 	if !ok {
-		return FailureMessage("Expected tuple (array), received " + typeof(value))
+		return FailureTypeMismatch("tuple (array)", value)
 	}
 
 	// Check length
@@ -78,12 +137,20 @@ func (v *TupleValidator) Parse(value any) ParseResult {
 
 	// If no rest validator, array must be exact length
 	if v.rest == nil && actualLen != expectedLen {
-		return FailureMessage(fmt.Sprintf("Expected tuple of length %d, received length %d", expectedLen, actualLen))
+		return FailureWithParams(
+			fmt.Sprintf("Expected tuple of length %d, received length %d", expectedLen, actualLen),
+			CodeInvalidLength,
+			map[string]any{"type": "tuple", "length": expectedLen, "received": actualLen},
+		)
 	}
 
 	// If rest validator, array must be at least the required length
 	if v.rest != nil && actualLen < expectedLen {
-		return FailureMessage(fmt.Sprintf("Expected tuple of at least length %d, received length %d", expectedLen, actualLen))
+		return FailureWithParams(
+			fmt.Sprintf("Expected tuple of at least length %d, received length %d", expectedLen, actualLen),
+			CodeTooSmall,
+			map[string]any{"type": "tuple", "minimum": expectedLen},
+		)
 	}
 
 	// Validate each position
@@ -92,16 +159,17 @@ func (v *TupleValidator) Parse(value any) ParseResult {
 
 	// Validate fixed positions
 	for i, validator := range v.validators {
-		elemResult := validator.Parse(arr[i])
+		if st.stopped() {
+			break
+		}
+
+		elemResult := st.parseIndex(i, validator, arr[i])
 
 		if !elemResult.Ok {
 			// Add tuple index to error path
 			for _, err := range elemResult.Errors {
-				errors = append(errors, ValidationError{
-					Path:    fmt.Sprintf("[%d]%s", i, prependPath(err.Path)),
-					Message: err.Message,
-					Value:   err.Value,
-				})
+				err.nest(fmt.Sprintf("[%d]", i), indexToken(i))
+				errors = append(errors, err)
 			}
 		} else {
 			result = append(result, elemResult.Value)
@@ -110,17 +178,14 @@ func (v *TupleValidator) Parse(value any) ParseResult {
 
 	// Validate rest elements if rest validator is set
 	if v.rest != nil {
-		for i := expectedLen; i < actualLen; i++ {
-			elemResult := v.rest.Parse(arr[i])
+		for i := expectedLen; i < actualLen && !st.stopped(); i++ {
+			elemResult := st.parseIndex(i, v.rest, arr[i])
 
 			if !elemResult.Ok {
 				// Add tuple index to error path
 				for _, err := range elemResult.Errors {
-					errors = append(errors, ValidationError{
-						Path:    fmt.Sprintf("[%d]%s", i, prependPath(err.Path)),
-						Message: err.Message,
-						Value:   err.Value,
-					})
+					err.nest(fmt.Sprintf("[%d]", i), indexToken(i))
+					errors = append(errors, err)
 				}
 			} else {
 				result = append(result, elemResult.Value)
@@ -133,5 +198,5 @@ func (v *TupleValidator) Parse(value any) ParseResult {
 		return Failure(errors...)
 	}
 
-	return Success(result)
+	return refine(v.refinements, Success(result))
 }
@@ -398,3 +398,19 @@ func TestTupleKeyValue(t *testing.T) {
 		t.Error("Expected key-value pair with number to pass")
 	}
 }
+
+// Test Default validates a default tuple in place of a missing one
+func TestTupleDefault(t *testing.T) {
+	origin := Tuple(Number(), Number()).Default([]interface{}{0, 0})
+
+	result := origin.Parse(nil)
+	if !result.Ok {
+		t.Fatalf("Expected nil to use the default, got %v", result.Errors)
+	}
+	if point := result.Value.([]interface{}); len(point) != 2 || point[0] != float64(0) {
+		t.Errorf("Expected the default point, got %v", point)
+	}
+	if result := origin.Parse([]interface{}{1, 2}); result.Value.([]interface{})[0] != float64(1) {
+		t.Errorf("Expected a given tuple to be used, got %v", result.Value)
+	}
+}
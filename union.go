@@ -1,18 +1,19 @@
 package zogo
 
-import (
-	"fmt"
-	"strings"
-)
-
 // UnionValidator validates that a value matches at least one of the provided validators
 type UnionValidator struct {
 	validators []Validator
+	exclusive  bool
 
 	// Modifiers
 	isRequired bool
 	isOptional bool
 	isNullable bool
+
+	// Custom validators
+	refinements []refinement
+
+	examples schemaExamples // Declared with Examples
 }
 
 // Union creates a new union validator with the given validators
@@ -22,6 +23,14 @@ func Union(validators ...Validator) *UnionValidator {
 	}
 }
 
+// Exclusive requires the value to match exactly one member, like JSON
+// Schema's oneOf. A value matching several members fails with
+// CodeAmbiguousUnion, listing the positions of the matching members.
+func (v *UnionValidator) Exclusive() *UnionValidator {
+	v.exclusive = true
+	return v
+}
+
 // Required marks the field as required
 func (v *UnionValidator) Required() *UnionValidator {
 	v.isRequired = true
@@ -42,8 +51,35 @@ func (v *UnionValidator) Nullable() *UnionValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *UnionValidator) Nullish() *UnionValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
+// Refine adds custom validation logic on the value parsed by the matching
+// member
+func (v *UnionValidator) Refine(check func(any) bool, message string) *UnionValidator {
+	v.refinements = append(v.refinements, refinement{check: check, message: message})
+	return v
+}
+
+// Examples declares values the schema must accept and reject, checked by
+// RunExamples
+func (v *UnionValidator) Examples(valid, invalid []any) *UnionValidator {
+	v.examples = schemaExamples{valid: valid, invalid: invalid}
+	return v
+}
+
 // Parse validates the input value against all union members
 func (v *UnionValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *UnionValidator) parseWithState(value any, st *parseState) ParseResult {
 	// Handle nil values based on modifiers
 	if value == nil {
 		// If optional, nil is OK
@@ -58,7 +94,7 @@ func (v *UnionValidator) Parse(value any) ParseResult {
 
 		// If explicitly required, reject
 		if v.isRequired {
-			return FailureMessage("Expected value, received null")
+			return FailureTypeMismatch("value", nil)
 		}
 
 		// Otherwise, try validating nil through each validator
@@ -66,30 +102,109 @@ func (v *UnionValidator) Parse(value any) ParseResult {
 	}
 
 	// Try each validator in the union
-	var allErrors []string
+	branches := make([]UnionBranch, 0, len(v.validators))
+	var matched ParseResult
+	var matches []int
 
 	for i, validator := range v.validators {
-		result := validator.Parse(value)
+		// Members are tried speculatively, so their errors don't count toward the parse
+		result := st.fork().parse(validator, value)
 
-		// If any validator passes, return success immediately
+		// If any validator passes, return success immediately, unless the
+		// other members must be ruled out
 		if result.Ok {
-			return Success(result.Value)
+			if !v.exclusive {
+				return refine(v.refinements, Success(result.Value))
+			}
+			if matches = append(matches, i); len(matches) == 1 {
+				matched = result
+			}
+			continue
 		}
 
-		// Collect error messages for reporting
-		if len(result.Errors) > 0 {
-			// Format error for this union member
-			errorMsgs := make([]string, len(result.Errors))
-			for j, err := range result.Errors {
-				errorMsgs[j] = err.Message
+		// Keep this member's errors for reporting
+		branches = append(branches, UnionBranch{Index: i, Errors: result.Errors})
+	}
+
+	switch {
+	case len(matches) == 1:
+		return refine(v.refinements, Success(matched.Value))
+	case len(matches) > 1:
+		return Failure(ValidationError{
+			Message: "Value matched more than one union type",
+			Code:    CodeAmbiguousUnion,
+			Params:  map[string]any{"matches": matches},
+		})
+	}
+
+	// None of the validators passed. Report the errors of the member that came
+	// closest to matching, or all members' errors if none stands out
+	if best := bestBranch(branches); best >= 0 {
+		return Failure(branches[best].Errors...)
+	}
+	for i := range branches {
+		branches[i].Name = registeredName(v.validators[i])
+	}
+	return Failure(ValidationError{
+		Message:  "Value did not match any union type",
+		Code:     CodeInvalidUnion,
+		Branches: branches,
+	})
+}
+
+// branchScore ranks how close a union member came to matching
+type branchScore struct {
+	mismatched bool // A literal or enum field (such as a discriminator) did not match
+	errors     int  // Number of errors
+	depth      int  // Total depth of the error paths
+}
+
+// less reports whether s is a closer match than other
+func (s branchScore) less(other branchScore) bool {
+	if s.mismatched != other.mismatched {
+		return !s.mismatched
+	}
+	if s.errors != other.errors {
+		return s.errors < other.errors
+	}
+	return s.depth < other.depth
+}
+
+// bestBranch returns the position of the member with the fewest and
+// shallowest errors, preferring members whose literal fields matched. Members
+// rejecting the type of the value are never chosen, and -1 is returned if no
+// member is a closer match than all others.
+func bestBranch(branches []UnionBranch) int {
+	best, tied := -1, false
+	var bestScore branchScore
+
+	for i, branch := range branches {
+		score := branchScore{errors: len(branch.Errors)}
+		wrongType := false
+		for _, err := range branch.Errors {
+			if err.Path == "" && err.Code == CodeInvalidType {
+				wrongType = true
+				break
 			}
-			allErrors = append(allErrors, fmt.Sprintf("Option %d: %s", i+1, strings.Join(errorMsgs, ", ")))
-		} else {
-			allErrors = append(allErrors, fmt.Sprintf("Option %d: validation failed", i+1))
+			if err.Code == CodeInvalidLiteral || err.Code == CodeInvalidEnumValue {
+				score.mismatched = true
+			}
+			score.depth += len(pathTokens(err.Path))
+		}
+		if wrongType || len(branch.Errors) == 0 {
+			continue
+		}
+
+		switch {
+		case best < 0 || score.less(bestScore):
+			best, bestScore, tied = i, score, false
+		case !bestScore.less(score):
+			tied = true
 		}
 	}
 
-	// None of the validators passed
-	errorMsg := fmt.Sprintf("Value did not match any union type. Errors: %s", strings.Join(allErrors, "; "))
-	return FailureMessage(errorMsg)
+	if tied {
+		return -1
+	}
+	return best
 }
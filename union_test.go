@@ -465,3 +465,122 @@ func TestUnionFirstSuccess(t *testing.T) {
 		t.Errorf("Expected 'hello' (trimmed), got '%v'", result.Value)
 	}
 }
+
+// Test failures are reported per branch with nested paths when no branch is a
+// closer match than the others
+func TestUnionBranchErrors(t *testing.T) {
+	email := Object(Schema{"type": Literal("email"), "address": String().Email()})
+	phone := Object(Schema{"type": Literal("phone"), "number": String().Min(7)})
+	Register("UnionTestPhone", phone)
+	defer Unregister("UnionTestPhone")
+
+	schema := Object(Schema{"contact": Union(email, phone)})
+	result := schema.Parse(map[string]interface{}{
+		"contact": map[string]interface{}{"type": "fax"},
+	})
+	if result.Ok || len(result.Errors) != 1 {
+		t.Fatalf("Expected one union error, got %v", result.Errors)
+	}
+
+	err := result.Errors[0]
+	if err.Path != "contact" || err.Code != CodeInvalidUnion || err.Message != "Value did not match any union type" {
+		t.Errorf("Unexpected union error: %+v", err)
+	}
+	if len(err.Branches) != 2 {
+		t.Fatalf("Expected 2 branches, got %+v", err.Branches)
+	}
+	if first := err.Branches[0]; first.Index != 0 || first.Name != "" || !first.Errors.HasPath("type") || !first.Errors.HasPath("address") {
+		t.Errorf("Unexpected first branch: %+v", first)
+	}
+	if second := err.Branches[1]; second.Index != 1 || second.Name != "UnionTestPhone" || !second.Errors.HasPath("type") || !second.Errors.HasPath("number") {
+		t.Errorf("Unexpected second branch: %+v", second)
+	}
+
+	issues := result.Errors.Issues()
+	branches, ok := issues[0]["branches"].([]map[string]interface{})
+	if !ok || len(branches) != 2 || branches[1]["name"] != "UnionTestPhone" {
+		t.Fatalf("Expected branches in issues, got %v", issues[0])
+	}
+	if _, ok := branches[0]["name"]; ok {
+		t.Error("Expected unnamed branch to have no name")
+	}
+	if nested := branches[0]["errors"].([]map[string]interface{}); len(nested) != 2 {
+		t.Errorf("Expected nested branch issues, got %v", nested)
+	}
+
+	RegisterLocale("x-union", Messages{"invalid_literal": "Wrong literal"})
+	localized := result.Errors.Localize("x-union")
+	if message := localized[0].Branches[1].Errors.ByPath("type")[0].Message; message != "Wrong literal" {
+		t.Errorf("Expected branch errors to be localized, got %q", message)
+	}
+}
+
+// Test the errors of the closest branch are reported instead of every branch's
+func TestUnionBestMatch(t *testing.T) {
+	email := Object(Schema{"type": Literal("email"), "address": String().Email()})
+	phone := Object(Schema{"type": Literal("phone"), "number": String().Min(7)})
+	schema := Object(Schema{"contact": Union(email, phone)})
+
+	// The discriminator matches the phone branch
+	result := schema.Parse(map[string]interface{}{
+		"contact": map[string]interface{}{"type": "phone", "number": "123"},
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "contact.number" || result.Errors[0].Code != CodeTooSmall {
+		t.Errorf("Expected the phone branch error, got %v", result.Errors)
+	}
+
+	// Branches rejecting the type are ruled out
+	result = Union(Number(), String().Email()).Parse("nope")
+	if len(result.Errors) != 1 || result.Errors[0].Code != "invalid_string.email" {
+		t.Errorf("Expected the email error, got %v", result.Errors)
+	}
+
+	// Fewer errors win, then shallower ones
+	result = Union(
+		Object(Schema{"a": String(), "b": String()}),
+		Object(Schema{"a": Number()}),
+	).Parse(map[string]interface{}{"a": true})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "a" || result.Errors[0].Params["expected"] != "number" {
+		t.Errorf("Expected the branch with fewer errors, got %v", result.Errors)
+	}
+	result = Union(
+		Object(Schema{"a": Object(Schema{"b": Number()})}),
+		Object(Schema{"a": Number().Min(5)}),
+	).Parse(map[string]interface{}{"a": map[string]interface{}{"b": "x"}})
+	if len(result.Errors) != 1 || result.Errors[0].Path != "a" {
+		t.Errorf("Expected the branch with the shallower error, got %v", result.Errors)
+	}
+
+	// No branch stands out
+	result = Union(String(), Number()).Parse(true)
+	if len(result.Errors) != 1 || result.Errors[0].Code != CodeInvalidUnion || len(result.Errors[0].Branches) != 2 {
+		t.Errorf("Expected an invalid_union error, got %v", result.Errors)
+	}
+}
+
+// Test Exclusive rejects values matching more than one member
+func TestUnionExclusive(t *testing.T) {
+	card := Object(Schema{"card": String()})
+	iban := Object(Schema{"iban": String()})
+	schema := Union(card, iban).Exclusive()
+
+	result := schema.Parse(map[string]interface{}{"card": "4111"})
+	if !result.Ok || result.Value.(map[string]interface{})["card"] != "4111" {
+		t.Errorf("Expected a single match to pass, got %v", result.Errors)
+	}
+
+	result = schema.Parse(map[string]interface{}{"card": "4111", "iban": "DE89"})
+	if result.Ok || len(result.Errors) != 1 || result.Errors[0].Code != CodeAmbiguousUnion {
+		t.Fatalf("Expected an ambiguous_union error, got %v", result.Errors)
+	}
+	if matches := result.Errors[0].Params["matches"].([]int); len(matches) != 2 || matches[0] != 0 || matches[1] != 1 {
+		t.Errorf("Expected both members to be listed, got %v", matches)
+	}
+
+	if !Union(card, iban).Parse(map[string]interface{}{"card": "4111", "iban": "DE89"}).Ok {
+		t.Error("Expected a non-exclusive union to accept the first match")
+	}
+	if result := schema.Parse(map[string]interface{}{"card": 1}); result.Ok || result.Errors[0].Code == CodeAmbiguousUnion {
+		t.Errorf("Expected no match to fail as usual, got %v", result.Errors)
+	}
+}
@@ -33,11 +33,24 @@ func (v *UnknownValidator) Nullable() *UnknownValidator {
 	return v
 }
 
+// Nullish allows nil values, like Optional and Nullable together
+func (v *UnknownValidator) Nullish() *UnknownValidator {
+	v.isOptional = true
+	v.isNullable = true
+	v.isRequired = false
+	return v
+}
+
 // Parse accepts any value
 func (v *UnknownValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *UnknownValidator) parseWithState(value any, st *parseState) ParseResult {
 	// If Required is explicitly set and value is nil, reject
 	if v.isRequired && value == nil {
-		return FailureMessage("Expected value, received null")
+		return FailureTypeMismatch("value", nil)
 	}
 
 	// Accept everything else
@@ -0,0 +1,84 @@
+package zogo
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// UsernamePolicy lists the rules String().Username checks. Names are made
+// of ASCII letters, digits and AllowChars, so they cannot mix look-alike
+// characters from other scripts.
+type UsernamePolicy struct {
+	MinLength      int      // Minimum length, unchecked when 0
+	MaxLength      int      // Maximum length, unchecked when 0
+	AllowChars     string   // Characters allowed besides letters and digits, such as "_.-"
+	NoLeadingDigit bool     // Reject names starting with a digit
+	Reserved       []string // Names rejected whatever their case, such as "admin"
+}
+
+// reservedUsernames are the names DefaultUsernamePolicy reserves
+var reservedUsernames = []string{
+	"admin", "administrator", "api", "billing", "help", "login", "logout",
+	"me", "moderator", "null", "official", "root", "security", "settings",
+	"signup", "staff", "support", "system", "undefined", "www",
+}
+
+// DefaultUsernamePolicy returns the policy Username uses when given none:
+// 3 to 32 letters, digits, "_", "." and "-", not starting with a digit, and
+// not a reserved name such as "admin" or "root"
+func DefaultUsernamePolicy() UsernamePolicy {
+	return UsernamePolicy{
+		MinLength:      3,
+		MaxLength:      32,
+		AllowChars:     "_.-",
+		NoLeadingDigit: true,
+		Reserved:       append([]string(nil), reservedUsernames...),
+	}
+}
+
+// checkUsername checks a username against the policy, failing on the first
+// rule it breaks
+func checkUsername(name string, policy UsernamePolicy) (ParseResult, bool) {
+	if n := utf8.RuneCountInString(name); policy.MinLength > 0 && n < policy.MinLength {
+		return FailureWithParams(
+			fmt.Sprintf("Username must be at least %d characters", policy.MinLength),
+			CodeTooSmall,
+			map[string]any{"type": "username", "minimum": policy.MinLength},
+		), false
+	} else if policy.MaxLength > 0 && n > policy.MaxLength {
+		return FailureWithParams(
+			fmt.Sprintf("Username must be at most %d characters", policy.MaxLength),
+			CodeTooBig,
+			map[string]any{"type": "username", "maximum": policy.MaxLength},
+		), false
+	}
+
+	for _, r := range name {
+		if r < utf8.RuneSelf && isLettersOrDigits(string(r)) || strings.ContainsRune(policy.AllowChars, r) {
+			continue
+		}
+		if policy.AllowChars == "" {
+			return FailureWithParams("Username may only contain letters and digits", CodeInvalidUsername,
+				map[string]any{"type": "alnum"}), false
+		}
+		return FailureWithParams(
+			fmt.Sprintf("Username may only contain letters, digits and %s", policy.AllowChars),
+			CodeInvalidUsername,
+			map[string]any{"type": "chars", "allowed": policy.AllowChars},
+		), false
+	}
+
+	if policy.NoLeadingDigit && name != "" && name[0] >= '0' && name[0] <= '9' {
+		return FailureWithParams("Username must not start with a digit", CodeInvalidUsername,
+			map[string]any{"type": "digit"}), false
+	}
+
+	for _, reserved := range policy.Reserved {
+		if strings.EqualFold(name, reserved) {
+			return FailureWithParams("Username is reserved", CodeInvalidUsername,
+				map[string]any{"type": "reserved"}), false
+		}
+	}
+	return ParseResult{}, true
+}
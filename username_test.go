@@ -0,0 +1,62 @@
+package zogo
+
+import "testing"
+
+// Test Username with the default policy
+func TestStringUsername(t *testing.T) {
+	schema := String().Username()
+
+	for _, name := range []string{"jane", "jane_doe", "j.doe-42", "Admin2"} {
+		if result := schema.Parse(name); !result.Ok {
+			t.Errorf("Expected %q to pass Username(), got %v", name, result.Errors)
+		}
+	}
+
+	tests := []struct {
+		input string
+		code  ErrorCode
+		rule  string
+	}{
+		{"jo", CodeTooSmall, "username"},
+		{"a123456789012345678901234567890123", CodeTooBig, "username"},
+		{"jane doe", CodeInvalidUsername, "chars"},
+		{"jöhn", CodeInvalidUsername, "chars"},
+		{"42jane", CodeInvalidUsername, "digit"},
+		{"ADMIN", CodeInvalidUsername, "reserved"},
+	}
+	for _, tt := range tests {
+		result := schema.Parse(tt.input)
+		if result.Ok || result.Errors[0].Code != tt.code || result.Errors[0].Params["type"] != tt.rule {
+			t.Errorf("Expected %q to fail with %s (%s), got %v", tt.input, tt.code, tt.rule, result.Errors)
+		}
+	}
+}
+
+// Test Username with a custom policy
+func TestStringUsernamePolicy(t *testing.T) {
+	schema := String().Username(UsernamePolicy{MaxLength: 8, Reserved: []string{"owner"}})
+
+	for _, name := range []string{"a", "42", "jane2"} {
+		if result := schema.Parse(name); !result.Ok {
+			t.Errorf("Expected %q to pass, got %v", name, result.Errors)
+		}
+	}
+
+	result := schema.Parse("jane_doe")
+	if result.Ok || result.Errors[0].Message != "Username may only contain letters and digits" {
+		t.Errorf("Expected an underscore to fail without AllowChars, got %v", result.Errors)
+	}
+	if schema.Parse("Owner").Ok || schema.Parse("janedoe42").Ok {
+		t.Error("Expected reserved and long names to fail")
+	}
+}
+
+// Test DefaultUsernamePolicy returns a copy that callers may change
+func TestDefaultUsernamePolicy(t *testing.T) {
+	policy := DefaultUsernamePolicy()
+	policy.Reserved[0] = "jane"
+
+	if !String().Username().Parse("jane").Ok {
+		t.Error("Expected changing a returned policy not to affect the default")
+	}
+}
@@ -0,0 +1,302 @@
+package zogo
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Redacted replaces sensitive values in rejected payloads and their errors
+const Redacted = "[REDACTED]"
+
+// SensitiveValidator marks the values of a validator as sensitive
+type SensitiveValidator struct {
+	validator Validator
+}
+
+// Sensitive marks a field as holding sensitive data (passwords, tokens,
+// personal details). Validation is unchanged, but a Valve redacts the value
+// from the payloads and errors it reports.
+func Sensitive(validator Validator) *SensitiveValidator {
+	return &SensitiveValidator{validator: validator}
+}
+
+// Parse validates the input value
+func (v *SensitiveValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the input value as part of a larger parse
+func (v *SensitiveValidator) parseWithState(value any, st *parseState) ParseResult {
+	result := st.parse(v.validator, value)
+	if value != nil {
+		st.meta.Sensitive = append(st.meta.Sensitive, "")
+	}
+	return result
+}
+
+// Rejection describes a payload that failed validation
+type Rejection struct {
+	Schema  string           // Registered name of the schema, or the WithSchemaName option
+	Payload any              // The input, with sensitive values redacted
+	Errors  ValidationErrors // The errors, with sensitive values redacted
+}
+
+// RejectionSink receives rejected payloads from a Valve
+type RejectionSink func(ctx context.Context, rejection Rejection)
+
+// Valve passes samples of rejected payloads to a sink, such as a logger,
+// after redacting sensitive values. Install one for every parse with
+// SetValve, or for a single call with WithValve:
+//
+//	zogo.SetValve(zogo.NewValve(zogo.SlogSink(logger, slog.LevelWarn)).Sample(0.01))
+type Valve struct {
+	sink       RejectionSink
+	sampleRate float64
+	redactKeys map[string]bool
+	random     func() float64 // Source for sampling, replaced in tests
+}
+
+// NewValve creates a valve reporting every rejected payload to sink
+func NewValve(sink RejectionSink) *Valve {
+	return &Valve{
+		sink:       sink,
+		sampleRate: 1,
+		redactKeys: map[string]bool{},
+		random:     rand.Float64,
+	}
+}
+
+// Sample reports the given fraction of rejected payloads (0 to 1)
+func (v *Valve) Sample(rate float64) *Valve {
+	v.sampleRate = rate
+	return v
+}
+
+// RedactKeys also redacts fields with the given names (case-insensitive)
+// anywhere in the payload, whether or not the schema marks them Sensitive
+func (v *Valve) RedactKeys(keys ...string) *Valve {
+	for _, key := range keys {
+		v.redactKeys[strings.ToLower(key)] = true
+	}
+	return v
+}
+
+var globalValve atomic.Pointer[Valve]
+
+// SetValve installs a valve for all parse calls; nil removes it
+func SetValve(valve *Valve) {
+	globalValve.Store(valve)
+}
+
+// reject reports a failed parse to the sink, if it is sampled
+func (v *Valve) reject(ctx context.Context, validator Validator, value any, result ParseResult, name string) {
+	if v.sampleRate < 1 && v.random() >= v.sampleRate {
+		return
+	}
+	if name == "" {
+		name = registeredName(validator)
+	}
+
+	payload := redactPayload(value, v.redactKeys, result.Meta.Sensitive, nil)
+
+	errors := make(ValidationErrors, len(result.Errors))
+	for i, err := range result.Errors {
+		if v.sensitivePath(err.Path, result.Meta.Sensitive) {
+			err = redactError(err, value)
+		}
+		errors[i] = err
+	}
+
+	v.sink(ctx, Rejection{Schema: name, Payload: payload, Errors: errors})
+}
+
+// redactPayload returns a copy of value with the fields named in keys
+// (lowercase) and the values at the sensitive paths redacted. If paths is not
+// nil, the paths of the redacted fields are added to it.
+func redactPayload(value any, keys map[string]bool, sensitive []string, paths *[]string) any {
+	if len(keys) > 0 {
+		value = redactKeys(value, keys, "", paths)
+	}
+	for _, path := range sensitive {
+		value = redactPath(value, pathTokens(path))
+	}
+	if paths != nil {
+		*paths = append(*paths, sensitive...)
+	}
+	return value
+}
+
+// redactKeys returns a copy of value with the fields named in keys redacted
+func redactKeys(value any, keys map[string]bool, path string, paths *[]string) any {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(value))
+		for key, field := range value {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			if keys[strings.ToLower(key)] {
+				redacted[key] = Redacted
+				if paths != nil {
+					*paths = append(*paths, fieldPath)
+				}
+			} else {
+				redacted[key] = redactKeys(field, keys, fieldPath, paths)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(value))
+		for i, item := range value {
+			var itemPath string
+			if paths != nil {
+				itemPath = fmt.Sprintf("%s[%d]", path, i)
+			}
+			redacted[i] = redactKeys(item, keys, itemPath, paths)
+		}
+		return redacted
+	}
+	return value
+}
+
+// sensitivePath reports whether an error path is at or below a sensitive
+// path, or passes through a field named by RedactKeys
+func (v *Valve) sensitivePath(path string, sensitive []string) bool {
+	if underPaths(path, sensitive) {
+		return true
+	}
+	for _, token := range pathTokens(path) {
+		if !token.index && v.redactKeys[strings.ToLower(token.name)] {
+			return true
+		}
+	}
+	return false
+}
+
+// redactPath returns a copy of value with the value at the path redacted.
+// Maps and slices along the path are copied; the input is never modified.
+func redactPath(value any, tokens []pathToken) any {
+	if len(tokens) == 0 {
+		return Redacted
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		field, ok := value[tokens[0].name]
+		if !ok {
+			return value
+		}
+		redacted := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			redacted[key] = item
+		}
+		redacted[tokens[0].name] = redactPath(field, tokens[1:])
+		return redacted
+	case []interface{}:
+		i, err := strconv.Atoi(tokens[0].name)
+		if err != nil || i < 0 || i >= len(value) {
+			return value
+		}
+		redacted := append([]interface{}(nil), value...)
+		redacted[i] = redactPath(value[i], tokens[1:])
+		return redacted
+	}
+	return value
+}
+
+// redactError removes a sensitive value from an error, including from its
+// message when the message quotes the value it received
+func redactError(err ValidationError, payload any) ValidationError {
+	raw := err.Value
+	if raw == nil {
+		raw = valueAt(payload, pathTokens(err.Path))
+	}
+	if err.Value != nil {
+		err.Value = Redacted
+	}
+	if len(err.Branches) > 0 {
+		err.Branches = redactBranches(err.Branches, valueAt(payload, pathTokens(err.Path)))
+	}
+
+	received, ok := err.Params["received"].(string)
+	if !ok || raw == nil || received != fmt.Sprint(raw) {
+		return err
+	}
+
+	params := make(map[string]any, len(err.Params))
+	for key, param := range err.Params {
+		params[key] = param
+	}
+	params["received"] = Redacted
+	err.Params = params
+	if tmpl, ok := lookupTemplate("en", err); ok {
+		err.Message = interpolate(tmpl, err)
+	} else {
+		err.Message = strings.ReplaceAll(err.Message, received, Redacted)
+	}
+	return err
+}
+
+// redactBranches redacts the errors of union members, whose paths are
+// relative to the union value
+func redactBranches(branches []UnionBranch, value any) []UnionBranch {
+	redacted := make([]UnionBranch, len(branches))
+	for i, branch := range branches {
+		errors := make(ValidationErrors, len(branch.Errors))
+		for j, err := range branch.Errors {
+			errors[j] = redactError(err, value)
+		}
+		branch.Errors = errors
+		redacted[i] = branch
+	}
+	return redacted
+}
+
+// valueAt returns the value at a path, or nil if there is none
+func valueAt(value any, tokens []pathToken) any {
+	for _, token := range tokens {
+		switch container := value.(type) {
+		case map[string]interface{}:
+			value = container[token.name]
+		case []interface{}:
+			i, err := strconv.Atoi(token.name)
+			if err != nil || i < 0 || i >= len(container) {
+				return nil
+			}
+			value = container[i]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// registeredName returns the name a validator is registered under, if any
+func registeredName(validator Validator) string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	for name, registered := range registry {
+		if sameValidator(registered, validator) {
+			return name
+		}
+	}
+	return ""
+}
+
+// sameValidator compares validators by identity. Comparing interfaces panics
+// for uncomparable types (a validator implemented by a map or func type),
+// which are never the same.
+func sameValidator(a, b Validator) (same bool) {
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
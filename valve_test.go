@@ -0,0 +1,166 @@
+package zogo
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func collectRejections(rejections *[]Rejection) RejectionSink {
+	return func(ctx context.Context, rejection Rejection) {
+		*rejections = append(*rejections, rejection)
+	}
+}
+
+// Test rejected payloads are reported with sensitive values redacted
+func TestValveRedactsSensitiveValues(t *testing.T) {
+	var rejections []Rejection
+	valve := NewValve(collectRejections(&rejections))
+
+	schema := Object(Schema{
+		"email":    String().Email(),
+		"password": Sensitive(String().Min(12)),
+		"role":     Sensitive(Enum([]interface{}{"admin", "user"})),
+		"cards":    Array(Object(Schema{"number": Sensitive(String())})),
+	})
+	input := map[string]interface{}{
+		"email":    "nope",
+		"password": "hunter2",
+		"role":     "superuser",
+		"cards":    []interface{}{map[string]interface{}{"number": "4111111111111111"}},
+	}
+
+	result := ParseCtx(context.Background(), schema, input, WithValve(valve), WithSchemaName("Signup"))
+	if result.Ok || len(rejections) != 1 {
+		t.Fatalf("Expected one rejection, got %d", len(rejections))
+	}
+
+	rejection := rejections[0]
+	if rejection.Schema != "Signup" {
+		t.Errorf("Expected schema name Signup, got %q", rejection.Schema)
+	}
+	payload := rejection.Payload.(map[string]interface{})
+	if payload["email"] != "nope" || payload["password"] != Redacted || payload["role"] != Redacted {
+		t.Errorf("Unexpected redacted payload: %v", payload)
+	}
+	card := payload["cards"].([]interface{})[0].(map[string]interface{})
+	if card["number"] != Redacted {
+		t.Errorf("Expected nested sensitive value to be redacted, got %v", card)
+	}
+	if input["password"] != "hunter2" || input["cards"].([]interface{})[0].(map[string]interface{})["number"] != "4111111111111111" {
+		t.Error("Expected input to be left unchanged")
+	}
+
+	for _, err := range rejection.Errors {
+		if err.Path == "role" && (strings.Contains(err.Message, "superuser") || err.Params["received"] != Redacted) {
+			t.Errorf("Expected role error to be redacted, got %+v", err)
+		}
+	}
+	if !rejection.Errors.HasPath("email") || !rejection.Errors.HasPath("password") {
+		t.Errorf("Expected all errors to be reported, got %v", rejection.Errors)
+	}
+	if !strings.Contains(result.Errors.ByPath("role")[0].Message, "superuser") {
+		t.Error("Expected the parse result itself to be unredacted")
+	}
+}
+
+// Test RedactKeys redacts fields by name
+func TestValveRedactKeys(t *testing.T) {
+	var rejections []Rejection
+	valve := NewValve(collectRejections(&rejections)).RedactKeys("Token")
+
+	schema := Object(Schema{
+		"auth": Object(Schema{"token": String().Min(10)}),
+		"age":  Number(),
+	})
+	ParseCtx(context.Background(), schema, map[string]interface{}{
+		"auth": map[string]interface{}{"token": "abc"},
+		"age":  "old",
+	}, WithValve(valve))
+
+	if len(rejections) != 1 {
+		t.Fatalf("Expected one rejection, got %d", len(rejections))
+	}
+	auth := rejections[0].Payload.(map[string]interface{})["auth"].(map[string]interface{})
+	if auth["token"] != Redacted {
+		t.Errorf("Expected token to be redacted, got %v", auth)
+	}
+	for _, err := range rejections[0].Errors {
+		if err.Path == "age" && err.Value != "old" {
+			t.Errorf("Expected non-sensitive error value to be kept, got %+v", err)
+		}
+	}
+}
+
+// Test the global valve, registry names and sampling
+func TestSetValve(t *testing.T) {
+	var rejections []Rejection
+	valve := NewValve(collectRejections(&rejections)).Sample(0.5)
+	rolls := []float64{0.2, 0.7}
+	valve.random = func() float64 {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	}
+
+	SetValve(valve)
+	defer SetValve(nil)
+
+	schema := String().Min(3)
+	Register("ValveTest", schema)
+	defer Unregister("ValveTest")
+
+	schema.Parse("ok!")
+	schema.Parse("a")
+	schema.Parse("b")
+
+	if len(rejections) != 1 {
+		t.Fatalf("Expected one sampled rejection, got %d", len(rejections))
+	}
+	if rejections[0].Schema != "ValveTest" || rejections[0].Payload != "a" {
+		t.Errorf("Unexpected rejection: %+v", rejections[0])
+	}
+}
+
+// Test the global valve sees rejections from validators of every type
+func TestSetValveScalarRoots(t *testing.T) {
+	var rejections []Rejection
+	SetValve(NewValve(collectRejections(&rejections)))
+	defer SetValve(nil)
+
+	Boolean().Parse("yes")
+	Enum([]interface{}{"admin", "user"}).Parse("root")
+	Literal("v1").Parse("v2")
+	Null().Parse(0)
+
+	if len(rejections) != 4 {
+		t.Fatalf("Expected four rejections, got %d", len(rejections))
+	}
+	if rejections[1].Payload != "root" || rejections[1].Errors[0].Code != CodeInvalidEnumValue {
+		t.Errorf("Unexpected enum rejection: %+v", rejections[1])
+	}
+}
+
+// Test sensitive values are redacted from union branch errors
+func TestValveRedactsUnionBranches(t *testing.T) {
+	var rejections []Rejection
+	valve := NewValve(collectRejections(&rejections))
+
+	schema := Object(Schema{"secret": Sensitive(Union(Enum([]interface{}{"a", "b"}), Enum([]interface{}{"c", "d"})))})
+	ParseCtx(context.Background(), schema, map[string]interface{}{"secret": "hunter2"}, WithValve(valve))
+
+	if len(rejections) != 1 {
+		t.Fatalf("Expected one rejection, got %d", len(rejections))
+	}
+	branches := rejections[0].Errors[0].Branches
+	if len(branches) != 2 {
+		t.Fatalf("Expected union branches, got %+v", rejections[0].Errors)
+	}
+	for _, branch := range branches {
+		for _, err := range branch.Errors {
+			if strings.Contains(err.Message, "hunter2") || err.Value == "hunter2" {
+				t.Errorf("Expected branch error to be redacted, got %+v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DefaultVersionHeaders are the request headers checked for an API version, in order
+var DefaultVersionHeaders = []string{"Accept-Version", "X-API-Version"}
+
+// VersionedValidator selects among registered schema versions based on a
+// request header and validates the payload with the matching schema
+type VersionedValidator struct {
+	versions       map[string]Validator
+	order          []string
+	headers        []string
+	defaultVersion string
+}
+
+// Versioned creates an empty version router. Register schemas with Version:
+//
+//	schema := zogo.Versioned().
+//	    Version("v1", userV1).
+//	    Version("v2", userV2)
+//	result := schema.ParseRequest(r, body)
+func Versioned() *VersionedValidator {
+	return &VersionedValidator{
+		versions: map[string]Validator{},
+		headers:  DefaultVersionHeaders,
+	}
+}
+
+// Version registers the schema used for the given version
+func (v *VersionedValidator) Version(version string, validator Validator) *VersionedValidator {
+	version = strings.TrimSpace(version)
+	if _, exists := v.versions[version]; !exists {
+		v.order = append(v.order, version)
+	}
+	v.versions[version] = validator
+	return v
+}
+
+// Default sets the version used when the request has no version header
+func (v *VersionedValidator) Default(version string) *VersionedValidator {
+	v.defaultVersion = strings.TrimSpace(version)
+	return v
+}
+
+// Headers sets the request headers checked for the version, in order
+func (v *VersionedValidator) Headers(names ...string) *VersionedValidator {
+	v.headers = names
+	return v
+}
+
+// Versions returns the registered versions in registration order
+func (v *VersionedValidator) Versions() []string {
+	return append([]string(nil), v.order...)
+}
+
+// Resolve returns the version and schema selected by the request headers.
+// It fails with CodeUnsupportedVersion when the header is missing (and no
+// default is set) or names an unknown version.
+func (v *VersionedValidator) Resolve(header http.Header) (string, Validator, ValidationErrors) {
+	version := ""
+	for _, name := range v.headers {
+		if value := strings.TrimSpace(header.Get(name)); value != "" {
+			version = value
+			break
+		}
+	}
+	if version == "" {
+		version = v.defaultVersion
+	}
+
+	supported := strings.Join(v.order, ", ")
+	if version == "" {
+		return "", nil, ValidationErrors{{
+			Message: "Missing API version; supported versions: " + supported,
+			Code:    CodeUnsupportedVersion,
+			Params:  map[string]any{"type": "missing", "version": "", "supported": supported},
+		}}
+	}
+
+	validator, ok := v.versions[version]
+	if !ok {
+		return "", nil, ValidationErrors{{
+			Message: fmt.Sprintf("Unsupported API version '%s'; supported versions: %s", version, supported),
+			Value:   version,
+			Code:    CodeUnsupportedVersion,
+			Params:  map[string]any{"version": version, "supported": supported},
+		}}
+	}
+
+	return version, validator, nil
+}
+
+// ParseHeader validates the value with the schema selected by the headers
+func (v *VersionedValidator) ParseHeader(header http.Header, value any) ParseResult {
+	_, validator, errs := v.Resolve(header)
+	if errs != nil {
+		return Failure(errs...)
+	}
+	return validator.Parse(value)
+}
+
+// ParseRequest validates the value with the schema selected by the request headers
+func (v *VersionedValidator) ParseRequest(r *http.Request, value any) ParseResult {
+	return v.ParseHeader(r.Header, value)
+}
+
+// Parse validates the value with the default version's schema
+func (v *VersionedValidator) Parse(value any) ParseResult {
+	return ParseWith(v, value, ParseOptions{})
+}
+
+// parseWithState validates the value with the default version's schema as part of a larger parse
+func (v *VersionedValidator) parseWithState(value any, st *parseState) ParseResult {
+	_, validator, errs := v.Resolve(http.Header{})
+	if errs != nil {
+		return Failure(errs...)
+	}
+	return st.parse(validator, value)
+}
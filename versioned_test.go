@@ -0,0 +1,96 @@
+//go:build !zogo_minimal
+
+package zogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func testVersionedSchema() *VersionedValidator {
+	return Versioned().
+		Version("v1", Object(Schema{"name": String().Required()})).
+		Version("v2", Object(Schema{"firstName": String().Required(), "lastName": String().Required()}))
+}
+
+// Test schema selection by header
+func TestVersionedSelectsSchema(t *testing.T) {
+	schema := testVersionedSchema()
+
+	header := http.Header{}
+	header.Set("Accept-Version", "v1")
+	if !schema.ParseHeader(header, map[string]interface{}{"name": "Ada"}).Ok {
+		t.Error("Expected v1 payload to pass with v1 header")
+	}
+
+	header = http.Header{}
+	header.Set("X-API-Version", "v2")
+	if schema.ParseHeader(header, map[string]interface{}{"name": "Ada"}).Ok {
+		t.Error("Expected v1 payload to fail with v2 header")
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/users", nil)
+	req.Header.Set("X-API-Version", "v2")
+	result := schema.ParseRequest(req, map[string]interface{}{"firstName": "Ada", "lastName": "Lovelace"})
+	if !result.Ok {
+		t.Errorf("Expected v2 payload to pass: %v", result.Errors)
+	}
+}
+
+// Test missing and unknown versions return a coded error listing supported versions
+func TestVersionedUnsupported(t *testing.T) {
+	schema := testVersionedSchema()
+
+	result := schema.ParseHeader(http.Header{}, map[string]interface{}{})
+	if result.Ok {
+		t.Fatal("Expected missing version to fail")
+	}
+	err := result.Errors[0]
+	if err.Code != CodeUnsupportedVersion || err.Params["supported"] != "v1, v2" {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if err.Translate("en") != err.Message {
+		t.Errorf("Expected message %q to match template, got %q", err.Message, err.Translate("en"))
+	}
+
+	header := http.Header{}
+	header.Set("Accept-Version", "v3")
+	result = schema.ParseHeader(header, map[string]interface{}{})
+	if result.Ok {
+		t.Fatal("Expected unknown version to fail")
+	}
+	err = result.Errors[0]
+	if err.Code != CodeUnsupportedVersion || !strings.Contains(err.Message, "'v3'") || !strings.Contains(err.Message, "v1, v2") {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if err.Translate("en") != err.Message {
+		t.Errorf("Expected message %q to match template, got %q", err.Message, err.Translate("en"))
+	}
+}
+
+// Test default version and custom headers
+func TestVersionedOptions(t *testing.T) {
+	schema := testVersionedSchema().Default("v1").Headers("Api-Version")
+
+	if !schema.Parse(map[string]interface{}{"name": "Ada"}).Ok {
+		t.Error("Expected default version to be used without a header")
+	}
+
+	header := http.Header{}
+	header.Set("Accept-Version", "v2")
+	if !schema.ParseHeader(header, map[string]interface{}{"name": "Ada"}).Ok {
+		t.Error("Expected headers outside Headers() to be ignored")
+	}
+
+	header.Set("Api-Version", "v2")
+	version, _, errs := schema.Resolve(header)
+	if errs != nil || version != "v2" {
+		t.Errorf("Expected v2, got %q (%v)", version, errs)
+	}
+
+	if got := schema.Versions(); len(got) != 2 || got[0] != "v1" {
+		t.Errorf("Unexpected versions: %v", got)
+	}
+}
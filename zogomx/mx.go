@@ -0,0 +1,132 @@
+// Package zogomx checks that email domains accept mail by looking up their
+// MX records. It is kept apart so that the core zogo package has no
+// network dependencies.
+package zogomx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hkurdi/zogo"
+	"golang.org/x/net/idna"
+)
+
+// Resolver looks up the mail servers of a domain. *net.Resolver
+// implements it.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// Options configures Check
+type Options struct {
+	Timeout  time.Duration // Limit for each lookup (default 2s)
+	CacheTTL time.Duration // How long an answer is reused (default 10m, negative disables caching)
+	Resolver Resolver      // Resolver to query (default net.DefaultResolver)
+}
+
+// cacheSize bounds the domains a check remembers; the cache is emptied
+// when it fills up
+const cacheSize = 4096
+
+// entry is a cached MX answer
+type entry struct {
+	accepts bool
+	expires time.Time
+}
+
+// Check returns a check that an email address's domain has MX records, to
+// catch typo domains such as "gmial.con" at signup:
+//
+//	email := zogo.String().Email().RefineCtx(zogomx.Check())
+//
+// A domain without MX records, or with the null MX of RFC 7505, fails with
+// zogo.CodeInvalidEmail. Answers are cached per check until CacheTTL
+// passes. Lookups that time out or fail return a non-validation error, so
+// wrapping the check in zogo.Expensive lets addresses through while DNS is
+// unavailable. Values that are not email addresses are left to Email.
+func Check(options ...Options) zogo.CheckFunc {
+	var opts Options
+	if len(options) > 0 {
+		opts = options[len(options)-1]
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 2 * time.Second
+	}
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = 10 * time.Minute
+	}
+	if opts.Resolver == nil {
+		opts.Resolver = net.DefaultResolver
+	}
+
+	var mu sync.Mutex
+	cache := map[string]entry{}
+
+	return func(ctx context.Context, value any) error {
+		str, _ := value.(string)
+		at := strings.LastIndexByte(str, '@')
+		if at <= 0 || at == len(str)-1 {
+			return nil
+		}
+		domain, err := idna.Lookup.ToASCII(str[at+1:])
+		if err != nil {
+			return nil
+		}
+
+		mu.Lock()
+		cached, ok := cache[domain]
+		mu.Unlock()
+
+		if !ok || time.Now().After(cached.expires) {
+			accepts, err := lookup(ctx, opts, domain)
+			if err != nil {
+				return err
+			}
+			cached = entry{accepts: accepts, expires: time.Now().Add(opts.CacheTTL)}
+			if opts.CacheTTL > 0 {
+				mu.Lock()
+				if len(cache) >= cacheSize {
+					clear(cache)
+				}
+				cache[domain] = cached
+				mu.Unlock()
+			}
+		}
+
+		if !cached.accepts {
+			return zogo.ValidationError{
+				Message: "Email domain does not accept mail",
+				Code:    zogo.CodeInvalidEmail,
+				Params:  map[string]any{"type": "mx", "domain": domain},
+			}
+		}
+		return nil
+	}
+}
+
+// lookup reports whether the domain has MX records other than a null MX.
+// A domain that does not exist, or has no MX records, does not accept mail.
+func lookup(ctx context.Context, opts Options, domain string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	records, err := opts.Resolver.LookupMX(ctx, domain)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("zogomx: MX lookup for %s: %w", domain, err)
+	}
+	for _, record := range records {
+		if record.Host != "." && record.Host != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
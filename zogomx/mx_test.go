@@ -0,0 +1,121 @@
+package zogomx
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hkurdi/zogo"
+)
+
+// fakeResolver answers MX lookups from a map, counting the lookups
+type fakeResolver struct {
+	records map[string][]*net.MX
+	err     error
+	lookups atomic.Int32
+}
+
+func (r *fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.lookups.Add(1)
+	if r.err != nil {
+		return nil, r.err
+	}
+	if records, ok := r.records[name]; ok {
+		return records, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+// Test Check rejects domains that do not accept mail and caches answers
+func TestCheck(t *testing.T) {
+	resolver := &fakeResolver{records: map[string][]*net.MX{
+		"example.com":      {{Host: "mx.example.com.", Pref: 10}},
+		"xn--bcher-kva.de": {{Host: "mx.bücher.de.", Pref: 10}},
+		"nomail.example":   {{Host: ".", Pref: 0}},
+	}}
+	schema := zogo.String().Email(zogo.EmailOptions{AllowIDN: true}).RefineCtx(Check(Options{Resolver: resolver}))
+
+	for _, email := range []string{"user@example.com", "user@EXAMPLE.com", "user@bücher.de", "user@Bücher.de"} {
+		if result := schema.Parse(email); !result.Ok {
+			t.Errorf("Expected %q to pass, got %v", email, result.Errors)
+		}
+	}
+	if n := resolver.lookups.Load(); n != 2 {
+		t.Errorf("Expected cached answers to be reused, got %d lookups", n)
+	}
+
+	for _, email := range []string{"user@gmial.con", "user@nomail.example"} {
+		result := schema.Parse(email)
+		if result.Ok || result.Errors[0].Code != zogo.CodeInvalidEmail || result.Errors[0].Params["type"] != "mx" {
+			t.Errorf("Expected %q to fail with an mx error, got %v", email, result.Errors)
+		}
+	}
+
+	if result := schema.Parse("not-an-email"); result.Ok || result.Errors[0].Params["type"] == "mx" {
+		t.Errorf("Expected an invalid address to fail before the lookup, got %v", result.Errors)
+	}
+}
+
+// Test cached answers expire after CacheTTL
+func TestCheckCacheTTL(t *testing.T) {
+	resolver := &fakeResolver{records: map[string][]*net.MX{"example.com": {{Host: "mx.example.com.", Pref: 10}}}}
+	check := Check(Options{Resolver: resolver, CacheTTL: 20 * time.Millisecond})
+
+	check(context.Background(), "user@example.com")
+	check(context.Background(), "user@example.com")
+	if n := resolver.lookups.Load(); n != 1 {
+		t.Errorf("Expected the answer to be cached, got %d lookups", n)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	check(context.Background(), "user@example.com")
+	if n := resolver.lookups.Load(); n != 2 {
+		t.Errorf("Expected an expired answer to be looked up again, got %d lookups", n)
+	}
+}
+
+// Test Check reports lookup failures as non-validation errors
+func TestCheckLookupError(t *testing.T) {
+	resolver := &fakeResolver{err: errors.New("server misbehaving")}
+	check := Check(Options{Resolver: resolver, CacheTTL: -1})
+
+	err := check(context.Background(), "user@example.com")
+	if err == nil || len(zogo.CollectErrors(err)) > 0 {
+		t.Errorf("Expected a lookup error, got %v", err)
+	}
+	if result := zogo.String().Email().RefineCtx(check).Parse("user@example.com"); result.Ok || result.Errors[0].Code != zogo.CodeCheckFailed {
+		t.Errorf("Expected RefineCtx to fail with %s, got %v", zogo.CodeCheckFailed, result.Errors)
+	}
+
+	email := zogo.Expensive("email_mx", zogo.String().Email(), check)
+	result := email.Parse("user@example.com")
+	if !result.Ok || len(result.Meta.Skipped) != 1 || result.Meta.Skipped[0].Reason != zogo.SkipCheckError {
+		t.Errorf("Expected Expensive to skip the failed lookup, got %v %v", result.Errors, result.Meta.Skipped)
+	}
+
+	check(context.Background(), "user@example.com")
+	if resolver.lookups.Load() != 4 {
+		t.Errorf("Expected no caching with a negative CacheTTL, got %d lookups", resolver.lookups.Load())
+	}
+}
+
+// Test Check bounds each lookup with its timeout
+func TestCheckTimeout(t *testing.T) {
+	check := Check(Options{Resolver: slowResolver{}, Timeout: 10 * time.Millisecond})
+
+	err := check(context.Background(), "user@example.com")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the lookup to time out, got %v", err)
+	}
+}
+
+// slowResolver blocks until the lookup's context is done
+type slowResolver struct{}
+
+func (slowResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
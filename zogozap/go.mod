@@ -0,0 +1,16 @@
+module github.com/hkurdi/zogo/zogozap
+
+go 1.22.5
+
+require (
+	github.com/hkurdi/zogo v0.1.0
+	go.uber.org/zap v1.27.0
+)
+
+require (
+	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/net v0.34.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
+
+replace github.com/hkurdi/zogo => ../
@@ -0,0 +1,62 @@
+// Package zogozap connects zogo to the zap logger. It is a separate module
+// so that the core zogo package stays free of dependencies.
+package zogozap
+
+import (
+	"context"
+
+	"github.com/hkurdi/zogo"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Errors returns a field logging validation errors as an array of
+// {path, code, message} objects, rather than one long string:
+//
+//	logger.Warn("invalid input", zogozap.Errors("errors", result.Errors))
+func Errors(key string, errs zogo.ValidationErrors) zap.Field {
+	return zap.Array(key, errorArray(errs))
+}
+
+// Error returns a field logging a validation error as a {path, code, message} object
+func Error(key string, err zogo.ValidationError) zap.Field {
+	return zap.Object(key, errorObject(err))
+}
+
+// errorArray marshals validation errors as a zap array
+type errorArray zogo.ValidationErrors
+
+func (errs errorArray) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, err := range errs {
+		if e := enc.AppendObject(errorObject(err)); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// errorObject marshals a validation error as a zap object
+type errorObject zogo.ValidationError
+
+func (err errorObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("path", err.Path)
+	enc.AddString("code", err.Code)
+	enc.AddString("message", err.Message)
+	return nil
+}
+
+// Sink returns a valve sink logging rejected payloads to logger at the
+// given level, with the schema name, redacted payload and errors as fields
+func Sink(logger *zap.Logger, level zapcore.Level) zogo.RejectionSink {
+	return func(ctx context.Context, rejection zogo.Rejection) {
+		entry := logger.Check(level, "zogo: payload rejected")
+		if entry == nil {
+			return
+		}
+		entry.Write(
+			zap.String("schema", rejection.Schema),
+			zap.Any("payload", rejection.Payload),
+			Errors("errors", rejection.Errors),
+		)
+	}
+}
@@ -0,0 +1,67 @@
+package zogozap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hkurdi/zogo"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// Test the zap sink logs rejections as structured fields
+func TestSink(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	valve := zogo.NewValve(Sink(zap.New(core), zapcore.WarnLevel))
+
+	schema := zogo.Object(zogo.Schema{"password": zogo.Sensitive(zogo.String().Min(12))})
+	zogo.ParseCtx(context.Background(), schema, map[string]interface{}{"password": "short"}, zogo.WithValve(valve), zogo.WithSchemaName("Login"))
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected one log entry, got %d", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if entries[0].Level != zapcore.WarnLevel || fields["schema"] != "Login" {
+		t.Errorf("Unexpected log entry: %+v", entries[0])
+	}
+	if payload := fields["payload"].(map[string]interface{}); payload["password"] != zogo.Redacted {
+		t.Errorf("Expected redacted payload, got %v", payload)
+	}
+}
+
+// Test nothing is logged below the logger's level
+func TestSinkDisabledLevel(t *testing.T) {
+	core, logs := observer.New(zapcore.ErrorLevel)
+	valve := zogo.NewValve(Sink(zap.New(core), zapcore.WarnLevel))
+
+	zogo.ParseCtx(context.Background(), zogo.String(), 42, zogo.WithValve(valve))
+	if logs.Len() != 0 {
+		t.Errorf("Expected no log entries, got %d", logs.Len())
+	}
+}
+
+// Test errors are logged as structured arrays
+func TestErrors(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+
+	result := zogo.Object(zogo.Schema{"name": zogo.String(), "age": zogo.Number().Min(18)}).Parse(map[string]interface{}{"age": float64(3)})
+	logger.Warn("invalid input", Errors("errors", result.Errors), Error("first", result.Errors[0]))
+
+	fields := logs.All()[0].ContextMap()
+	errs, ok := fields["errors"].([]interface{})
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected an array of 2 errors, got %#v", fields["errors"])
+	}
+	for _, raw := range errs {
+		err := raw.(map[string]interface{})
+		if err["path"] == "" || err["code"] == "" || err["message"] == "" {
+			t.Errorf("Expected path, code and message, got %v", err)
+		}
+	}
+	if first := fields["first"].(map[string]interface{}); first["path"] != result.Errors[0].Path {
+		t.Errorf("Expected the single error as an object, got %v", first)
+	}
+}